@@ -140,6 +140,7 @@ import (
 	tokenfactorykeeper "github.com/sei-protocol/sei-chain/x/tokenfactory/keeper"
 	tokenfactorytypes "github.com/sei-protocol/sei-chain/x/tokenfactory/types"
 	aexburnmodule "github.com/sei-protocol/sei-chain/x/aexburn"
+	aexburnclient "github.com/sei-protocol/sei-chain/x/aexburn/client/cli"
 	aexburnkeeper "github.com/sei-protocol/sei-chain/x/aexburn/keeper"
 	aexburntypes "github.com/sei-protocol/sei-chain/x/aexburn/types"
 	"github.com/sei-protocol/sei-db/ss"
@@ -174,6 +175,10 @@ func getGovProposalHandlers() []govclient.ProposalHandler {
 		ibcclientclient.UpgradeProposalHandler,
 		aclclient.ResourceDependencyProposalHandler,
 		mintclient.UpdateMinterHandler,
+		aexburnclient.UpdateParamsProposalHandler,
+		aexburnclient.SetEmergencyPauseProposalHandler,
+		aexburnclient.DrainIncomeBufferProposalHandler,
+		aexburnclient.ResetIncomeBufferProposalHandler,
 		// this line is used by starport scaffolding # stargate/app/govProposalHandler
 	)
 
@@ -212,6 +217,7 @@ var (
 		wasm.AppModuleBasic{},
 		epochmodule.AppModuleBasic{},
 		tokenfactorymodule.AppModuleBasic{},
+		aexburnmodule.AppModuleBasic{},
 		// this line is used by starport scaffolding # stargate/app/moduleBasic
 	)
 
@@ -504,10 +510,16 @@ func New(
 		app.GetSubspace(aexburntypes.ModuleName),
 		app.AccountKeeper,
 		app.BankKeeper,
+		app.DistrKeeper,
+		&app.OracleKeeper, // constructed below
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	// Set fee burn hook on distribution keeper for AEX fee burning
 	app.DistrKeeper.SetFeeBurnHook(&app.AexburnKeeper)
+	// Report x/mint releases to aexburn for its supply reconciliation. Must be set
+	// before the mint keeper is copied into the epoch hooks below
+	app.MintKeeper.SetHooks(minttypes.NewMultiMintHooks(app.AexburnKeeper.MintHooks()))
 
 	app.SlashingKeeper = slashingkeeper.NewKeeper(
 		appCodec, keys[slashingtypes.StoreKey], &stakingKeeper, app.GetSubspace(slashingtypes.ModuleName),
@@ -658,6 +670,7 @@ func New(
 		panic(fmt.Sprintf("error reading evm query config due to %s", err))
 	}
 	app.EvmKeeper.QueryConfig = &evmQueryConfig
+	app.EvmKeeper.LogIndexEnabled = app.evmRPCConfig.LogIndexEnabled
 	ethReplayConfig, err := replay.ReadConfig(appOpts)
 	if err != nil {
 		panic(fmt.Sprintf("error reading eth replay config due to %s", err))
@@ -710,6 +723,7 @@ func New(
 		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
 		AddRoute(minttypes.RouterKey, mint.NewProposalHandler(app.MintKeeper)).
 		AddRoute(tokenfactorytypes.RouterKey, tokenfactorymodule.NewProposalHandler(app.TokenFactoryKeeper)).
+		AddRoute(aexburntypes.RouterKey, aexburnmodule.NewProposalHandler(app.AexburnKeeper)).
 		AddRoute(acltypes.ModuleName, aclmodule.NewProposalHandler(app.AccessControlKeeper)).
 		AddRoute(evmtypes.RouterKey, evm.NewProposalHandler(app.EvmKeeper))
 	if len(enabledProposals) != 0 {
@@ -857,6 +871,8 @@ func New(
 		govtypes.ModuleName,
 		minttypes.ModuleName,
 		vestingtypes.ModuleName,
+		// aexburn invariants are asserted by crisis, so its state must exist first
+		aexburntypes.ModuleName,
 		crisistypes.ModuleName,
 		ibchost.ModuleName,
 		genutiltypes.ModuleName,
@@ -870,7 +886,6 @@ func New(
 		wasm.ModuleName,
 		evmtypes.ModuleName,
 		acltypes.ModuleName,
-		aexburntypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/initGenesis
 	)
 
@@ -1614,11 +1629,15 @@ func (app *App) ProcessBlock(ctx sdk.Context, txs [][]byte, req BlockProcessRequ
 
 	// Sum up total used per block only for evm transactions
 	evmTotalGasUsed := int64(0)
+	totalGasUsed := int64(0)
 	for _, txResult := range txResults {
 		if txResult.EvmTxInfo != nil {
 			evmTotalGasUsed += txResult.GasUsed
 		}
+		totalGasUsed += txResult.GasUsed
 	}
+	// aexburn measures chain utilization, so it is fed the gas of every tx rather than only EVM ones
+	app.AexburnKeeper.RecordBlockGasUsage(ctx, uint64(totalGasUsed))
 
 	endBlockResp := app.EndBlock(ctx, abci.RequestEndBlock{
 		Height:       req.GetHeight(),
@@ -4,9 +4,11 @@ import (
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	"github.com/cosmos/cosmos-sdk/client"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	feegrantkeeper "github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	putils "github.com/sei-protocol/sei-chain/precompiles/utils"
+	tokenfactorykeeper "github.com/sei-protocol/sei-chain/x/tokenfactory/keeper"
 )
 
 type PrecompileKeepers struct {
@@ -21,33 +23,45 @@ type PrecompileKeepers struct {
 	putils.StakingQuerier
 	putils.GovKeeper
 	putils.GovMsgServer
+	putils.GovQuerier
 	putils.DistributionKeeper
 	putils.TransferKeeper
 	putils.ClientKeeper
 	putils.ConnectionKeeper
 	putils.ChannelKeeper
+	putils.AexburnKeeper
+	putils.AuthzMsgServer
+	putils.FeegrantMsgServer
+	putils.TokenfactoryMsgServer
+	putils.TokenfactoryQuerier
 	txConf client.TxConfig
 }
 
 func NewPrecompileKeepers(a *App) *PrecompileKeepers {
 	return &PrecompileKeepers{
-		BankKeeper:         a.BankKeeper,
-		BankMsgServer:      bankkeeper.NewMsgServerImpl(a.BankKeeper),
-		EVMKeeper:          &a.EvmKeeper,
-		AccountKeeper:      a.AccountKeeper,
-		OracleKeeper:       a.OracleKeeper,
-		WasmdKeeper:        wasmkeeper.NewDefaultPermissionKeeper(a.WasmKeeper),
-		WasmdViewKeeper:    a.WasmKeeper,
-		StakingKeeper:      stakingkeeper.NewMsgServerImpl(a.StakingKeeper),
-		StakingQuerier:     stakingkeeper.Querier{Keeper: a.StakingKeeper},
-		GovKeeper:          a.GovKeeper,
-		GovMsgServer:       govkeeper.NewMsgServerImpl(a.GovKeeper),
-		DistributionKeeper: a.DistrKeeper,
-		TransferKeeper:     a.TransferKeeper,
-		ClientKeeper:       a.IBCKeeper.ClientKeeper,
-		ConnectionKeeper:   a.IBCKeeper.ConnectionKeeper,
-		ChannelKeeper:      a.IBCKeeper.ChannelKeeper,
-		txConf:             a.GetTxConfig(),
+		BankKeeper:            a.BankKeeper,
+		BankMsgServer:         bankkeeper.NewMsgServerImpl(a.BankKeeper),
+		EVMKeeper:             &a.EvmKeeper,
+		AccountKeeper:         a.AccountKeeper,
+		OracleKeeper:          a.OracleKeeper,
+		WasmdKeeper:           wasmkeeper.NewDefaultPermissionKeeper(a.WasmKeeper),
+		WasmdViewKeeper:       a.WasmKeeper,
+		StakingKeeper:         stakingkeeper.NewMsgServerImpl(a.StakingKeeper),
+		StakingQuerier:        stakingkeeper.Querier{Keeper: a.StakingKeeper},
+		GovKeeper:             a.GovKeeper,
+		GovMsgServer:          govkeeper.NewMsgServerImpl(a.GovKeeper),
+		GovQuerier:            a.GovKeeper,
+		DistributionKeeper:    a.DistrKeeper,
+		TransferKeeper:        a.TransferKeeper,
+		ClientKeeper:          a.IBCKeeper.ClientKeeper,
+		ConnectionKeeper:      a.IBCKeeper.ConnectionKeeper,
+		ChannelKeeper:         a.IBCKeeper.ChannelKeeper,
+		AexburnKeeper:         a.AexburnKeeper,
+		AuthzMsgServer:        a.AuthzKeeper,
+		FeegrantMsgServer:     feegrantkeeper.NewMsgServerImpl(a.FeeGrantKeeper),
+		TokenfactoryMsgServer: tokenfactorykeeper.NewMsgServerImpl(a.TokenFactoryKeeper),
+		TokenfactoryQuerier:   a.TokenFactoryKeeper,
+		txConf:                a.GetTxConfig(),
 	}
 }
 
@@ -62,9 +76,19 @@ func (pk *PrecompileKeepers) StakingK() putils.StakingKeeper           { return
 func (pk *PrecompileKeepers) StakingQ() putils.StakingQuerier          { return pk.StakingQuerier }
 func (pk *PrecompileKeepers) GovK() putils.GovKeeper                   { return pk.GovKeeper }
 func (pk *PrecompileKeepers) GovMS() putils.GovMsgServer               { return pk.GovMsgServer }
+func (pk *PrecompileKeepers) GovQ() putils.GovQuerier                  { return pk.GovQuerier }
 func (pk *PrecompileKeepers) DistributionK() putils.DistributionKeeper { return pk.DistributionKeeper }
 func (pk *PrecompileKeepers) TransferK() putils.TransferKeeper         { return pk.TransferKeeper }
 func (pk *PrecompileKeepers) ClientK() putils.ClientKeeper             { return pk.ClientKeeper }
 func (pk *PrecompileKeepers) ConnectionK() putils.ConnectionKeeper     { return pk.ConnectionKeeper }
 func (pk *PrecompileKeepers) ChannelK() putils.ChannelKeeper           { return pk.ChannelKeeper }
-func (pk *PrecompileKeepers) TxConfig() client.TxConfig                { return pk.txConf }
+func (pk *PrecompileKeepers) AexburnK() putils.AexburnKeeper           { return pk.AexburnKeeper }
+func (pk *PrecompileKeepers) AuthzMS() putils.AuthzMsgServer           { return pk.AuthzMsgServer }
+func (pk *PrecompileKeepers) FeegrantMS() putils.FeegrantMsgServer     { return pk.FeegrantMsgServer }
+func (pk *PrecompileKeepers) TokenfactoryMS() putils.TokenfactoryMsgServer {
+	return pk.TokenfactoryMsgServer
+}
+func (pk *PrecompileKeepers) TokenfactoryQ() putils.TokenfactoryQuerier {
+	return pk.TokenfactoryQuerier
+}
+func (pk *PrecompileKeepers) TxConfig() client.TxConfig { return pk.txConf }
@@ -0,0 +1,186 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/sei-protocol/sei-chain/x/aexburn/simulator"
+	aexburntypes "github.com/sei-protocol/sei-chain/x/aexburn/types"
+)
+
+const (
+	FlagSimParams         = "params"
+	FlagSimEpochs         = "epochs"
+	FlagSimBlocksPerEpoch = "blocks-per-epoch"
+	FlagSimEpochDuration  = "epoch-duration"
+	FlagSimFeesPerEpoch   = "fees-per-epoch"
+	FlagSimGasTrace       = "gas-trace"
+	FlagSimGasPattern     = "gas-pattern"
+	FlagSimGasMin         = "gas-min"
+	FlagSimGasMax         = "gas-max"
+	FlagSimGasPeriod      = "gas-period"
+	FlagSimFormat         = "format"
+	FlagSimOutput         = "output-file"
+)
+
+func AexburnSimulateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aexburn-simulate",
+		Short: "Project the AEX supply curve of an aexburn parameter set",
+		Long: fmt.Sprintf(`Run the aexburn burn, income smoothing, inflation and reverse brake logic
+against an in-memory store for a number of epochs and output the minted, burned,
+income buffer balance and net supply of every epoch as CSV or JSON.
+
+Gas utilization per epoch is either read from a recorded trace (--gas-trace, a JSON
+array or a CSV file whose last column is the utilization) or generated from a
+synthetic pattern: constant (--gas-min), ramp (--gas-min to --gas-max) or sine
+(between --gas-min and --gas-max every --gas-period epochs).
+
+Example:
+$ %s debug aexburn-simulate --params params.json --epochs 730 --gas-pattern sine --gas-min 0.2 --gas-max 0.8 --format json
+`, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: aexburnSimulateCmdHandler,
+	}
+
+	cmd.Flags().String(FlagSimParams, "", "JSON file with the aexburn params to simulate, defaults to the default params")
+	cmd.Flags().Uint64(FlagSimEpochs, 365, "Number of epochs to simulate")
+	cmd.Flags().Uint64(FlagSimBlocksPerEpoch, 100, "Number of blocks simulated per epoch")
+	cmd.Flags().Duration(FlagSimEpochDuration, 24*time.Hour, "Block time spanned by an epoch")
+	cmd.Flags().String(FlagSimFeesPerEpoch, "1000000000000", "uaex fees collected per epoch at 100% gas utilization")
+	cmd.Flags().String(FlagSimGasTrace, "", "Recorded per-epoch gas utilization trace (.json or .csv), overrides --gas-pattern")
+	cmd.Flags().String(FlagSimGasPattern, simulator.PatternConstant, "Synthetic gas utilization pattern: constant, ramp or sine")
+	cmd.Flags().String(FlagSimGasMin, "0.5", "Minimum gas utilization of the synthetic pattern")
+	cmd.Flags().String(FlagSimGasMax, "0.8", "Maximum gas utilization of the synthetic pattern")
+	cmd.Flags().Uint64(FlagSimGasPeriod, 30, "Period in epochs of the sine pattern")
+	cmd.Flags().String(FlagSimFormat, simulator.FormatCSV, "Output format: csv or json")
+	cmd.Flags().StringP(FlagSimOutput, "o", "", "File to write the results to, defaults to stdout")
+
+	return cmd
+}
+
+func aexburnSimulateCmdHandler(cmd *cobra.Command, _ []string) error {
+	cfg, err := readSimulationConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	format, err := cmd.Flags().GetString(FlagSimFormat)
+	if err != nil {
+		return err
+	}
+	write := simulator.WriteCSV
+	switch format {
+	case simulator.FormatCSV:
+	case simulator.FormatJSON:
+		write = simulator.WriteJSON
+	default:
+		return fmt.Errorf("unknown output format %q, expected %s or %s", format, simulator.FormatCSV, simulator.FormatJSON)
+	}
+
+	results, err := simulator.Run(cfg)
+	if err != nil {
+		return err
+	}
+
+	outputFile, err := cmd.Flags().GetString(FlagSimOutput)
+	if err != nil {
+		return err
+	}
+	var out io.Writer = cmd.OutOrStdout()
+	if outputFile != "" {
+		file, err := os.Create(outputFile)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		out = file
+	}
+
+	return write(out, results)
+}
+
+func readSimulationConfig(cmd *cobra.Command) (simulator.Config, error) {
+	cfg := simulator.Config{Params: aexburntypes.DefaultParams()}
+
+	paramsFile, err := cmd.Flags().GetString(FlagSimParams)
+	if err != nil {
+		return cfg, err
+	}
+	if paramsFile != "" {
+		bz, err := os.ReadFile(paramsFile)
+		if err != nil {
+			return cfg, err
+		}
+		cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+		if err := cdc.UnmarshalJSON(bz, &cfg.Params); err != nil {
+			return cfg, err
+		}
+	}
+
+	if cfg.Epochs, err = cmd.Flags().GetUint64(FlagSimEpochs); err != nil {
+		return cfg, err
+	}
+	if cfg.BlocksPerEpoch, err = cmd.Flags().GetUint64(FlagSimBlocksPerEpoch); err != nil {
+		return cfg, err
+	}
+	if cfg.EpochDuration, err = cmd.Flags().GetDuration(FlagSimEpochDuration); err != nil {
+		return cfg, err
+	}
+	feesPerEpoch, err := cmd.Flags().GetString(FlagSimFeesPerEpoch)
+	if err != nil {
+		return cfg, err
+	}
+	var ok bool
+	if cfg.FeesPerEpoch, ok = sdk.NewIntFromString(feesPerEpoch); !ok {
+		return cfg, fmt.Errorf("invalid fees per epoch: %s", feesPerEpoch)
+	}
+
+	traceFile, err := cmd.Flags().GetString(FlagSimGasTrace)
+	if err != nil {
+		return cfg, err
+	}
+	if traceFile != "" {
+		cfg.GasTrace, err = simulator.LoadGasTrace(traceFile)
+		return cfg, err
+	}
+
+	pattern, err := cmd.Flags().GetString(FlagSimGasPattern)
+	if err != nil {
+		return cfg, err
+	}
+	gasMin, err := readDecFlag(cmd, FlagSimGasMin)
+	if err != nil {
+		return cfg, err
+	}
+	gasMax, err := readDecFlag(cmd, FlagSimGasMax)
+	if err != nil {
+		return cfg, err
+	}
+	period, err := cmd.Flags().GetUint64(FlagSimGasPeriod)
+	if err != nil {
+		return cfg, err
+	}
+	cfg.GasTrace, err = simulator.SyntheticGasTrace(pattern, cfg.Epochs, gasMin, gasMax, period)
+	return cfg, err
+}
+
+func readDecFlag(cmd *cobra.Command, flag string) (sdk.Dec, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	dec, err := sdk.NewDecFromStr(value)
+	if err != nil {
+		return sdk.Dec{}, fmt.Errorf("invalid --%s: %w", flag, err)
+	}
+	return dec, nil
+}
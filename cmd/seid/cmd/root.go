@@ -120,6 +120,7 @@ func initRootCmd(
 	// extend debug command
 	debugCmd := debug.Cmd()
 	debugCmd.AddCommand(DumpIavlCmd())
+	debugCmd.AddCommand(AexburnSimulateCmd())
 
 	rootCmd.AddCommand(
 		InitCmd(app.ModuleBasics, app.DefaultNodeHome),
@@ -544,15 +545,82 @@ slow = {{ .EVM.Slow }}
 # Deny list defines list of methods that EVM RPC should fail fast, e.g ["debug_traceBlockByNumber"]
 deny_list = {{ .EVM.DenyList }}
 
+# number of tokens added per second to the rate limit bucket of every client, where each
+# call takes the weight of its method. Requests over the limit are rejected with HTTP 429
+# and a JSON-RPC error on HTTP, and with a JSON-RPC error on websocket. Set to 0 to disable.
+rate_limit_requests_per_second = {{ .EVM.RateLimitRequestsPerSecond }}
+
+# size of the rate limit bucket of every client
+rate_limit_burst = {{ .EVM.RateLimitBurst }}
+
+# what clients are rate limited by: "ip", "jwt" (subject of the bearer token) or "api_key"
+# (X-API-Key header or apikey query parameter). "jwt" needs rate_limit_jwt_secret and only
+# uses tokens signed with it, "api_key" needs rate_limit_api_keys and only uses the keys in
+# it. Requests without a valid token or key are limited by IP.
+rate_limit_key = "{{ .EVM.RateLimitKey }}"
+
+# HMAC secret (HS256, HS384 or HS512) bearer tokens are verified with when rate limiting by JWT
+rate_limit_jwt_secret = "{{ .EVM.RateLimitJWTSecret }}"
+
+# comma separated API keys clients are rate limited by when rate limiting by API key
+rate_limit_api_keys = "{{ .EVM.RateLimitAPIKeys }}"
+
+# header to read client IPs from when behind a proxy, e.g. "X-Forwarded-For". The last
+# address in the header is used. Leave empty to use the address of the connection.
+rate_limit_ip_header = "{{ .EVM.RateLimitIPHeader }}"
+
+# weights of methods for rate limiting as comma separated method:weight pairs. Other
+# methods weigh 1.
+rate_limit_method_weights = "{{ .EVM.RateLimitMethodWeights }}"
+
+# max number of responses to eth_getBlockByNumber, eth_getBlockByHash, eth_getBlockReceipts
+# and eth_getTransactionReceipt (and their sei_ counterparts) about blocks below the latest
+# one to keep in memory. Such responses never change. Set to 0 to disable the cache.
+response_cache_size = {{ .EVM.ResponseCacheSize }}
+
+# how long cached responses are kept
+response_cache_ttl = "{{ .EVM.ResponseCacheTTL }}"
+
+# max number of calls in a JSON-RPC batch. Set to 0 for unlimited.
+batch_request_limit = {{ .EVM.BatchRequestLimit }}
+
+# max total size in bytes of the results of a JSON-RPC batch, past which the remaining
+# calls of the batch are answered with an error. Set to 0 for unlimited.
+batch_response_max_size = {{ .EVM.BatchResponseMaxSize }}
+
+# max size in bytes of a single response. Large array results like block traces and
+# receipts are encoded incrementally and stop with an error once they exceed it, and
+# websocket messages over it are replaced with an error. Set to 0 for unlimited, which
+# also keeps the stock go-ethereum websocket handler unless rate limiting is enabled.
+max_response_size = {{ .EVM.MaxResponseSize }}
+
+# max number of historical versions kept loaded to serve eth_getProof at heights no longer
+# held by the commitment store. Such versions are loaded from the retained memiavl snapshots
+# (see state-commit.sc-keep-recent), so proofs are available for any version from the
+# earliest retained snapshot on. Loading a version replays the changelog since its snapshot,
+# and loads are done one at a time, so only enable this on nodes meant to serve old proofs.
+# Set to 0 to disable proofs at those heights.
+max_proof_snapshots = {{ .EVM.MaxProofSnapshots }}
+
 # max number of logs returned if block range is open-ended
 max_log_no_block = {{ .EVM.MaxLogNoBlock }}
 
 # max number of blocks to query logs for
 max_blocks_for_log = {{ .EVM.MaxBlocksForLog }}
 
+# controls whether receipts are indexed by log address and topics in receipt.db, so that
+# filtered log queries only read the blocks with matching logs. With the index, max_blocks_for_log
+# limits the number of blocks with matching logs instead of the block range.
+# Receipts stored before the index was enabled are backfilled in batches of 1000 with each
+# receipt flush at block commit, which adds to commit time until the backfill completes.
+log_index_enabled = {{ .EVM.LogIndexEnabled }}
+
 # max number of concurrent NewHead subscriptions
 max_subscriptions_new_head = {{ .EVM.MaxSubscriptionsNewHead }}
 
+# max number of concurrent NewPendingTransactions subscriptions
+max_subscriptions_pending_txs = {{ .EVM.MaxSubscriptionsPendingTxs }}
+
 # MaxConcurrentTraceCalls defines the maximum number of concurrent debug_trace calls.
 # Set to 0 for unlimited.
 max_concurrent_trace_calls = {{ .EVM.MaxConcurrentTraceCalls }}
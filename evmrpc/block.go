@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"strings"
@@ -46,6 +47,7 @@ type BlockAPI struct {
 	includeBankTransfers bool
 	globalBlockCache     BlockCache
 	cacheCreationMutex   *sync.Mutex
+	responseCache        *ResponseCache
 }
 
 type SeiBlockAPI struct {
@@ -62,6 +64,7 @@ func NewBlockAPI(
 	connectionType ConnectionType,
 	globalBlockCache BlockCache,
 	cacheCreationMutex *sync.Mutex,
+	responseCache *ResponseCache,
 ) *BlockAPI {
 	return &BlockAPI{
 		tmClient:             tmClient,
@@ -75,6 +78,7 @@ func NewBlockAPI(
 		namespace:            EthNamespace,
 		globalBlockCache:     globalBlockCache,
 		cacheCreationMutex:   cacheCreationMutex,
+		responseCache:        responseCache,
 	}
 }
 
@@ -88,6 +92,7 @@ func NewSeiBlockAPI(
 	isPanicTx func(ctx context.Context, hash common.Hash) (bool, error),
 	globalBlockCache BlockCache,
 	cacheCreationMutex *sync.Mutex,
+	responseCache *ResponseCache,
 ) *SeiBlockAPI {
 	blockAPI := &BlockAPI{
 		tmClient:             tmClient,
@@ -101,6 +106,7 @@ func NewSeiBlockAPI(
 		namespace:            SeiNamespace,
 		globalBlockCache:     globalBlockCache,
 		cacheCreationMutex:   cacheCreationMutex,
+		responseCache:        responseCache,
 	}
 	return &SeiBlockAPI{
 		BlockAPI:  blockAPI,
@@ -118,8 +124,9 @@ func NewSei2BlockAPI(
 	isPanicTx func(ctx context.Context, hash common.Hash) (bool, error),
 	globalBlockCache BlockCache,
 	cacheCreationMutex *sync.Mutex,
+	responseCache *ResponseCache,
 ) *SeiBlockAPI {
-	blockAPI := NewSeiBlockAPI(tmClient, k, ctxProvider, txConfigProvider, earliestVersion, connectionType, isPanicTx, globalBlockCache, cacheCreationMutex)
+	blockAPI := NewSeiBlockAPI(tmClient, k, ctxProvider, txConfigProvider, earliestVersion, connectionType, isPanicTx, globalBlockCache, cacheCreationMutex, responseCache)
 	blockAPI.namespace = Sei2Namespace
 	blockAPI.includeBankTransfers = true
 	return blockAPI
@@ -166,7 +173,12 @@ func (a *BlockAPI) GetBlockByHash(ctx context.Context, blockHash common.Hash, fu
 
 func (a *BlockAPI) getBlockByHash(ctx context.Context, blockHash common.Hash, fullTx bool, includeSyntheticTxs bool, isPanicTx func(ctx context.Context, hash common.Hash) (bool, error)) (result map[string]interface{}, returnErr error) {
 	startTime := time.Now()
-	defer recordMetrics(fmt.Sprintf("%s_getBlockByHash", a.namespace), a.connectionType, startTime)
+	cacheMethod := fmt.Sprintf("%s_getBlockByHash", a.namespace)
+	defer recordMetrics(cacheMethod, a.connectionType, startTime)
+	cacheKey := fmt.Sprintf("%s:%t:%t:%t", blockHash.Hex(), fullTx, includeSyntheticTxs, isPanicTx != nil)
+	if cached, ok := getCachedResponse[map[string]interface{}](a.responseCache, cacheMethod, cacheKey); ok {
+		return cached, nil
+	}
 	block, err := blockByHashWithRetry(ctx, a.tmClient, blockHash[:], 1)
 	if err != nil {
 		return nil, err
@@ -192,6 +204,7 @@ func (a *BlockAPI) getBlockByHash(ctx context.Context, blockHash common.Hash, fu
 	if err != nil {
 		return nil, err
 	}
+	addCachedResponse(a.responseCache, cacheMethod, cacheKey, block.Block.Height, a.ctxProvider(LatestCtxHeight).BlockHeight(), encodedBlock)
 	return encodedBlock, nil
 }
 
@@ -234,11 +247,21 @@ func (a *BlockAPI) getBlockByNumber(
 	isPanicTx func(ctx context.Context, hash common.Hash) (bool, error),
 ) (result map[string]interface{}, returnErr error) {
 	startTime := time.Now()
-	defer recordMetrics(fmt.Sprintf("%s_getBlockByNumber", a.namespace), a.connectionType, startTime)
+	cacheMethod := fmt.Sprintf("%s_getBlockByNumber", a.namespace)
+	defer recordMetrics(cacheMethod, a.connectionType, startTime)
 	numberPtr, err := getBlockNumber(ctx, a.tmClient, number)
 	if err != nil {
 		return nil, err
 	}
+	cacheKey := func(height int64) string {
+		return fmt.Sprintf("%d:%t:%t:%t", height, fullTx, includeSyntheticTxs, isPanicTx != nil)
+	}
+	// Tags like latest are resolved by tendermint, and only ever to blocks that aren't cached
+	if numberPtr != nil {
+		if cached, ok := getCachedResponse[map[string]interface{}](a.responseCache, cacheMethod, cacheKey(*numberPtr)); ok {
+			return cached, nil
+		}
+	}
 	block, err := blockByNumberWithRetry(ctx, a.tmClient, numberPtr, 1)
 	if err != nil {
 		return nil, err
@@ -264,10 +287,11 @@ func (a *BlockAPI) getBlockByNumber(
 	if err != nil {
 		return nil, err
 	}
+	addCachedResponse(a.responseCache, cacheMethod, cacheKey(block.Block.Height), block.Block.Height, a.ctxProvider(LatestCtxHeight).BlockHeight(), encodedBlock)
 	return encodedBlock, nil
 }
 
-func (a *BlockAPI) GetBlockReceipts(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (result []map[string]interface{}, returnErr error) {
+func (a *BlockAPI) GetBlockReceipts(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (result json.RawMessage, returnErr error) {
 	startTime := time.Now()
 	defer recordMetrics(fmt.Sprintf("%s_getBlockReceipts", a.namespace), a.connectionType, startTime)
 	// Get height from params
@@ -275,6 +299,12 @@ func (a *BlockAPI) GetBlockReceipts(ctx context.Context, blockNrOrHash rpc.Block
 	if err != nil {
 		return nil, err
 	}
+	cacheMethod := fmt.Sprintf("%s_getBlockReceipts", a.namespace)
+	if heightPtr != nil {
+		if cached, ok := getCachedResponse[json.RawMessage](a.responseCache, cacheMethod, fmt.Sprint(*heightPtr)); ok {
+			return cached, nil
+		}
+	}
 
 	block, err := blockByNumberWithRetry(ctx, a.tmClient, heightPtr, 1)
 	if err != nil {
@@ -347,7 +377,12 @@ func (a *BlockAPI) GetBlockReceipts(ctx context.Context, blockNrOrHash rpc.Block
 	if returnErr != nil {
 		return nil, returnErr
 	}
-	return compactReceipts, nil
+	result, returnErr = encodeArrayResult(ctx, compactReceipts)
+	if returnErr != nil {
+		return nil, returnErr
+	}
+	addCachedResponse(a.responseCache, cacheMethod, fmt.Sprint(height), height, a.ctxProvider(LatestCtxHeight).BlockHeight(), result)
+	return result, nil
 }
 
 func EncodeTmBlock(
@@ -78,15 +78,67 @@ type Config struct {
 	// Deny list defines list of methods that EVM RPC should fail fast
 	DenyList []string `mapstructure:"deny_list"`
 
+	// number of tokens added per second to the rate limit bucket of every client, where each
+	// call takes the weight of its method. Set to 0 to disable rate limiting.
+	RateLimitRequestsPerSecond float64 `mapstructure:"rate_limit_requests_per_second"`
+
+	// size of the rate limit bucket of every client
+	RateLimitBurst int `mapstructure:"rate_limit_burst"`
+
+	// what clients are rate limited by: "ip", "jwt" (bearer token subject) or "api_key"
+	RateLimitKey string `mapstructure:"rate_limit_key"`
+
+	// HMAC secret bearer tokens are verified with, required to rate limit by JWT
+	RateLimitJWTSecret string `mapstructure:"rate_limit_jwt_secret"`
+
+	// comma separated API keys clients are told apart by, required to rate limit by API key
+	RateLimitAPIKeys string `mapstructure:"rate_limit_api_keys"`
+
+	// header to read client IPs from when behind a proxy, e.g. "X-Forwarded-For"
+	RateLimitIPHeader string `mapstructure:"rate_limit_ip_header"`
+
+	// weights of methods for rate limiting as comma separated method:weight pairs. Other
+	// methods weigh 1.
+	RateLimitMethodWeights string `mapstructure:"rate_limit_method_weights"`
+
+	// max number of responses to eth_getBlockByNumber, eth_getBlockByHash,
+	// eth_getBlockReceipts and eth_getTransactionReceipt about blocks below the latest one
+	// to keep in memory. Set to 0 to disable the cache.
+	ResponseCacheSize int `mapstructure:"response_cache_size"`
+
+	// how long cached responses are kept
+	ResponseCacheTTL time.Duration `mapstructure:"response_cache_ttl"`
+
+	// max number of calls in a JSON-RPC batch. Set to 0 for unlimited.
+	BatchRequestLimit int `mapstructure:"batch_request_limit"`
+
+	// max total size in bytes of the results of a JSON-RPC batch. Set to 0 for unlimited.
+	BatchResponseMaxSize int `mapstructure:"batch_response_max_size"`
+
+	// max size in bytes of a single response. Set to 0 for unlimited.
+	MaxResponseSize int `mapstructure:"max_response_size"`
+
+	// max number of historical memiavl versions kept loaded to serve eth_getProof at
+	// heights no longer held by the commitment store. Set to 0 to disable such proofs.
+	MaxProofSnapshots int `mapstructure:"max_proof_snapshots"`
+
 	// max number of logs returned if block range is open-ended
 	MaxLogNoBlock int64 `mapstructure:"max_log_no_block"`
 
 	// max number of blocks to query logs for
 	MaxBlocksForLog int64 `mapstructure:"max_blocks_for_log"`
 
+	// controls whether receipts are indexed by log address and topics in receipt.db, so that
+	// filtered log queries only read the blocks with matching logs. Receipts stored before
+	// the index was enabled are backfilled in batches of 1000 with each receipt flush at commit.
+	LogIndexEnabled bool `mapstructure:"log_index_enabled"`
+
 	// max number of concurrent NewHead subscriptions
 	MaxSubscriptionsNewHead uint64 `mapstructure:"max_subscriptions_new_head"`
 
+	// max number of concurrent NewPendingTransactions subscriptions
+	MaxSubscriptionsPendingTxs uint64 `mapstructure:"max_subscriptions_pending_txs"`
+
 	// test api enables certain override apis for integration test situations
 	EnableTestAPI bool `mapstructure:"enable_test_api"`
 
@@ -135,9 +187,24 @@ var DefaultConfig = Config{
 	Slow:                         false,
 	FlushReceiptSync:             false,
 	DenyList:                     make([]string, 0),
+	RateLimitRequestsPerSecond:   0,
+	RateLimitBurst:               100,
+	RateLimitKey:                 RateLimitKeyIP,
+	RateLimitJWTSecret:           "",
+	RateLimitAPIKeys:             "",
+	RateLimitIPHeader:            "",
+	RateLimitMethodWeights:       "eth_getLogs:10,eth_call:2,eth_estimateGas:2,debug_traceTransaction:20,debug_traceCall:20,debug_traceBlockByNumber:50,debug_traceBlockByHash:50,trace_block:50,trace_filter:100",
+	ResponseCacheSize:            5000,
+	ResponseCacheTTL:             10 * time.Minute,
+	BatchRequestLimit:            1000,
+	BatchResponseMaxSize:         25 * 1000 * 1000,
+	MaxResponseSize:              0,
+	MaxProofSnapshots:            0,
 	MaxLogNoBlock:                10000,
 	MaxBlocksForLog:              2000,
+	LogIndexEnabled:              false,
 	MaxSubscriptionsNewHead:      10000,
+	MaxSubscriptionsPendingTxs:   1000,
 	EnableTestAPI:                false,
 	MaxConcurrentTraceCalls:      10,
 	MaxConcurrentSimulationCalls: runtime.NumCPU(),
@@ -167,9 +234,24 @@ const (
 	flagSlow                         = "evm.slow"
 	FlagFlushReceiptSync             = "evm.flush_receipt_sync"
 	flagDenyList                     = "evm.deny_list"
+	flagRateLimitRequestsPerSecond   = "evm.rate_limit_requests_per_second"
+	flagRateLimitBurst               = "evm.rate_limit_burst"
+	flagRateLimitKey                 = "evm.rate_limit_key"
+	flagRateLimitJWTSecret           = "evm.rate_limit_jwt_secret"
+	flagRateLimitAPIKeys             = "evm.rate_limit_api_keys"
+	flagRateLimitIPHeader            = "evm.rate_limit_ip_header"
+	flagRateLimitMethodWeights       = "evm.rate_limit_method_weights"
+	flagResponseCacheSize            = "evm.response_cache_size"
+	flagResponseCacheTTL             = "evm.response_cache_ttl"
+	flagBatchRequestLimit            = "evm.batch_request_limit"
+	flagBatchResponseMaxSize         = "evm.batch_response_max_size"
+	flagMaxResponseSize              = "evm.max_response_size"
+	flagMaxProofSnapshots            = "evm.max_proof_snapshots"
 	flagMaxLogNoBlock                = "evm.max_log_no_block"
 	flagMaxBlocksForLog              = "evm.max_blocks_for_log"
+	flagLogIndexEnabled              = "evm.log_index_enabled"
 	flagMaxSubscriptionsNewHead      = "evm.max_subscriptions_new_head"
+	flagMaxSubscriptionsPendingTxs   = "evm.max_subscriptions_pending_txs"
 	flagEnableTestAPI                = "evm.enable_test_api"
 	flagMaxConcurrentTraceCalls      = "evm.max_concurrent_trace_calls"
 	flagMaxConcurrentSimulationCalls = "evm.max_concurrent_simulation_calls"
@@ -273,6 +355,71 @@ func ReadConfig(opts servertypes.AppOptions) (Config, error) {
 			return cfg, err
 		}
 	}
+	if v := opts.Get(flagRateLimitRequestsPerSecond); v != nil {
+		if cfg.RateLimitRequestsPerSecond, err = cast.ToFloat64E(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagRateLimitBurst); v != nil {
+		if cfg.RateLimitBurst, err = cast.ToIntE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagRateLimitKey); v != nil {
+		if cfg.RateLimitKey, err = cast.ToStringE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagRateLimitJWTSecret); v != nil {
+		if cfg.RateLimitJWTSecret, err = cast.ToStringE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagRateLimitAPIKeys); v != nil {
+		if cfg.RateLimitAPIKeys, err = cast.ToStringE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagRateLimitIPHeader); v != nil {
+		if cfg.RateLimitIPHeader, err = cast.ToStringE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagRateLimitMethodWeights); v != nil {
+		if cfg.RateLimitMethodWeights, err = cast.ToStringE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagResponseCacheSize); v != nil {
+		if cfg.ResponseCacheSize, err = cast.ToIntE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagResponseCacheTTL); v != nil {
+		if cfg.ResponseCacheTTL, err = cast.ToDurationE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagBatchRequestLimit); v != nil {
+		if cfg.BatchRequestLimit, err = cast.ToIntE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagBatchResponseMaxSize); v != nil {
+		if cfg.BatchResponseMaxSize, err = cast.ToIntE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagMaxResponseSize); v != nil {
+		if cfg.MaxResponseSize, err = cast.ToIntE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagMaxProofSnapshots); v != nil {
+		if cfg.MaxProofSnapshots, err = cast.ToIntE(v); err != nil {
+			return cfg, err
+		}
+	}
 	if v := opts.Get(flagMaxLogNoBlock); v != nil {
 		if cfg.MaxLogNoBlock, err = cast.ToInt64E(v); err != nil {
 			return cfg, err
@@ -283,11 +430,21 @@ func ReadConfig(opts servertypes.AppOptions) (Config, error) {
 			return cfg, err
 		}
 	}
+	if v := opts.Get(flagLogIndexEnabled); v != nil {
+		if cfg.LogIndexEnabled, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
 	if v := opts.Get(flagMaxSubscriptionsNewHead); v != nil {
 		if cfg.MaxSubscriptionsNewHead, err = cast.ToUint64E(v); err != nil {
 			return cfg, err
 		}
 	}
+	if v := opts.Get(flagMaxSubscriptionsPendingTxs); v != nil {
+		if cfg.MaxSubscriptionsPendingTxs, err = cast.ToUint64E(v); err != nil {
+			return cfg, err
+		}
+	}
 	if v := opts.Get(flagEnableTestAPI); v != nil {
 		if cfg.EnableTestAPI, err = cast.ToBoolE(v); err != nil {
 			return cfg, err
@@ -399,15 +556,82 @@ slow = {{ .EVM.Slow }}
 # Deny list defines list of methods that EVM RPC should fail fast, e.g ["debug_traceBlockByNumber"]
 deny_list = {{ .EVM.DenyList }}
 
+# number of tokens added per second to the rate limit bucket of every client, where each
+# call takes the weight of its method. Requests over the limit are rejected with HTTP 429
+# and a JSON-RPC error on HTTP, and with a JSON-RPC error on websocket. Set to 0 to disable.
+rate_limit_requests_per_second = {{ .EVM.RateLimitRequestsPerSecond }}
+
+# size of the rate limit bucket of every client
+rate_limit_burst = {{ .EVM.RateLimitBurst }}
+
+# what clients are rate limited by: "ip", "jwt" (subject of the bearer token) or "api_key"
+# (X-API-Key header or apikey query parameter). "jwt" needs rate_limit_jwt_secret and only
+# uses tokens signed with it, "api_key" needs rate_limit_api_keys and only uses the keys in
+# it. Requests without a valid token or key are limited by IP.
+rate_limit_key = "{{ .EVM.RateLimitKey }}"
+
+# HMAC secret (HS256, HS384 or HS512) bearer tokens are verified with when rate limiting by JWT
+rate_limit_jwt_secret = "{{ .EVM.RateLimitJWTSecret }}"
+
+# comma separated API keys clients are rate limited by when rate limiting by API key
+rate_limit_api_keys = "{{ .EVM.RateLimitAPIKeys }}"
+
+# header to read client IPs from when behind a proxy, e.g. "X-Forwarded-For". The last
+# address in the header is used. Leave empty to use the address of the connection.
+rate_limit_ip_header = "{{ .EVM.RateLimitIPHeader }}"
+
+# weights of methods for rate limiting as comma separated method:weight pairs. Other
+# methods weigh 1.
+rate_limit_method_weights = "{{ .EVM.RateLimitMethodWeights }}"
+
+# max number of responses to eth_getBlockByNumber, eth_getBlockByHash, eth_getBlockReceipts
+# and eth_getTransactionReceipt (and their sei_ counterparts) about blocks below the latest
+# one to keep in memory. Such responses never change. Set to 0 to disable the cache.
+response_cache_size = {{ .EVM.ResponseCacheSize }}
+
+# how long cached responses are kept
+response_cache_ttl = "{{ .EVM.ResponseCacheTTL }}"
+
+# max number of calls in a JSON-RPC batch. Set to 0 for unlimited.
+batch_request_limit = {{ .EVM.BatchRequestLimit }}
+
+# max total size in bytes of the results of a JSON-RPC batch, past which the remaining
+# calls of the batch are answered with an error. Set to 0 for unlimited.
+batch_response_max_size = {{ .EVM.BatchResponseMaxSize }}
+
+# max size in bytes of a single response. Large array results like block traces and
+# receipts are encoded incrementally and stop with an error once they exceed it, and
+# websocket messages over it are replaced with an error. Set to 0 for unlimited, which
+# also keeps the stock go-ethereum websocket handler unless rate limiting is enabled.
+max_response_size = {{ .EVM.MaxResponseSize }}
+
+# max number of historical versions kept loaded to serve eth_getProof at heights no longer
+# held by the commitment store. Such versions are loaded from the retained memiavl snapshots
+# (see state-commit.sc-keep-recent), so proofs are available for any version from the
+# earliest retained snapshot on. Loading a version replays the changelog since its snapshot,
+# and loads are done one at a time, so only enable this on nodes meant to serve old proofs.
+# Set to 0 to disable proofs at those heights.
+max_proof_snapshots = {{ .EVM.MaxProofSnapshots }}
+
 # max number of logs returned if block range is open-ended
 max_log_no_block = {{ .EVM.MaxLogNoBlock }}
 
 # max number of blocks to query logs for
 max_blocks_for_log = {{ .EVM.MaxBlocksForLog }}
 
+# controls whether receipts are indexed by log address and topics in receipt.db, so that
+# filtered log queries only read the blocks with matching logs. With the index, max_blocks_for_log
+# limits the number of blocks with matching logs instead of the block range.
+# Receipts stored before the index was enabled are backfilled in batches of 1000 with each
+# receipt flush at block commit, which adds to commit time until the backfill completes.
+log_index_enabled = {{ .EVM.LogIndexEnabled }}
+
 # max number of concurrent NewHead subscriptions
 max_subscriptions_new_head = {{ .EVM.MaxSubscriptionsNewHead }}
 
+# max number of concurrent NewPendingTransactions subscriptions
+max_subscriptions_pending_txs = {{ .EVM.MaxSubscriptionsPendingTxs }}
+
 # MaxConcurrentTraceCalls defines the maximum number of concurrent debug_trace calls.
 # Set to 0 for unlimited.
 max_concurrent_trace_calls = {{ .EVM.MaxConcurrentTraceCalls }}
@@ -27,9 +27,24 @@ type opts struct {
 	slow                         interface{}
 	flushReceiptSync             interface{}
 	denyList                     interface{}
+	rateLimitRequestsPerSecond   interface{}
+	rateLimitBurst               interface{}
+	rateLimitKey                 interface{}
+	rateLimitJWTSecret           interface{}
+	rateLimitAPIKeys             interface{}
+	rateLimitIPHeader            interface{}
+	rateLimitMethodWeights       interface{}
+	responseCacheSize            interface{}
+	responseCacheTTL             interface{}
+	batchRequestLimit            interface{}
+	batchResponseMaxSize         interface{}
+	maxResponseSize              interface{}
+	maxProofSnapshots            interface{}
 	maxLogNoBlock                interface{}
 	maxBlocksForLog              interface{}
+	logIndexEnabled              interface{}
 	maxSubscriptionsNewHead      interface{}
+	maxSubscriptionsPendingTxs   interface{}
 	enableTestAPI                interface{}
 	maxConcurrentTraceCalls      interface{}
 	maxConcurrentSimulationCalls interface{}
@@ -95,15 +110,60 @@ func (o *opts) Get(k string) interface{} {
 	if k == "evm.deny_list" {
 		return o.denyList
 	}
+	if k == "evm.rate_limit_requests_per_second" {
+		return o.rateLimitRequestsPerSecond
+	}
+	if k == "evm.rate_limit_burst" {
+		return o.rateLimitBurst
+	}
+	if k == "evm.rate_limit_key" {
+		return o.rateLimitKey
+	}
+	if k == "evm.rate_limit_jwt_secret" {
+		return o.rateLimitJWTSecret
+	}
+	if k == "evm.rate_limit_api_keys" {
+		return o.rateLimitAPIKeys
+	}
+	if k == "evm.rate_limit_ip_header" {
+		return o.rateLimitIPHeader
+	}
+	if k == "evm.rate_limit_method_weights" {
+		return o.rateLimitMethodWeights
+	}
+	if k == "evm.response_cache_size" {
+		return o.responseCacheSize
+	}
+	if k == "evm.response_cache_ttl" {
+		return o.responseCacheTTL
+	}
+	if k == "evm.batch_request_limit" {
+		return o.batchRequestLimit
+	}
+	if k == "evm.batch_response_max_size" {
+		return o.batchResponseMaxSize
+	}
+	if k == "evm.max_response_size" {
+		return o.maxResponseSize
+	}
+	if k == "evm.max_proof_snapshots" {
+		return o.maxProofSnapshots
+	}
 	if k == "evm.max_log_no_block" {
 		return o.maxLogNoBlock
 	}
 	if k == "evm.max_blocks_for_log" {
 		return o.maxBlocksForLog
 	}
+	if k == "evm.log_index_enabled" {
+		return o.logIndexEnabled
+	}
 	if k == "evm.max_subscriptions_new_head" {
 		return o.maxSubscriptionsNewHead
 	}
+	if k == "evm.max_subscriptions_pending_txs" {
+		return o.maxSubscriptionsPendingTxs
+	}
 	if k == "evm.enable_test_api" {
 		return o.enableTestAPI
 	}
@@ -152,9 +212,24 @@ func getDefaultOpts() opts {
 		false,
 		false,
 		make([]string, 0),
+		float64(0),
+		100,
+		"ip",
+		"",
+		"",
+		"",
+		"eth_getLogs:10",
+		5000,
+		10 * time.Minute,
+		1000,
+		25000000,
+		100000000,
+		2,
 		20000,
 		1000,
+		true,
 		10000,
+		1000,
 		false,
 		uint64(10),
 		uint64(10),
@@ -238,6 +313,30 @@ func TestReadConfig(t *testing.T) {
 	badOpts.denyList = map[string]interface{}{}
 	_, err = evmrpc.ReadConfig(&badOpts)
 	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.rateLimitRequestsPerSecond = "bad"
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.rateLimitBurst = "bad"
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.rateLimitJWTSecret = map[string]interface{}{}
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.rateLimitAPIKeys = map[string]interface{}{}
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.logIndexEnabled = "bad"
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.maxSubscriptionsPendingTxs = "bad"
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
 
 	// Test bad types for new trace config options
 	badOpts = goodOpts
@@ -547,7 +547,7 @@ func (a *FilterAPI) GetLogs(ctx context.Context, crit filters.FilterCriteria) (r
 	blockRange := end - begin + 1
 
 	// Use config value instead of hardcoded constant
-	if blockRange > a.filterConfig.maxBlock {
+	if blockRange > a.filterConfig.maxBlock && !a.logFetcher.canUseLogIndex(crit, begin) {
 		return nil, fmt.Errorf("block range too large (%d), maximum allowed is %d blocks", blockRange, a.filterConfig.maxBlock)
 	}
 
@@ -681,14 +681,22 @@ func (f *LogFetcher) GetLogsByFilters(ctx context.Context, crit filters.FilterCr
 	}
 
 	blockRange := end - begin + 1
+	useLogIndex := f.canUseLogIndex(crit, begin)
 
 	// Use config value instead of hardcoded constant
-	if blockRange > f.filterConfig.maxBlock {
+	if blockRange > f.filterConfig.maxBlock && !useLogIndex {
 		return nil, 0, fmt.Errorf("block range too large (%d), maximum allowed is %d blocks", blockRange, f.filterConfig.maxBlock)
 	}
 
 	bloomIndexes := EncodeFilters(crit.Addresses, crit.Topics)
-	blocks, end, applyOpenEndedLogLimit, err := f.fetchBlocksByCrit(ctx, crit, lastToHeight, bloomIndexes)
+	var blocks chan *coretypes.ResultBlock
+	var applyOpenEndedLogLimit bool
+	var err error
+	if useLogIndex {
+		blocks, end, applyOpenEndedLogLimit, err = f.fetchBlocksByLogIndex(ctx, crit, begin, end, bloomIndexes)
+	} else {
+		blocks, end, applyOpenEndedLogLimit, err = f.fetchBlocksByCrit(ctx, crit, lastToHeight, bloomIndexes)
+	}
 	if err != nil {
 		return nil, 0, err
 	}
@@ -1009,6 +1017,86 @@ func (f *LogFetcher) fetchBlocksByCrit(ctx context.Context, crit filters.FilterC
 	return res, end, applyOpenEndedLogLimit, nil
 }
 
+// canUseLogIndex returns whether the blocks of a filter from the given height on can be
+// looked up in the log index instead of scanning the block range
+func (f *LogFetcher) canUseLogIndex(crit filters.FilterCriteria, begin int64) bool {
+	if crit.BlockHash != nil {
+		return false
+	}
+	hasTopics := false
+	for _, topicList := range crit.Topics {
+		if len(topicList) > 0 {
+			hasTopics = true
+			break
+		}
+	}
+	if len(crit.Addresses) == 0 && !hasTopics {
+		return false
+	}
+	return f.k.LogIndexCovers(f.ctxProvider(LatestCtxHeight), begin)
+}
+
+// fetchBlocksByLogIndex fetches only the blocks the log index has a candidate log for. The
+// block limit applies to the number of candidate blocks instead of the block range, open-ended
+// queries keep the latest candidate blocks.
+func (f *LogFetcher) fetchBlocksByLogIndex(ctx context.Context, crit filters.FilterCriteria, begin int64, end int64, bloomIndexes [][]bloomIndexes) (chan *coretypes.ResultBlock, int64, bool, error) {
+	if begin > end {
+		return nil, 0, false, fmt.Errorf("fromBlock %d is after toBlock %d", begin, end)
+	}
+
+	applyOpenEndedLogLimit := f.filterConfig.maxLog > 0 && (crit.FromBlock == nil || crit.ToBlock == nil)
+	heights, err := f.k.GetLogIndexHeights(crit.Addresses, crit.Topics, begin, end, int(f.filterConfig.maxBlock))
+	if err != nil {
+		return nil, 0, false, err
+	}
+	if f.filterConfig.maxBlock > 0 && int64(len(heights)) > f.filterConfig.maxBlock {
+		if !applyOpenEndedLogLimit {
+			return nil, 0, false, fmt.Errorf("too many blocks with matching logs, maximum allowed is %d blocks", f.filterConfig.maxBlock)
+		}
+		heights = heights[int64(len(heights))-f.filterConfig.maxBlock:]
+	}
+
+	res := make(chan *coretypes.ResultBlock, len(heights))
+	errChan := make(chan error, 1)
+	runner := GetGlobalWorkerPool()
+	var wg sync.WaitGroup
+
+	for batchStart := 0; batchStart < len(heights); batchStart += WorkerBatchSize {
+		batch := heights[batchStart:min(batchStart+WorkerBatchSize, len(heights))]
+
+		wg.Add(1)
+		if err := runner.Submit(func() {
+			defer wg.Done()
+			for _, height := range batch {
+				f.processBatch(ctx, height, height, crit, bloomIndexes, res, errChan)
+			}
+		}); err != nil {
+			wg.Done()
+			return nil, 0, false, fmt.Errorf("system overloaded, please reduce request frequency: %w", err)
+		}
+	}
+
+	go func() {
+		defer recoverAndLog()
+		wg.Wait()
+		close(res)
+		close(errChan)
+	}()
+
+	var firstErr error
+	for err := range errChan {
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	if firstErr != nil {
+		return nil, 0, false, firstErr
+	}
+
+	return res, end, applyOpenEndedLogLimit, nil
+}
+
 // Batch processing function for blocks
 func (f *LogFetcher) processBatch(ctx context.Context, start, end int64, crit filters.FilterCriteria, bloomIndexes [][]bloomIndexes, res chan *coretypes.ResultBlock, errChan chan error) {
 	defer func() {
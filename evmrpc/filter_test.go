@@ -474,3 +474,26 @@ func TestCollectLogsEvmTransactionIndex(t *testing.T) {
 			"log %d should not use absolute transaction index %d", i, i+10)
 	}
 }
+
+func TestGetLogsFromLogIndex(t *testing.T) {
+	t.Parallel()
+	// The range exceeds max_blocks_for_log, the log index narrows it down to the matching blocks
+	filterCriteria := map[string]interface{}{
+		"fromBlock": "0x1",
+		"toBlock":   "0x100000",
+		"address":   []common.Address{common.HexToAddress("0x1111111111111111111111111111111111111112")},
+	}
+	resObj := sendRequestGood(t, "getLogs", filterCriteria)
+	logs := resObj["result"].([]interface{})
+	require.Len(t, logs, 2)
+	for _, logInterface := range logs {
+		log := logInterface.(map[string]interface{})
+		require.Equal(t, "0x1111111111111111111111111111111111111112", log["address"].(string))
+		require.Equal(t, "0x2", log["blockNumber"].(string))
+	}
+
+	// Unfiltered queries still scan the range
+	delete(filterCriteria, "address")
+	resObj = sendRequestGood(t, "getLogs", filterCriteria)
+	require.Contains(t, resObj["error"].(map[string]interface{})["message"].(string), "block range too large")
+}
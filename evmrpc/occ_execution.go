@@ -0,0 +1,116 @@
+package evmrpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/tasks"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	coretypes "github.com/tendermint/tendermint/rpc/coretypes"
+)
+
+// BlockExecutionResult is how the txs of a block were executed by the OCC scheduler
+type BlockExecutionResult struct {
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	BlockHash   common.Hash    `json:"blockHash"`
+	// Number of rounds of execution and validation the scheduler went through
+	Iterations int `json:"iterations"`
+	// Whether the scheduler fell back to executing txs one at a time because of conflicts
+	Synchronous  bool                `json:"synchronous"`
+	Transactions []TxExecutionResult `json:"transactions"`
+}
+
+type TxExecutionResult struct {
+	TxIndex hexutil.Uint64 `json:"txIndex"`
+	// Tendermint hash of the tx
+	TxHash common.Hash `json:"txHash"`
+	// Ethereum hash of the tx, if it is an EVM tx
+	EthTxHash    *common.Hash    `json:"ethTxHash,omitempty"`
+	Incarnations int             `json:"incarnations"`
+	Aborts       []TxAbortResult `json:"aborts"`
+}
+
+type TxAbortResult struct {
+	Incarnation     int                    `json:"incarnation"`
+	Reason          string                 `json:"reason"`
+	DependentTxs    []int                  `json:"dependentTxs"`
+	ConflictingKeys []ConflictingKeyResult `json:"conflictingKeys"`
+}
+
+type ConflictingKeyResult struct {
+	Store string        `json:"store"`
+	Key   hexutil.Bytes `json:"key"`
+}
+
+// TraceBlockExecution returns the incarnations of each tx of a block as executed by the OCC
+// scheduler, along with why earlier incarnations were aborted and the keys they conflicted
+// on. Executions are only kept in memory for the most recent blocks processed by this node.
+func (api *SeiDebugAPI) TraceBlockExecution(ctx context.Context, number rpc.BlockNumber) (result *BlockExecutionResult, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("sei_traceBlockExecution", api.connectionType, startTime)
+	numberPtr, err := getBlockNumber(ctx, api.tmClient, number)
+	if err != nil {
+		return nil, err
+	}
+	block, err := blockByNumberWithRetry(ctx, api.tmClient, numberPtr, 1)
+	if err != nil {
+		return nil, err
+	}
+	var execution tasks.BlockExecution
+	found := false
+	if api.backend.app != nil {
+		execution, found = api.backend.app.OccExecution(block.Block.Height)
+	}
+	if !found {
+		return nil, fmt.Errorf("execution of block %d is not available, it was either not processed with OCC by this node or is no longer kept", block.Block.Height)
+	}
+	return api.encodeBlockExecution(block, execution), nil
+}
+
+func (api *SeiDebugAPI) encodeBlockExecution(block *coretypes.ResultBlock, execution tasks.BlockExecution) *BlockExecutionResult {
+	decoder := api.txConfigProvider(block.Block.Height).TxDecoder()
+	txs := make([]TxExecutionResult, 0, len(execution.Txs))
+	for _, tx := range execution.Txs {
+		res := TxExecutionResult{
+			TxIndex:      hexutil.Uint64(tx.AbsoluteIndex), //nolint:gosec
+			Incarnations: tx.Incarnations,
+			Aborts:       make([]TxAbortResult, 0, len(tx.Aborts)),
+		}
+		if tx.AbsoluteIndex < len(block.Block.Txs) {
+			txBz := block.Block.Txs[tx.AbsoluteIndex]
+			res.TxHash = sha256.Sum256(txBz)
+			if ethtx := getEthTxForTxBz(txBz, decoder); ethtx != nil {
+				hash := ethtx.Hash()
+				res.EthTxHash = &hash
+			}
+		}
+		for _, abort := range tx.Aborts {
+			keys := make([]ConflictingKeyResult, 0, len(abort.ConflictingKeys))
+			for _, key := range abort.ConflictingKeys {
+				keys = append(keys, ConflictingKeyResult{Store: key.StoreKey, Key: key.Key})
+			}
+			dependentTxs := abort.DependentTxs
+			if dependentTxs == nil {
+				dependentTxs = []int{}
+			}
+			res.Aborts = append(res.Aborts, TxAbortResult{
+				Incarnation:     abort.Incarnation,
+				Reason:          abort.Reason,
+				DependentTxs:    dependentTxs,
+				ConflictingKeys: keys,
+			})
+		}
+		txs = append(txs, res)
+	}
+	return &BlockExecutionResult{
+		BlockNumber:  hexutil.Uint64(block.Block.Height), //nolint:gosec
+		BlockHash:    common.HexToHash(block.BlockID.Hash.String()),
+		Iterations:   execution.Iterations,
+		Synchronous:  execution.Synchronous,
+		Transactions: txs,
+	}
+}
@@ -0,0 +1,140 @@
+package evmrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/storev2/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sctypes "github.com/sei-protocol/sei-db/sc/types"
+)
+
+// Max time a request waits for other historical versions to load before giving up
+const ProofSnapshotLoadWait = 10 * time.Second
+
+// ProofSnapshots serves eth_getProof at heights no longer held by the commitment store, by
+// loading the memiavl tree of the requested version from the closest retained snapshot and
+// replaying the changelog up to it. Loading a version is expensive, so the most recently used
+// versions are kept open. A nil ProofSnapshots serves nothing.
+type ProofSnapshots struct {
+	// loadSem serializes loads so that concurrent requests don't load the same version twice
+	loadSem chan struct{}
+	mtx     sync.Mutex
+	size    int
+	load    func(version int64) (sctypes.Committer, error)
+	// most recently used first
+	snapshots []*proofSnapshot
+}
+
+type proofSnapshot struct {
+	version int64
+	store   sctypes.Committer
+	// number of requests using the snapshot, which is only closed once unused and evicted
+	refs    int
+	evicted bool
+}
+
+// NewProofSnapshots returns a cache of at most size historical versions loaded from the
+// given commitment store. It returns nil if size is not positive.
+func NewProofSnapshots(commitStore sctypes.Committer, size int) *ProofSnapshots {
+	if size <= 0 || commitStore == nil {
+		return nil
+	}
+	return newProofSnapshots(size, func(version int64) (sctypes.Committer, error) {
+		return commitStore.LoadVersion(version, true)
+	})
+}
+
+func newProofSnapshots(size int, load func(version int64) (sctypes.Committer, error)) *ProofSnapshots {
+	return &ProofSnapshots{loadSem: make(chan struct{}, 1), size: size, load: load}
+}
+
+// NewProofSnapshotsFromConfig returns the proof snapshots configured for an EVM RPC server. It
+// returns nil if the app does not use memiavl as its commitment store.
+func NewProofSnapshotsFromConfig(config Config, cms sdk.CommitMultiStore) *ProofSnapshots {
+	rs, ok := cms.(*rootmulti.Store)
+	if !ok {
+		return nil
+	}
+	return NewProofSnapshots(rs.GetCommitter(), config.MaxProofSnapshots)
+}
+
+// acquire returns the commitment store at the given version, which must be released once
+// the caller is done with it. Waiting for other loads to finish gives up once ctx is done or
+// after ProofSnapshotLoadWait, so that requests for many old heights cannot pile up.
+func (p *ProofSnapshots) acquire(ctx context.Context, version int64) (*proofSnapshot, error) {
+	if p == nil {
+		return nil, errors.New("historical proofs are disabled")
+	}
+	if snapshot := p.get(version); snapshot != nil {
+		return snapshot, nil
+	}
+	timer := time.NewTimer(ProofSnapshotLoadWait)
+	defer timer.Stop()
+	select {
+	case p.loadSem <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-timer.C:
+		return nil, fmt.Errorf("timed out after %s waiting for other historical versions to load", ProofSnapshotLoadWait)
+	}
+	defer func() { <-p.loadSem }()
+	// the version may have been loaded while waiting
+	if snapshot := p.get(version); snapshot != nil {
+		return snapshot, nil
+	}
+	store, err := p.load(version)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := &proofSnapshot{version: version, store: store, refs: 1}
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.snapshots = append([]*proofSnapshot{snapshot}, p.snapshots...)
+	for len(p.snapshots) > p.size {
+		evicted := p.snapshots[len(p.snapshots)-1]
+		p.snapshots = p.snapshots[:len(p.snapshots)-1]
+		evicted.evicted = true
+		if evicted.refs == 0 {
+			_ = evicted.store.Close()
+		}
+	}
+	return snapshot, nil
+}
+
+func (p *ProofSnapshots) get(version int64) *proofSnapshot {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	for i, snapshot := range p.snapshots {
+		if snapshot.version != version {
+			continue
+		}
+		copy(p.snapshots[1:i+1], p.snapshots[:i])
+		p.snapshots[0] = snapshot
+		snapshot.refs++
+		return snapshot
+	}
+	return nil
+}
+
+func (p *ProofSnapshots) release(snapshot *proofSnapshot) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	snapshot.refs--
+	if snapshot.evicted && snapshot.refs == 0 {
+		_ = snapshot.store.Close()
+	}
+}
+
+// Len returns the number of versions kept open
+func (p *ProofSnapshots) Len() int {
+	if p == nil {
+		return 0
+	}
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	return len(p.snapshots)
+}
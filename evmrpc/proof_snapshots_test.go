@@ -0,0 +1,99 @@
+package evmrpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	sctypes "github.com/sei-protocol/sei-db/sc/types"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeCommitter struct {
+	sctypes.Committer
+	version int64
+	closed  bool
+}
+
+func (c *fakeCommitter) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestProofSnapshots(t *testing.T) {
+	loaded := map[int64]*fakeCommitter{}
+	snapshots := newProofSnapshots(2, func(version int64) (sctypes.Committer, error) {
+		if version > 10 {
+			return nil, errors.New("target version is pruned")
+		}
+		loaded[version] = &fakeCommitter{version: version}
+		return loaded[version], nil
+	})
+
+	ctx := context.Background()
+	first, err := snapshots.acquire(ctx, 1)
+	require.NoError(t, err)
+	snapshots.release(first)
+	again, err := snapshots.acquire(ctx, 1)
+	require.NoError(t, err)
+	require.Same(t, first, again, "loaded versions should be reused")
+	snapshots.release(again)
+
+	second, err := snapshots.acquire(ctx, 2)
+	require.NoError(t, err)
+	snapshots.release(second)
+	// version 1 is more recently used than version 2, which is evicted first
+	_, err = snapshots.acquire(ctx, 1)
+	require.NoError(t, err)
+	third, err := snapshots.acquire(ctx, 3)
+	require.NoError(t, err)
+	require.Equal(t, 2, snapshots.Len())
+	require.True(t, loaded[2].closed)
+	// versions still in use are only closed once released
+	fourth, err := snapshots.acquire(ctx, 4)
+	require.NoError(t, err)
+	require.False(t, loaded[1].closed)
+	snapshots.release(first)
+	require.True(t, loaded[1].closed)
+	require.False(t, loaded[3].closed)
+	snapshots.release(third)
+	snapshots.release(fourth)
+
+	_, err = snapshots.acquire(ctx, 11)
+	require.Error(t, err)
+	require.Equal(t, 2, snapshots.Len())
+}
+
+func TestProofSnapshotsDisabled(t *testing.T) {
+	require.Nil(t, NewProofSnapshots(&fakeCommitter{}, 0))
+	require.Nil(t, NewProofSnapshots(nil, 2))
+	var snapshots *ProofSnapshots
+	_, err := snapshots.acquire(context.Background(), 1)
+	require.Error(t, err)
+	require.Zero(t, snapshots.Len())
+}
+
+func TestProofSnapshotsLoadWait(t *testing.T) {
+	loading := make(chan struct{})
+	done := make(chan struct{})
+	snapshots := newProofSnapshots(2, func(version int64) (sctypes.Committer, error) {
+		close(loading)
+		<-done
+		return &fakeCommitter{version: version}, nil
+	})
+	go func() {
+		snapshot, err := snapshots.acquire(context.Background(), 1)
+		if err == nil {
+			snapshots.release(snapshot)
+		}
+	}()
+	<-loading
+
+	// Requests waiting for the load of another version give up with their context
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := snapshots.acquire(ctx, 2)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	close(done)
+}
@@ -0,0 +1,356 @@
+package evmrpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"github.com/sei-protocol/sei-chain/utils/metrics"
+	"golang.org/x/time/rate"
+)
+
+// What clients are told apart by for rate limiting
+const (
+	RateLimitKeyIP     = "ip"
+	RateLimitKeyJWT    = "jwt"
+	RateLimitKeyAPIKey = "api_key"
+)
+
+const (
+	// APIKeyHeader carries the API key of a client. Websocket clients in browsers cannot set
+	// headers, so the key can also be passed as the APIKeyQueryParam query parameter.
+	APIKeyHeader     = "X-API-Key"
+	APIKeyQueryParam = "apikey"
+
+	// Max number of clients whose token buckets are kept at once
+	RateLimitMaxClients = 100_000
+
+	// JSON-RPC error code for exceeded limits, as defined by EIP-1474
+	RateLimitErrCode = -32005
+
+	DefaultRateLimitMethodWeight = 1
+
+	// Only this much of an HTTP body is read to find the methods of the request, which is
+	// the default body limit of the RPC server
+	rateLimitMaxBodySize = 5 * 1024 * 1024
+)
+
+type RateLimitConfig struct {
+	// Tokens added to the bucket of every client per second. 0 disables rate limiting.
+	RequestsPerSecond float64
+	// Size of the bucket of every client
+	Burst int
+	// One of RateLimitKeyIP, RateLimitKeyJWT and RateLimitKeyAPIKey
+	Key string
+	// Header the client IP is read from, e.g. X-Forwarded-For behind a proxy. The remote
+	// address of the connection is used if empty.
+	IPHeader string
+	// Number of tokens a call of a method costs, DefaultRateLimitMethodWeight if not set
+	MethodWeights map[string]int
+	// HMAC secret the bearer tokens are signed with, required for RateLimitKeyJWT
+	JWTSecret []byte
+	// API keys clients are told apart by, required for RateLimitKeyAPIKey
+	APIKeys map[string]struct{}
+}
+
+// RateLimiter limits the calls of every client with a token bucket, where each call costs
+// the weight of its method. Buckets of clients that have been idle for long enough to have
+// refilled are dropped, since a new bucket is full too.
+type RateLimiter struct {
+	config  RateLimitConfig
+	mtx     sync.Mutex
+	buckets *expirable.LRU[string, *rate.Limiter]
+}
+
+type rpcCall struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+}
+
+type rpcErrorMessage struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Error   rpcError        `json:"error"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func NewRateLimiter(config RateLimitConfig) (*RateLimiter, error) {
+	if config.RequestsPerSecond <= 0 {
+		return nil, fmt.Errorf("rate limit must be positive, got %v", config.RequestsPerSecond)
+	}
+	if config.Burst <= 0 {
+		return nil, fmt.Errorf("rate limit burst must be positive, got %d", config.Burst)
+	}
+	switch config.Key {
+	case RateLimitKeyIP:
+	case RateLimitKeyJWT:
+		if len(config.JWTSecret) == 0 {
+			return nil, errors.New("rate limiting by JWT needs a JWT secret")
+		}
+	case RateLimitKeyAPIKey:
+		if len(config.APIKeys) == 0 {
+			return nil, errors.New("rate limiting by API key needs a list of API keys")
+		}
+	default:
+		return nil, fmt.Errorf("unknown rate limit key %q", config.Key)
+	}
+	refillTime := time.Duration(float64(config.Burst) / config.RequestsPerSecond * float64(time.Second))
+	return &RateLimiter{
+		config:  config,
+		buckets: expirable.NewLRU[string, *rate.Limiter](RateLimitMaxClients, nil, max(refillTime, time.Second)),
+	}, nil
+}
+
+// NewRateLimiterFromConfig returns the rate limiter configured for the EVM RPC servers, or
+// nil if rate limiting is disabled
+func NewRateLimiterFromConfig(config Config) (*RateLimiter, error) {
+	if config.RateLimitRequestsPerSecond <= 0 {
+		return nil, nil
+	}
+	weights, err := ParseMethodWeights(config.RateLimitMethodWeights)
+	if err != nil {
+		return nil, err
+	}
+	return NewRateLimiter(RateLimitConfig{
+		RequestsPerSecond: config.RateLimitRequestsPerSecond,
+		Burst:             config.RateLimitBurst,
+		Key:               config.RateLimitKey,
+		IPHeader:          config.RateLimitIPHeader,
+		MethodWeights:     weights,
+		JWTSecret:         []byte(config.RateLimitJWTSecret),
+		APIKeys:           ParseAPIKeys(config.RateLimitAPIKeys),
+	})
+}
+
+// ParseAPIKeys parses comma separated API keys
+func ParseAPIKeys(s string) map[string]struct{} {
+	apiKeys := map[string]struct{}{}
+	for _, apiKey := range strings.Split(s, ",") {
+		if apiKey = strings.TrimSpace(apiKey); apiKey != "" {
+			apiKeys[apiKey] = struct{}{}
+		}
+	}
+	return apiKeys
+}
+
+// ParseMethodWeights parses comma separated method:weight pairs,
+// e.g. "eth_getLogs:10,debug_traceTransaction:20"
+func ParseMethodWeights(s string) (map[string]int, error) {
+	weights := map[string]int{}
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		method, weightStr, found := strings.Cut(pair, ":")
+		if !found {
+			return nil, fmt.Errorf("invalid method weight %q, expected method:weight", pair)
+		}
+		weight, err := strconv.Atoi(strings.TrimSpace(weightStr))
+		if err != nil || weight < 0 {
+			return nil, fmt.Errorf("invalid weight for method %s: %q", method, weightStr)
+		}
+		weights[strings.TrimSpace(method)] = weight
+	}
+	return weights, nil
+}
+
+// Allow takes the tokens for the given calls from the bucket of a client. If there are not
+// enough, nothing is taken and the time until there will be is returned. Calls costing more
+// than the bucket size are allowed once the bucket is full.
+func (l *RateLimiter) Allow(clientKey string, methods []string) (bool, time.Duration) {
+	cost := 0
+	for _, method := range methods {
+		cost += l.weight(method)
+	}
+	cost = min(cost, l.config.Burst)
+	if cost == 0 {
+		return true, 0
+	}
+
+	now := time.Now()
+	reservation := l.bucket(clientKey).ReserveN(now, cost)
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		reservation.CancelAt(now)
+		return false, delay
+	}
+	return true, 0
+}
+
+func (l *RateLimiter) weight(method string) int {
+	if weight, ok := l.config.MethodWeights[method]; ok {
+		return weight
+	}
+	return DefaultRateLimitMethodWeight
+}
+
+func (l *RateLimiter) bucket(clientKey string) *rate.Limiter {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	bucket, ok := l.buckets.Get(clientKey)
+	if !ok {
+		bucket = rate.NewLimiter(rate.Limit(l.config.RequestsPerSecond), l.config.Burst)
+	}
+	// Re-adding resets the expiry, so that buckets in use are never dropped
+	l.buckets.Add(clientKey, bucket)
+	return bucket
+}
+
+// ClientKey returns the key of the client that sent a request, prefixed with its type. Only
+// the subjects of bearer tokens signed with the JWT secret and the configured API keys are
+// used, since clients could otherwise get a fresh bucket with every request. Requests without
+// one are keyed by IP.
+func (l *RateLimiter) ClientKey(r *http.Request) string {
+	switch l.config.Key {
+	case RateLimitKeyJWT:
+		if subject := l.jwtSubject(r); subject != "" {
+			return RateLimitKeyJWT + ":" + subject
+		}
+	case RateLimitKeyAPIKey:
+		apiKey := r.Header.Get(APIKeyHeader)
+		if apiKey == "" {
+			apiKey = r.URL.Query().Get(APIKeyQueryParam)
+		}
+		if _, ok := l.config.APIKeys[apiKey]; ok {
+			return RateLimitKeyAPIKey + ":" + apiKey
+		}
+	}
+	return RateLimitKeyIP + ":" + l.clientIP(r)
+}
+
+func (l *RateLimiter) clientIP(r *http.Request) string {
+	if l.config.IPHeader != "" {
+		// The last address is the one appended by the proxy, which the client cannot forge
+		if addresses := strings.Split(r.Header.Get(l.config.IPHeader), ","); len(addresses) > 0 {
+			if ip := strings.TrimSpace(addresses[len(addresses)-1]); ip != "" {
+				return ip
+			}
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// jwtSubject returns the subject of the bearer token of a request if the token is signed with
+// the JWT secret and not expired
+func (l *RateLimiter) jwtSubject(r *http.Request) string {
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "Bearer ") {
+		return ""
+	}
+	claims := jwt.RegisteredClaims{}
+	parser := jwt.NewParser(jwt.WithValidMethods([]string{
+		jwt.SigningMethodHS256.Alg(), jwt.SigningMethodHS384.Alg(), jwt.SigningMethodHS512.Alg(),
+	}))
+	keyFunc := func(*jwt.Token) (interface{}, error) { return l.config.JWTSecret, nil }
+	if _, err := parser.ParseWithClaims(strings.TrimPrefix(auth, "Bearer "), &claims, keyFunc); err != nil {
+		return ""
+	}
+	return claims.Subject
+}
+
+// allowMessage checks a JSON-RPC message against the limit of a client. If it is rejected,
+// the error response to send back is returned as well.
+func (l *RateLimiter) allowMessage(clientKey string, msg []byte, connectionType ConnectionType) (bool, time.Duration, interface{}) {
+	calls, batch := parseRPCCalls(msg)
+	methods := make([]string, len(calls))
+	for i, call := range calls {
+		methods[i] = call.Method
+	}
+	allowed, retryAfter := l.Allow(clientKey, methods)
+	if allowed {
+		return true, 0, nil
+	}
+
+	keyType, _, _ := strings.Cut(clientKey, ":")
+	responses := make([]rpcErrorMessage, len(calls))
+	for i, call := range calls {
+		method := call.Method
+		if method == "" {
+			method = "unknown"
+		}
+		metrics.IncrementRpcRateLimitedCounter(method, string(connectionType), keyType)
+		responses[i] = rpcErrorMessage{
+			Version: "2.0",
+			ID:      call.ID,
+			Error:   rpcError{Code: RateLimitErrCode, Message: "rate limit exceeded"},
+		}
+	}
+	if !batch {
+		return false, retryAfter, responses[0]
+	}
+	return false, retryAfter, responses
+}
+
+// parseRPCCalls returns the calls of a single or batch JSON-RPC message. A message that
+// cannot be parsed counts as a single call without a method.
+func parseRPCCalls(msg []byte) ([]rpcCall, bool) {
+	msg = bytes.TrimLeft(msg, " \t\r\n")
+	if len(msg) > 0 && msg[0] == '[' {
+		var calls []rpcCall
+		if err := json.Unmarshal(msg, &calls); err == nil && len(calls) > 0 {
+			return calls, true
+		}
+		return []rpcCall{{}}, false
+	}
+	var call rpcCall
+	_ = json.Unmarshal(msg, &call)
+	return []rpcCall{call}, false
+}
+
+type rateLimitHandler struct {
+	limiter        *RateLimiter
+	connectionType ConnectionType
+	next           http.Handler
+}
+
+// newRateLimitHandler rejects HTTP requests of clients over their limit with a 429 status
+// and a JSON-RPC error for every call of the request
+func newRateLimitHandler(limiter *RateLimiter, next http.Handler) http.Handler {
+	return &rateLimitHandler{limiter: limiter, connectionType: ConnectionTypeHTTP, next: next}
+}
+
+func (h *rateLimitHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, rateLimitMaxBodySize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	// Whatever was not read is left for the RPC server, which rejects oversized bodies
+	r.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(body), r.Body), r.Body}
+
+	allowed, retryAfter, response := h.limiter.allowMessage(h.limiter.ClientKey(r), body, h.connectionType)
+	if allowed {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	w.WriteHeader(http.StatusTooManyRequests)
+	_ = json.NewEncoder(w).Encode(response)
+}
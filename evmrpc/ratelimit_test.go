@@ -0,0 +1,226 @@
+package evmrpc_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/gorilla/websocket"
+	"github.com/sei-protocol/sei-chain/evmrpc"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRateLimiter(t *testing.T, key string, weights map[string]int) *evmrpc.RateLimiter {
+	limiter, err := evmrpc.NewRateLimiter(evmrpc.RateLimitConfig{
+		RequestsPerSecond: 0.001, // effectively no refill within a test
+		Burst:             3,
+		Key:               key,
+		MethodWeights:     weights,
+		JWTSecret:         []byte("secret"),
+		APIKeys:           evmrpc.ParseAPIKeys("a,b,abc,def"),
+	})
+	require.NoError(t, err)
+	return limiter
+}
+
+func TestParseMethodWeights(t *testing.T) {
+	weights, err := evmrpc.ParseMethodWeights(" eth_getLogs:10, debug_traceTransaction : 20,,")
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"eth_getLogs": 10, "debug_traceTransaction": 20}, weights)
+
+	weights, err = evmrpc.ParseMethodWeights("")
+	require.NoError(t, err)
+	require.Empty(t, weights)
+
+	_, err = evmrpc.ParseMethodWeights("eth_getLogs")
+	require.Error(t, err)
+	_, err = evmrpc.ParseMethodWeights("eth_getLogs:ten")
+	require.Error(t, err)
+	_, err = evmrpc.ParseMethodWeights("eth_getLogs:-1")
+	require.Error(t, err)
+}
+
+func TestNewRateLimiterFromConfig(t *testing.T) {
+	limiter, err := evmrpc.NewRateLimiterFromConfig(evmrpc.DefaultConfig)
+	require.NoError(t, err)
+	require.Nil(t, limiter, "rate limiting is disabled by default")
+
+	cfg := evmrpc.DefaultConfig
+	cfg.RateLimitRequestsPerSecond = 10
+	limiter, err = evmrpc.NewRateLimiterFromConfig(cfg)
+	require.NoError(t, err)
+	require.NotNil(t, limiter)
+
+	cfg.RateLimitKey = "bad"
+	_, err = evmrpc.NewRateLimiterFromConfig(cfg)
+	require.Error(t, err)
+
+	// Tokens and API keys can only be verified when configured
+	cfg.RateLimitKey = evmrpc.RateLimitKeyJWT
+	_, err = evmrpc.NewRateLimiterFromConfig(cfg)
+	require.Error(t, err)
+	cfg.RateLimitJWTSecret = "secret"
+	_, err = evmrpc.NewRateLimiterFromConfig(cfg)
+	require.NoError(t, err)
+
+	cfg.RateLimitKey = evmrpc.RateLimitKeyAPIKey
+	_, err = evmrpc.NewRateLimiterFromConfig(cfg)
+	require.Error(t, err)
+	cfg.RateLimitAPIKeys = " a, b ,"
+	_, err = evmrpc.NewRateLimiterFromConfig(cfg)
+	require.NoError(t, err)
+}
+
+func TestRateLimiterAllow(t *testing.T) {
+	limiter := newTestRateLimiter(t, evmrpc.RateLimitKeyIP, map[string]int{"eth_getLogs": 2, "debug_traceTransaction": 10, "eth_chainId": 0})
+
+	allowed, _ := limiter.Allow("ip:1.1.1.1", []string{"eth_getLogs"})
+	require.True(t, allowed)
+	// A batch costs the weights of all its calls
+	allowed, retryAfter := limiter.Allow("ip:1.1.1.1", []string{"eth_blockNumber", "eth_getLogs"})
+	require.False(t, allowed)
+	require.Greater(t, retryAfter, time.Duration(0))
+	allowed, _ = limiter.Allow("ip:1.1.1.1", []string{"eth_blockNumber"})
+	require.True(t, allowed)
+	allowed, _ = limiter.Allow("ip:1.1.1.1", []string{"eth_blockNumber"})
+	require.False(t, allowed)
+	// Free methods are always allowed
+	allowed, _ = limiter.Allow("ip:1.1.1.1", []string{"eth_chainId"})
+	require.True(t, allowed)
+
+	// Clients have their own buckets, and calls weighing more than a bucket need a full one
+	allowed, _ = limiter.Allow("ip:2.2.2.2", []string{"debug_traceTransaction"})
+	require.True(t, allowed)
+	allowed, _ = limiter.Allow("ip:2.2.2.2", []string{"eth_blockNumber"})
+	require.False(t, allowed)
+}
+
+func TestRateLimiterClientKey(t *testing.T) {
+	newRequest := func(target string, headers ...string) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, target, nil)
+		r.RemoteAddr = "1.2.3.4:5678"
+		for i := 0; i < len(headers); i += 2 {
+			r.Header.Set(headers[i], headers[i+1])
+		}
+		return r
+	}
+
+	limiter := newTestRateLimiter(t, evmrpc.RateLimitKeyIP, nil)
+	require.Equal(t, "ip:1.2.3.4", limiter.ClientKey(newRequest("/")))
+	require.Equal(t, "ip:1.2.3.4", limiter.ClientKey(newRequest("/", "X-Forwarded-For", "5.6.7.8")))
+
+	limiter, err := evmrpc.NewRateLimiter(evmrpc.RateLimitConfig{RequestsPerSecond: 1, Burst: 1, Key: evmrpc.RateLimitKeyIP, IPHeader: "X-Forwarded-For"})
+	require.NoError(t, err)
+	require.Equal(t, "ip:9.9.9.9", limiter.ClientKey(newRequest("/", "X-Forwarded-For", "5.6.7.8, 9.9.9.9")))
+	require.Equal(t, "ip:1.2.3.4", limiter.ClientKey(newRequest("/")))
+
+	limiter = newTestRateLimiter(t, evmrpc.RateLimitKeyAPIKey, nil)
+	require.Equal(t, "api_key:abc", limiter.ClientKey(newRequest("/", evmrpc.APIKeyHeader, "abc")))
+	require.Equal(t, "api_key:def", limiter.ClientKey(newRequest("/?apikey=def")))
+	require.Equal(t, "ip:1.2.3.4", limiter.ClientKey(newRequest("/")))
+	// Unknown keys do not get their own bucket
+	require.Equal(t, "ip:1.2.3.4", limiter.ClientKey(newRequest("/", evmrpc.APIKeyHeader, "xyz")))
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{Subject: "alice"}).SignedString([]byte("secret"))
+	require.NoError(t, err)
+	limiter = newTestRateLimiter(t, evmrpc.RateLimitKeyJWT, nil)
+	require.Equal(t, "jwt:alice", limiter.ClientKey(newRequest("/", "Authorization", "Bearer "+token)))
+	require.Equal(t, "ip:1.2.3.4", limiter.ClientKey(newRequest("/", "Authorization", "Bearer bad")))
+
+	// Tokens signed with another secret, expired or unsigned are not trusted
+	forged, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{Subject: "bob"}).SignedString([]byte("other"))
+	require.NoError(t, err)
+	require.Equal(t, "ip:1.2.3.4", limiter.ClientKey(newRequest("/", "Authorization", "Bearer "+forged)))
+	expired, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		Subject:   "alice",
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Minute)),
+	}).SignedString([]byte("secret"))
+	require.NoError(t, err)
+	require.Equal(t, "ip:1.2.3.4", limiter.ClientKey(newRequest("/", "Authorization", "Bearer "+expired)))
+	unsigned, err := jwt.NewWithClaims(jwt.SigningMethodNone, jwt.RegisteredClaims{Subject: "bob"}).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	require.NoError(t, err)
+	require.Equal(t, "ip:1.2.3.4", limiter.ClientKey(newRequest("/", "Authorization", "Bearer "+unsigned)))
+}
+
+func TestHTTPRateLimit(t *testing.T) {
+	limiter := newTestRateLimiter(t, evmrpc.RateLimitKeyAPIKey, map[string]int{"test_sleep": 2})
+	srv := createAndStartServer(t, &evmrpc.HTTPConfig{
+		Modules:           []string{"test"},
+		RPCEndpointConfig: evmrpc.RPCEndpointConfig{RateLimiter: limiter},
+	}, false, &evmrpc.WsConfig{}, nil)
+	defer srv.Stop()
+	url := "http://" + srv.ListenAddr()
+
+	readBody := func(resp *http.Response) string {
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return string(body)
+	}
+
+	resp := batchRpcRequest(t, url, []string{"test_greet", "test_greet"}, evmrpc.APIKeyHeader, "a")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Contains(t, readBody(resp), "Hello")
+
+	// The batch costs more than what is left of the bucket
+	resp = batchRpcRequest(t, url, []string{"test_greet", "test_sleep"}, evmrpc.APIKeyHeader, "a")
+	require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	require.NotEmpty(t, resp.Header.Get("Retry-After"))
+	var batchErrs []map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(readBody(resp)), &batchErrs))
+	require.Len(t, batchErrs, 2)
+	for _, batchErr := range batchErrs {
+		require.Equal(t, float64(1), batchErr["id"])
+		require.Equal(t, float64(evmrpc.RateLimitErrCode), batchErr["error"].(map[string]interface{})["code"])
+	}
+
+	resp = rpcRequest(t, url, "test_greet", evmrpc.APIKeyHeader, "a")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Contains(t, readBody(resp), "Hello")
+
+	resp = rpcRequest(t, url, "test_greet", evmrpc.APIKeyHeader, "a")
+	require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	require.Equal(t, fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"error":{"code":%d,"message":"rate limit exceeded"}}`, evmrpc.RateLimitErrCode)+"\n", readBody(resp))
+
+	// Another client is not affected
+	resp = rpcRequest(t, url, "test_greet", evmrpc.APIKeyHeader, "b")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Contains(t, readBody(resp), "Hello")
+}
+
+func TestWebsocketRateLimit(t *testing.T) {
+	limiter := newTestRateLimiter(t, evmrpc.RateLimitKeyIP, nil)
+	srv := createAndStartServer(t, &evmrpc.HTTPConfig{}, true, &evmrpc.WsConfig{
+		Origins:           []string{"*"},
+		RPCEndpointConfig: evmrpc.RPCEndpointConfig{RateLimiter: limiter},
+	}, nil)
+	defer srv.Stop()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws://"+srv.ListenAddr(), nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	call := func(id int) map[string]interface{} {
+		require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"method":"rpc_modules","params":[]}`, id))))
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+		res := map[string]interface{}{}
+		require.NoError(t, conn.ReadJSON(&res))
+		return res
+	}
+	for id := 1; id <= 3; id++ {
+		res := call(id)
+		require.Equal(t, float64(id), res["id"])
+		require.Contains(t, res, "result")
+	}
+	// The connection stays open after a rejected message
+	for id := 4; id <= 5; id++ {
+		res := call(id)
+		require.Equal(t, float64(id), res["id"])
+		require.Equal(t, float64(evmrpc.RateLimitErrCode), res["error"].(map[string]interface{})["code"])
+	}
+}
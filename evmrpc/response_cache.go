@@ -0,0 +1,61 @@
+package evmrpc
+
+import (
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"github.com/sei-protocol/sei-chain/utils/metrics"
+)
+
+// ResponseCache holds the results of RPC calls about blocks below the latest committed
+// height, which never change, so that clients fetching the same history over and over don't
+// have them recomputed. A nil cache caches nothing.
+type ResponseCache struct {
+	cache *expirable.LRU[string, interface{}]
+}
+
+// NewResponseCache returns a cache of at most size responses, each kept for ttl. It returns
+// nil if size is not positive, which disables caching.
+func NewResponseCache(size int, ttl time.Duration) *ResponseCache {
+	if size <= 0 {
+		return nil
+	}
+	return &ResponseCache{cache: expirable.NewLRU[string, interface{}](size, nil, ttl)}
+}
+
+// NewResponseCacheFromConfig returns the response cache configured for an EVM RPC server
+func NewResponseCacheFromConfig(config Config) *ResponseCache {
+	return NewResponseCache(config.ResponseCacheSize, config.ResponseCacheTTL)
+}
+
+func (c *ResponseCache) Len() int {
+	if c == nil {
+		return 0
+	}
+	return c.cache.Len()
+}
+
+// getCachedResponse looks up the response of a method for the given key, recording
+// whether it was found
+func getCachedResponse[T any](c *ResponseCache, method string, key string) (T, bool) {
+	var res T
+	if c == nil {
+		return res, false
+	}
+	cached, ok := c.cache.Get(method + ":" + key)
+	if ok {
+		res, ok = cached.(T)
+	}
+	metrics.IncrementRpcResponseCacheCounter(method, ok)
+	return res, ok
+}
+
+// addCachedResponse caches the response of a method if it is about a block below the
+// latest committed one. Cached responses are shared by all callers, so they must not be
+// modified once added.
+func addCachedResponse[T any](c *ResponseCache, method string, key string, height int64, latestHeight int64, res T) {
+	if c == nil || height >= latestHeight {
+		return
+	}
+	c.cache.Add(method+":"+key, res)
+}
@@ -0,0 +1,62 @@
+package evmrpc
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponseCache(t *testing.T) {
+	cache := NewResponseCache(2, time.Minute)
+	block := map[string]interface{}{"number": "0x1"}
+
+	// Responses about the latest block are not cached
+	addCachedResponse(cache, "eth_getBlockByNumber", "10", 10, 10, block)
+	_, ok := getCachedResponse[map[string]interface{}](cache, "eth_getBlockByNumber", "10")
+	require.False(t, ok)
+
+	addCachedResponse(cache, "eth_getBlockByNumber", "1", 1, 10, block)
+	cached, ok := getCachedResponse[map[string]interface{}](cache, "eth_getBlockByNumber", "1")
+	require.True(t, ok)
+	require.Equal(t, block, cached)
+	// Keys are per method
+	_, ok = getCachedResponse[map[string]interface{}](cache, "sei_getBlockByNumber", "1")
+	require.False(t, ok)
+
+	receipts := json.RawMessage(`[{"status":"0x1"}]`)
+	addCachedResponse(cache, "eth_getBlockReceipts", "1", 1, 10, receipts)
+	addCachedResponse(cache, "eth_getBlockReceipts", "2", 2, 10, receipts)
+	require.Equal(t, 2, cache.Len())
+	_, ok = getCachedResponse[map[string]interface{}](cache, "eth_getBlockByNumber", "1")
+	require.False(t, ok, "the least recently used response should have been evicted")
+	cachedReceipts, ok := getCachedResponse[json.RawMessage](cache, "eth_getBlockReceipts", "2")
+	require.True(t, ok)
+	require.Equal(t, receipts, cachedReceipts)
+}
+
+func TestResponseCacheExpiry(t *testing.T) {
+	cache := NewResponseCache(10, 10*time.Millisecond)
+	addCachedResponse(cache, "eth_getTransactionReceipt", "0x1:false", 1, 10, map[string]interface{}{})
+	_, ok := getCachedResponse[map[string]interface{}](cache, "eth_getTransactionReceipt", "0x1:false")
+	require.True(t, ok)
+	require.Eventually(t, func() bool {
+		_, ok := getCachedResponse[map[string]interface{}](cache, "eth_getTransactionReceipt", "0x1:false")
+		return !ok
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestResponseCacheDisabled(t *testing.T) {
+	cache := NewResponseCache(0, time.Minute)
+	require.Nil(t, cache)
+	addCachedResponse(cache, "eth_getBlockByNumber", "1", 1, 10, map[string]interface{}{})
+	_, ok := getCachedResponse[map[string]interface{}](cache, "eth_getBlockByNumber", "1")
+	require.False(t, ok)
+	require.Zero(t, cache.Len())
+
+	config := DefaultConfig
+	config.ResponseCacheSize = 0
+	require.Nil(t, NewResponseCacheFromConfig(config))
+	require.NotNil(t, NewResponseCacheFromConfig(DefaultConfig))
+}
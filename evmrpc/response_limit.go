@@ -0,0 +1,105 @@
+package evmrpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// JSON-RPC error code for responses over the size limit, the same as the one go-ethereum
+// uses for batches over their response limit
+const ResponseTooLargeErrCode = -32003
+
+type responseTooLargeError struct {
+	limit int
+}
+
+func (e *responseTooLargeError) Error() string {
+	return fmt.Sprintf("response too large, exceeds the limit of %d bytes", e.limit)
+}
+
+func (e *responseTooLargeError) ErrorCode() int {
+	return ResponseTooLargeErrCode
+}
+
+type maxResponseSizeKey struct{}
+
+// withMaxResponseSize makes the max response size known to the methods serving a request.
+// Websocket connections are served outside of the context of their upgrade request, so
+// their responses are checked as they are written instead.
+func withMaxResponseSize(ctx context.Context, limit int) context.Context {
+	return context.WithValue(ctx, maxResponseSizeKey{}, limit)
+}
+
+func maxResponseSize(ctx context.Context) int {
+	limit, _ := ctx.Value(maxResponseSizeKey{}).(int)
+	return limit
+}
+
+type responseSizeHandler struct {
+	limit int
+	next  http.Handler
+}
+
+func newResponseSizeHandler(limit int, next http.Handler) http.Handler {
+	return &responseSizeHandler{limit: limit, next: next}
+}
+
+func (h *responseSizeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.next.ServeHTTP(w, r.WithContext(withMaxResponseSize(r.Context(), h.limit)))
+}
+
+// encodeArrayResult encodes a large array result one element at a time, so that encoding
+// stops as soon as the max response size of the request is exceeded rather than after the
+// whole array has been encoded. Every element is cleared from items once it is encoded, so
+// that the encoding replaces the elements in memory instead of being held next to them.
+func encodeArrayResult[T any](ctx context.Context, items []T) (json.RawMessage, error) {
+	if items == nil {
+		return json.RawMessage("null"), nil
+	}
+	limit := maxResponseSize(ctx)
+	buf := bytes.Buffer{}
+	buf.WriteByte('[')
+	enc := json.NewEncoder(&buf)
+	var zero T
+	for i, item := range items {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if err := enc.Encode(item); err != nil {
+			return nil, err
+		}
+		items[i] = zero
+		// Encode adds a newline after every item
+		buf.Truncate(buf.Len() - 1)
+		if limit > 0 && buf.Len() > limit {
+			return nil, &responseTooLargeError{limit: limit}
+		}
+	}
+	buf.WriteByte(']')
+	return buf.Bytes(), nil
+}
+
+// limitMessageSize returns the encoding of a JSON-RPC message, or of an error response to
+// the same request if the message is larger than limit
+func limitMessageSize(msg interface{}, limit int) ([]byte, error) {
+	encoded, err := json.Marshal(msg)
+	if err != nil || limit <= 0 || len(encoded) <= limit {
+		return encoded, err
+	}
+	calls, batch := parseRPCCalls(encoded)
+	responses := make([]rpcErrorMessage, len(calls))
+	for i, call := range calls {
+		responses[i] = rpcErrorMessage{
+			Version: "2.0",
+			ID:      call.ID,
+			Error:   rpcError{Code: ResponseTooLargeErrCode, Message: (&responseTooLargeError{limit: limit}).Error()},
+		}
+	}
+	if !batch {
+		return json.Marshal(responses[0])
+	}
+	return json.Marshal(responses)
+}
@@ -0,0 +1,65 @@
+package evmrpc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeArrayResult(t *testing.T) {
+	newItems := func() []map[string]interface{} { return []map[string]interface{}{{"a": 1}, {"b": "<2>"}} }
+	expected, err := json.Marshal(newItems())
+	require.NoError(t, err)
+
+	items := newItems()
+	encoded, err := encodeArrayResult(context.Background(), items)
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(encoded))
+	// Encoded elements are released
+	require.Equal(t, []map[string]interface{}{nil, nil}, items)
+
+	encoded, err = encodeArrayResult(context.Background(), []int{})
+	require.NoError(t, err)
+	require.Equal(t, "[]", string(encoded))
+	encoded, err = encodeArrayResult[int](context.Background(), nil)
+	require.NoError(t, err)
+	require.Equal(t, "null", string(encoded))
+
+	ctx := withMaxResponseSize(context.Background(), len(expected))
+	_, err = encodeArrayResult(ctx, newItems())
+	require.NoError(t, err)
+	ctx = withMaxResponseSize(context.Background(), len(expected)-2)
+	_, err = encodeArrayResult(ctx, newItems())
+	require.Equal(t, &responseTooLargeError{limit: len(expected) - 2}, err)
+}
+
+func TestResponseSizeHandler(t *testing.T) {
+	var limit int
+	handler := newResponseSizeHandler(100, http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		limit = maxResponseSize(r.Context())
+	}))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", nil))
+	require.Equal(t, 100, limit)
+	require.Zero(t, maxResponseSize(context.Background()))
+}
+
+func TestLimitMessageSize(t *testing.T) {
+	msg := map[string]interface{}{"jsonrpc": "2.0", "id": 1, "result": "0x1234"}
+	encoded, err := limitMessageSize(msg, 100)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"jsonrpc":"2.0","id":1,"result":"0x1234"}`, string(encoded))
+
+	encoded, err = limitMessageSize(msg, 10)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"jsonrpc":"2.0","id":1,"error":{"code":-32003,"message":"response too large, exceeds the limit of 10 bytes"}}`, string(encoded))
+
+	encoded, err = limitMessageSize([]interface{}{msg, msg}, 10)
+	require.NoError(t, err)
+	var batch []map[string]interface{}
+	require.NoError(t, json.Unmarshal(encoded, &batch))
+	require.Len(t, batch, 2)
+}
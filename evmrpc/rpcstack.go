@@ -55,9 +55,11 @@ type WsConfig struct {
 }
 
 type RPCEndpointConfig struct {
-	JwtSecret              []byte // optional JWT secret
-	batchItemLimit         int
-	batchResponseSizeLimit int
+	JwtSecret              []byte       // optional JWT secret
+	RateLimiter            *RateLimiter // optional per-client rate limiter
+	BatchItemLimit         int          // max number of calls in a batch, 0 for unlimited
+	BatchResponseSizeLimit int          // max total size of the results of a batch in bytes, 0 for unlimited
+	MaxResponseSize        int          // max size of a response in bytes, 0 for unlimited
 	readLimit              int64
 }
 
@@ -295,7 +297,7 @@ func (h *HTTPServer) EnableRPC(apis []rpc.API, config HTTPConfig) error {
 
 	// Create RPC server and handler.
 	srv := rpc.NewServer()
-	srv.SetBatchLimits(config.batchItemLimit, config.batchResponseSizeLimit)
+	srv.SetBatchLimits(config.BatchItemLimit, config.BatchResponseSizeLimit)
 	h.log.Info("Registering apis for evm rpc")
 	if err := RegisterApis(h.log, apis, config.Modules, srv); err != nil {
 		return err
@@ -305,8 +307,15 @@ func (h *HTTPServer) EnableRPC(apis []rpc.API, config HTTPConfig) error {
 		srv.RegisterDenyList(method)
 	}
 	h.HTTPConfig = config
+	var handler http.Handler = srv
+	if config.MaxResponseSize > 0 {
+		handler = newResponseSizeHandler(config.MaxResponseSize, handler)
+	}
+	if config.RateLimiter != nil {
+		handler = newRateLimitHandler(config.RateLimiter, handler)
+	}
 	h.httpHandler.Store(&rpcHandler{
-		Handler: NewHTTPHandlerStack(srv, config.CorsAllowedOrigins, config.Vhosts, config.JwtSecret),
+		Handler: NewHTTPHandlerStack(handler, config.CorsAllowedOrigins, config.Vhosts, config.JwtSecret),
 		server:  srv,
 	})
 	return nil
@@ -332,15 +341,19 @@ func (h *HTTPServer) EnableWS(apis []rpc.API, config WsConfig) error {
 	}
 	// Create RPC server and handler.
 	srv := rpc.NewServer()
-	srv.SetBatchLimits(config.batchItemLimit, config.batchResponseSizeLimit)
+	srv.SetBatchLimits(config.BatchItemLimit, config.BatchResponseSizeLimit)
 	srv.SetReadLimits(config.readLimit)
 	h.log.Info("Registering apis for evm websocket")
 	if err := RegisterApis(h.log, apis, config.Modules, srv); err != nil {
 		return err
 	}
 	h.WsConfig = config
+	handler := srv.WebsocketHandler(config.Origins)
+	if config.RateLimiter != nil || config.MaxResponseSize > 0 {
+		handler = newWebsocketHandler(srv, config.Origins, config.readLimit, config.RateLimiter, config.MaxResponseSize)
+	}
 	h.wsHandler.Store(&rpcHandler{
-		Handler: NewWSHandlerStack(srv.WebsocketHandler(config.Origins), config.JwtSecret),
+		Handler: NewWSHandlerStack(handler, config.JwtSecret),
 		server:  srv,
 	})
 	return nil
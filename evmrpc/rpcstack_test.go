@@ -18,6 +18,7 @@ package evmrpc_test
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -33,6 +34,7 @@ import (
 	"github.com/gorilla/websocket"
 	"github.com/sei-protocol/sei-chain/evmrpc"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
@@ -624,3 +626,72 @@ func TestHttpDenyList(t *testing.T) {
 		}
 	})
 }
+
+func TestHTTPBatchLimits(t *testing.T) {
+	srv := createAndStartServer(t, &evmrpc.HTTPConfig{
+		Modules: []string{"test"},
+		RPCEndpointConfig: evmrpc.RPCEndpointConfig{
+			BatchItemLimit:         3,
+			BatchResponseSizeLimit: 10,
+		},
+	}, false, &evmrpc.WsConfig{}, nil)
+	defer srv.Stop()
+	url := "http://" + srv.ListenAddr()
+
+	send := func(methods []string) []map[string]interface{} {
+		resp := batchRpcRequest(t, url, methods)
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		var res []map[string]interface{}
+		if err := json.Unmarshal(body, &res); err != nil {
+			// Batches over the item limit are answered with a single error
+			var single map[string]interface{}
+			require.NoError(t, json.Unmarshal(body, &single))
+			res = []map[string]interface{}{single}
+		}
+		return res
+	}
+
+	res := send([]string{"test_greet"})
+	require.Len(t, res, 1)
+	require.Equal(t, "Hello", res[0]["result"])
+
+	res = send([]string{"test_greet", "test_greet", "test_greet", "test_greet"})
+	require.Len(t, res, 1)
+	require.Equal(t, "batch too large", res[0]["error"].(map[string]interface{})["message"])
+
+	// Each result is 7 bytes, so the calls after the second one are not made
+	res = send([]string{"test_greet", "test_greet", "test_greet"})
+	require.Len(t, res, 3)
+	require.Equal(t, "Hello", res[0]["result"])
+	require.Equal(t, "Hello", res[1]["result"])
+	require.Equal(t, float64(evmrpc.ResponseTooLargeErrCode), res[2]["error"].(map[string]interface{})["code"])
+}
+
+func TestWebsocketMaxResponseSize(t *testing.T) {
+	srv := createAndStartServer(t, &evmrpc.HTTPConfig{}, true, &evmrpc.WsConfig{
+		Origins:           []string{"*"},
+		RPCEndpointConfig: evmrpc.RPCEndpointConfig{MaxResponseSize: 30},
+	}, nil)
+	defer srv.Stop()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws://"+srv.ListenAddr(), nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":7,"method":"rpc_modules","params":[]}`)))
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	res := map[string]interface{}{}
+	require.NoError(t, conn.ReadJSON(&res))
+	require.Equal(t, float64(7), res["id"])
+	require.Equal(t, float64(evmrpc.ResponseTooLargeErrCode), res["error"].(map[string]interface{})["code"])
+
+	// The connection stays usable
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(`[{"jsonrpc":"2.0","id":8,"method":"rpc_modules","params":[]}]`)))
+	var batchRes []map[string]interface{}
+	require.NoError(t, conn.ReadJSON(&batchRes))
+	require.Len(t, batchRes, 1)
+	require.Equal(t, float64(8), batchRes[0]["id"])
+	require.Equal(t, float64(evmrpc.ResponseTooLargeErrCode), batchRes[0]["error"].(map[string]interface{})["code"])
+}
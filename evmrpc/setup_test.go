@@ -535,6 +535,8 @@ func init() {
 	MultiTxCtx, _ = Ctx.CacheContext()
 	EVMKeeper = &testApp.EvmKeeper
 	EVMKeeper.InitGenesis(Ctx, *types.DefaultGenesis())
+	// filtered log queries go through the log index
+	EVMKeeper.LogIndexEnabled = true
 	seiAddr, err := sdk.AccAddressFromHex(common.Bytes2Hex([]byte("seiAddr")))
 	if err != nil {
 		panic(err)
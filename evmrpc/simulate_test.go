@@ -13,8 +13,10 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/export"
 	"github.com/sei-protocol/sei-chain/app"
 	"github.com/sei-protocol/sei-chain/evmrpc"
@@ -664,3 +666,98 @@ func TestSimulationAPIRequestLimiter(t *testing.T) {
 		t.Logf("Found %d rate limit errors with correct format", len(rateLimitErrors))
 	})
 }
+
+func TestSimulateV1(t *testing.T) {
+	_, from := testkeeper.MockAddressPair()
+	_, to := testkeeper.MockAddressPair()
+	code, err := os.ReadFile("../example/contracts/simplestorage/SimpleStorage.bin")
+	require.Nil(t, err)
+	abi, err := simplestorage.SimplestorageMetaData.GetAbi()
+	require.Nil(t, err)
+	setInput, err := abi.Pack("set", big.NewInt(20))
+	require.Nil(t, err)
+	getInput, err := abi.Pack("get")
+	require.Nil(t, err)
+	contractAddr := crypto.CreateAddress(from, 0)
+
+	opts := map[string]interface{}{
+		"blockStateCalls": []interface{}{
+			map[string]interface{}{
+				"calls": []interface{}{
+					map[string]interface{}{"from": from.Hex(), "input": "0x" + string(code)},
+				},
+			},
+			map[string]interface{}{
+				// skips a block, which is simulated without calls
+				"blockOverrides": map[string]interface{}{"number": fmt.Sprintf("%#x", MockHeight8+3)},
+				"stateOverrides": map[string]interface{}{from.Hex(): map[string]interface{}{"balance": "0x100"}},
+				"calls": []interface{}{
+					map[string]interface{}{"from": from.Hex(), "to": contractAddr.Hex(), "input": fmt.Sprintf("%#x", setInput)},
+					map[string]interface{}{"from": from.Hex(), "to": to.Hex(), "value": "0x10"},
+				},
+			},
+			map[string]interface{}{
+				"calls": []interface{}{
+					map[string]interface{}{"from": from.Hex(), "to": contractAddr.Hex(), "input": fmt.Sprintf("%#x", getInput)},
+				},
+			},
+		},
+		"traceTransfers": true,
+	}
+	resObj := sendRequestGood(t, "simulateV1", opts, "latest")
+	require.Nil(t, resObj["error"])
+	blocks := resObj["result"].([]interface{})
+	require.Len(t, blocks, 4)
+	for i, block := range blocks {
+		require.Equal(t, fmt.Sprintf("%#x", MockHeight8+1+i), block.(map[string]interface{})["number"])
+	}
+	require.Equal(t, blocks[0].(map[string]interface{})["hash"], blocks[1].(map[string]interface{})["parentHash"])
+	require.Empty(t, blocks[1].(map[string]interface{})["calls"])
+
+	// the contract deployed in the first block is called in the later ones
+	deploy := blocks[0].(map[string]interface{})["calls"].([]interface{})[0].(map[string]interface{})
+	require.Equal(t, "0x1", deploy["status"])
+
+	calls := blocks[2].(map[string]interface{})["calls"].([]interface{})
+	require.Len(t, calls, 2)
+	set := calls[0].(map[string]interface{})
+	require.Equal(t, "0x1", set["status"])
+	setLogs := set["logs"].([]interface{})
+	require.Len(t, setLogs, 1)
+	require.Equal(t, strings.ToLower(contractAddr.Hex()), strings.ToLower(setLogs[0].(map[string]interface{})["address"].(string)))
+	require.Equal(t, blocks[2].(map[string]interface{})["hash"], setLogs[0].(map[string]interface{})["blockHash"])
+	transferLogs := calls[1].(map[string]interface{})["logs"].([]interface{})
+	require.Len(t, transferLogs, 1)
+	transferLog := transferLogs[0].(map[string]interface{})
+	require.Equal(t, "0xeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee", strings.ToLower(transferLog["address"].(string)))
+	require.Equal(t, "0x1", transferLog["logIndex"])
+	require.Len(t, blocks[2].(map[string]interface{})["transactions"], 2)
+
+	get := blocks[3].(map[string]interface{})["calls"].([]interface{})[0].(map[string]interface{})
+	require.Equal(t, fmt.Sprintf("%#x", common.BigToHash(big.NewInt(20))), get["returnData"])
+
+	// calls that revert are reported without failing the simulation
+	opts = map[string]interface{}{
+		"blockStateCalls": []interface{}{
+			map[string]interface{}{
+				"stateOverrides": map[string]interface{}{contractAddr.Hex(): map[string]interface{}{"code": "0x60006000fd"}},
+				"calls": []interface{}{
+					map[string]interface{}{"from": from.Hex(), "to": contractAddr.Hex()},
+				},
+			},
+		},
+	}
+	resObj = sendRequestGood(t, "simulateV1", opts, "latest")
+	call := resObj["result"].([]interface{})[0].(map[string]interface{})["calls"].([]interface{})[0].(map[string]interface{})
+	require.Equal(t, "0x0", call["status"])
+	require.Equal(t, float64(-32000), call["error"].(map[string]interface{})["code"])
+
+	// block numbers have to increase
+	opts = map[string]interface{}{
+		"blockStateCalls": []interface{}{
+			map[string]interface{}{"blockOverrides": map[string]interface{}{"number": fmt.Sprintf("%#x", MockHeight8)}},
+		},
+	}
+	resObj = sendRequestGood(t, "simulateV1", opts, "latest")
+	require.Contains(t, resObj["error"].(map[string]interface{})["message"], "block numbers must be in order")
+}
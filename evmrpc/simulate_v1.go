@@ -0,0 +1,505 @@
+package evmrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/export"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/sei-protocol/sei-chain/precompiles/wasmd"
+	"github.com/sei-protocol/sei-chain/utils"
+	"github.com/sei-protocol/sei-chain/x/evm/state"
+)
+
+const (
+	// MaxSimulateBlocks is the maximum number of blocks a single eth_simulateV1 request can simulate
+	MaxSimulateBlocks = 256
+	// SimulateTimestampIncrement is the default number of seconds between simulated blocks
+	SimulateTimestampIncrement = 1
+)
+
+// JSON-RPC error codes of failed simulated calls, the same as geth's
+const (
+	simulateErrCodeReverted = -32000
+	simulateErrCodeVMError  = -32015
+)
+
+// ERC-7528 address and ERC-20 Transfer topic of the logs emitted for native value transfers
+var (
+	simulateTransferAddress = common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE")
+	simulateTransferTopic   = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+)
+
+// SimulateBlock is a batch of calls executed in one simulated block, after its state overrides
+// are applied
+type SimulateBlock struct {
+	BlockOverrides *export.BlockOverrides   `json:"blockOverrides"`
+	StateOverrides *export.StateOverride    `json:"stateOverrides"`
+	Calls          []export.TransactionArgs `json:"calls"`
+}
+
+// SimulateOpts are the parameters of eth_simulateV1
+type SimulateOpts struct {
+	BlockStateCalls        []SimulateBlock `json:"blockStateCalls"`
+	TraceTransfers         bool            `json:"traceTransfers"`
+	Validation             bool            `json:"validation"`
+	ReturnFullTransactions bool            `json:"returnFullTransactions"`
+}
+
+type SimulateCallError struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Data    string `json:"data,omitempty"`
+}
+
+type SimulateCallResult struct {
+	ReturnValue hexutil.Bytes      `json:"returnData"`
+	Logs        []*ethtypes.Log    `json:"logs"`
+	GasUsed     hexutil.Uint64     `json:"gasUsed"`
+	Status      hexutil.Uint64     `json:"status"`
+	Error       *SimulateCallError `json:"error,omitempty"`
+}
+
+// SimulateV1 executes blocks of calls on top of the state of blockNrOrHash. Every block can
+// override header fields and state before its calls run, and sees the state changes of the
+// blocks before it. Nothing is persisted.
+func (s *SimulationAPI) SimulateV1(ctx context.Context, opts SimulateOpts, blockNrOrHash *rpc.BlockNumberOrHash) (result []map[string]interface{}, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("eth_simulateV1", s.connectionType, startTime)
+	/* ---------- fail‑fast limiter ---------- */
+	if s.requestLimiter != nil {
+		if !s.requestLimiter.TryAcquire(1) {
+			returnErr = errors.New("eth_simulateV1 rejected due to rate limit: server busy")
+			return
+		}
+		defer s.requestLimiter.Release(1)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			returnErr = fmt.Errorf("something went wrong: %v", r)
+		}
+	}()
+	if len(opts.BlockStateCalls) == 0 {
+		return nil, errors.New("empty input")
+	}
+	if len(opts.BlockStateCalls) > MaxSimulateBlocks {
+		return nil, errors.New("too many blocks")
+	}
+	if blockNrOrHash == nil {
+		latest := rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
+		blockNrOrHash = &latest
+	}
+	for _, block := range opts.BlockStateCalls {
+		for _, call := range block.Calls {
+			if wasmd.IsWasmdCall(call.To) {
+				ctx = context.WithValue(ctx, CtxIsWasmdPrecompileCallKey, true)
+			}
+		}
+	}
+	statedb, base, err := s.backend.StateAndHeaderByNumberOrHash(ctx, *blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	baseHeight := base.Number.Int64()
+	baseBlock, err := blockByNumber(ctx, s.backend.tmClient, &baseHeight)
+	if err != nil {
+		return nil, err
+	}
+	baseHash := common.BytesToHash(baseBlock.BlockID.Hash)
+
+	if timeout := s.backend.RPCEVMTimeout(); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	gasCap := s.backend.RPCGasCap()
+	if gasCap == 0 {
+		gasCap = math.MaxUint64
+	}
+	sim := &simulator{
+		backend:        s.backend,
+		state:          state.GetDBImpl(statedb),
+		base:           base,
+		baseHash:       baseHash,
+		chainConfig:    s.backend.ChainConfig(),
+		gp:             new(core.GasPool).AddGas(gasCap),
+		traceTransfers: opts.TraceTransfers,
+		validate:       opts.Validation,
+		fullTx:         opts.ReturnFullTransactions,
+	}
+	return sim.execute(ctx, opts.BlockStateCalls)
+}
+
+// simulator executes the blocks of an eth_simulateV1 request in order on the same state
+type simulator struct {
+	backend        *Backend
+	state          *state.DBImpl
+	base           *ethtypes.Header
+	baseHash       common.Hash
+	chainConfig    *params.ChainConfig
+	gp             *core.GasPool
+	traceTransfers bool
+	validate       bool
+	fullTx         bool
+}
+
+func (sim *simulator) execute(ctx context.Context, blocks []SimulateBlock) ([]map[string]interface{}, error) {
+	blocks, err := sim.sanitizeChain(blocks)
+	if err != nil {
+		return nil, err
+	}
+	baseBlockCtx, err := sim.backend.keeper.GetVMBlockContext(sim.state.Ctx(), sim.backend.keeper.GetGasPool())
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]map[string]interface{}, 0, len(blocks))
+	simulatedHashes := make(map[uint64]common.Hash, len(blocks))
+	parentHash := sim.baseHash
+	for _, block := range blocks {
+		header := sim.makeHeader(block.BlockOverrides, parentHash, baseBlockCtx)
+		blockCtx := *baseBlockCtx
+		blockCtx.BlockNumber = header.Number
+		blockCtx.Time = header.Time
+		blockCtx.GasLimit = header.GasLimit
+		blockCtx.Coinbase = header.Coinbase
+		blockCtx.BaseFee = header.BaseFee
+		random := header.MixDigest
+		blockCtx.Random = &random
+		if block.BlockOverrides.BlobBaseFee != nil {
+			blockCtx.BlobBaseFee = block.BlockOverrides.BlobBaseFee.ToInt()
+		}
+		blockCtx.GetHash = func(height uint64) common.Hash {
+			if hash, ok := simulatedHashes[height]; ok {
+				return hash
+			}
+			return baseBlockCtx.GetHash(height)
+		}
+
+		ethBlock, senders, callResults, err := sim.processBlock(ctx, &block, header, blockCtx)
+		if err != nil {
+			return nil, err
+		}
+		simulatedHashes[ethBlock.NumberU64()] = ethBlock.Hash()
+		parentHash = ethBlock.Hash()
+		results = append(results, sim.encodeBlock(ethBlock, senders, callResults))
+	}
+	return results, nil
+}
+
+func (sim *simulator) processBlock(ctx context.Context, block *SimulateBlock, header *ethtypes.Header, blockCtx vm.BlockContext) (*ethtypes.Block, []common.Address, []SimulateCallResult, error) {
+	rules := sim.chainConfig.Rules(blockCtx.BlockNumber, blockCtx.Random != nil, blockCtx.Time)
+	precompiles := vm.ActivePrecompiledContracts(rules, sim.backend.GetCustomPrecompiles(sim.base.Number.Int64()))
+	if err := block.StateOverrides.Apply(sim.state, precompiles); err != nil {
+		return nil, nil, nil, err
+	}
+
+	tracer := &simulateTracer{traceTransfers: sim.traceTransfers}
+	sim.state.SetLogger(tracer.hooks())
+	defer sim.state.SetLogger(nil)
+
+	var (
+		gasUsed     uint64
+		txs         = make([]*ethtypes.Transaction, len(block.Calls))
+		senders     = make([]common.Address, len(block.Calls))
+		receipts    = make([]*ethtypes.Receipt, len(block.Calls))
+		callResults = make([]SimulateCallResult, len(block.Calls))
+		logIndex    uint
+	)
+	for i, call := range block.Calls {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, nil, err
+		}
+		if err := sim.sanitizeCall(&call, header, gasUsed); err != nil {
+			return nil, nil, nil, err
+		}
+		tx := call.ToTransaction(ethtypes.DynamicFeeTxType)
+		txs[i] = tx
+		if call.From != nil {
+			senders[i] = *call.From
+		}
+
+		msg := call.ToMessage(header.BaseFee, !sim.validate, true)
+		evm := vm.NewEVM(blockCtx, sim.state, sim.chainConfig, vm.Config{NoBaseFee: !sim.validate, Tracer: tracer.hooks()}, sim.backend.GetCustomPrecompiles(sim.base.Number.Int64()))
+		evm.SetPrecompiles(precompiles)
+		evm.SetTxContext(core.NewEVMTxContext(msg))
+		tracer.reset()
+		res, err := applySimulatedMessage(ctx, evm, msg, sim.gp)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if err := sim.state.Error(); err != nil {
+			return nil, nil, nil, err
+		}
+		gasUsed += res.UsedGas
+
+		logs := tracer.logs()
+		for _, log := range logs {
+			log.BlockNumber = header.Number.Uint64()
+			log.TxHash = tx.Hash()
+			log.TxIndex = uint(i)
+			log.Index = logIndex
+			logIndex++
+		}
+		callResult := SimulateCallResult{ReturnValue: res.Return(), Logs: logs, GasUsed: hexutil.Uint64(res.UsedGas)}
+		receipt := &ethtypes.Receipt{
+			Type:              tx.Type(),
+			CumulativeGasUsed: gasUsed,
+			TxHash:            tx.Hash(),
+			GasUsed:           res.UsedGas,
+			TransactionIndex:  uint(i),
+			BlockNumber:       header.Number,
+		}
+		if res.Failed() {
+			callResult.Status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+			receipt.Status = ethtypes.ReceiptStatusFailed
+			if errors.Is(res.Err, vm.ErrExecutionReverted) {
+				revertErr := NewRevertError(res)
+				callResult.Error = &SimulateCallError{Message: revertErr.Error(), Code: simulateErrCodeReverted, Data: revertErr.reason}
+			} else {
+				callResult.Error = &SimulateCallError{Message: res.Err.Error(), Code: simulateErrCodeVMError}
+			}
+		} else {
+			callResult.Status = hexutil.Uint64(ethtypes.ReceiptStatusSuccessful)
+			receipt.Status = ethtypes.ReceiptStatusSuccessful
+			receipt.Logs = logs
+		}
+		receipt.Bloom = ethtypes.CreateBloom(receipt)
+		if callResult.Logs == nil {
+			callResult.Logs = []*ethtypes.Log{}
+		}
+		receipts[i] = receipt
+		callResults[i] = callResult
+	}
+	header.GasUsed = gasUsed
+
+	ethBlock := ethtypes.NewBlock(header, &ethtypes.Body{Transactions: txs}, receipts, trie.NewStackTrie(nil))
+	for _, callResult := range callResults {
+		for _, log := range callResult.Logs {
+			log.BlockHash = ethBlock.Hash()
+		}
+	}
+	return ethBlock, senders, callResults, nil
+}
+
+// sanitizeCall fills in the nonce and gas of a call the way a wallet would
+func (sim *simulator) sanitizeCall(call *export.TransactionArgs, header *ethtypes.Header, gasUsed uint64) error {
+	if call.Nonce == nil {
+		var from common.Address
+		if call.From != nil {
+			from = *call.From
+		}
+		nonce := sim.state.GetNonce(from)
+		call.Nonce = (*hexutil.Uint64)(&nonce)
+	}
+	if call.Gas == nil {
+		remaining := header.GasLimit - gasUsed
+		call.Gas = (*hexutil.Uint64)(&remaining)
+	}
+	if gasUsed+uint64(*call.Gas) > header.GasLimit {
+		return fmt.Errorf("block gas limit reached: %d >= %d", gasUsed, header.GasLimit)
+	}
+	return call.CallDefaults(sim.gp.Gas(), header.BaseFee, sim.chainConfig.ChainID)
+}
+
+// sanitizeChain checks that block numbers and timestamps strictly increase and fills in the
+// ones not overridden. Gaps in block numbers are filled with empty blocks.
+func (sim *simulator) sanitizeChain(blocks []SimulateBlock) ([]SimulateBlock, error) {
+	res := make([]SimulateBlock, 0, len(blocks))
+	prevNumber := sim.base.Number
+	prevTimestamp := sim.base.Time
+	for _, block := range blocks {
+		if block.BlockOverrides == nil {
+			block.BlockOverrides = new(export.BlockOverrides)
+		}
+		if block.BlockOverrides.BeaconRoot != nil {
+			return nil, errors.New(`block override "beaconRoot" is not supported`)
+		}
+		if block.BlockOverrides.Withdrawals != nil && len(*block.BlockOverrides.Withdrawals) > 0 {
+			return nil, errors.New(`block override "withdrawals" is not supported`)
+		}
+		if block.BlockOverrides.Number == nil {
+			n := new(big.Int).Add(prevNumber, utils.Big1)
+			block.BlockOverrides.Number = (*hexutil.Big)(n)
+		}
+		diff := new(big.Int).Sub(block.BlockOverrides.Number.ToInt(), prevNumber)
+		if diff.Sign() <= 0 {
+			return nil, fmt.Errorf("block numbers must be in order: %d <= %d", block.BlockOverrides.Number.ToInt(), prevNumber)
+		}
+		if total := new(big.Int).Sub(block.BlockOverrides.Number.ToInt(), sim.base.Number); total.Cmp(big.NewInt(MaxSimulateBlocks)) > 0 {
+			return nil, errors.New("too many blocks")
+		}
+		for i := int64(1); i < diff.Int64(); i++ {
+			n := new(big.Int).Add(prevNumber, big.NewInt(i))
+			t := prevTimestamp + SimulateTimestampIncrement
+			res = append(res, SimulateBlock{BlockOverrides: &export.BlockOverrides{Number: (*hexutil.Big)(n), Time: (*hexutil.Uint64)(&t)}})
+			prevTimestamp = t
+		}
+		prevNumber = block.BlockOverrides.Number.ToInt()
+		if block.BlockOverrides.Time == nil {
+			t := prevTimestamp + SimulateTimestampIncrement
+			block.BlockOverrides.Time = (*hexutil.Uint64)(&t)
+		} else if uint64(*block.BlockOverrides.Time) <= prevTimestamp {
+			return nil, fmt.Errorf("block timestamps must be in order: %d <= %d", uint64(*block.BlockOverrides.Time), prevTimestamp)
+		}
+		prevTimestamp = uint64(*block.BlockOverrides.Time)
+		res = append(res, block)
+	}
+	return res, nil
+}
+
+// makeHeader returns the header of a simulated block before its calls are executed. Outside of
+// validation mode the base fee is zero unless overridden, so calls without a gas price work.
+func (sim *simulator) makeHeader(overrides *export.BlockOverrides, parentHash common.Hash, baseBlockCtx *vm.BlockContext) *ethtypes.Header {
+	baseFee := new(big.Int)
+	if sim.validate {
+		baseFee = sim.base.BaseFee
+	}
+	var random common.Hash
+	if baseBlockCtx.Random != nil {
+		random = *baseBlockCtx.Random
+	}
+	header := overrides.MakeHeader(&ethtypes.Header{
+		ParentHash:  parentHash,
+		UncleHash:   ethtypes.EmptyUncleHash,
+		Coinbase:    baseBlockCtx.Coinbase,
+		Difficulty:  common.Big0,
+		GasLimit:    sim.base.GasLimit,
+		MixDigest:   random,
+		BaseFee:     baseFee,
+		TxHash:      ethtypes.EmptyTxsHash,
+		ReceiptHash: ethtypes.EmptyReceiptsHash,
+	})
+	return header
+}
+
+func (sim *simulator) encodeBlock(block *ethtypes.Block, senders []common.Address, calls []SimulateCallResult) map[string]interface{} {
+	header := block.Header()
+	transactions := make([]interface{}, 0, len(block.Transactions()))
+	for i, tx := range block.Transactions() {
+		if !sim.fullTx {
+			transactions = append(transactions, tx.Hash())
+			continue
+		}
+		rpcTx := export.NewRPCTransaction(tx, block.Hash(), block.NumberU64(), block.Time(), uint64(i), block.BaseFee(), sim.chainConfig)
+		// simulated calls are not signed, so the sender cannot be recovered from the signature
+		rpcTx.From = senders[i]
+		transactions = append(transactions, rpcTx)
+	}
+	return map[string]interface{}{
+		"number":           (*hexutil.Big)(header.Number),
+		"hash":             block.Hash(),
+		"parentHash":       header.ParentHash,
+		"nonce":            header.Nonce,
+		"mixHash":          header.MixDigest,
+		"sha3Uncles":       header.UncleHash,
+		"logsBloom":        header.Bloom,
+		"stateRoot":        header.Root,
+		"miner":            header.Coinbase,
+		"difficulty":       (*hexutil.Big)(header.Difficulty),
+		"extraData":        hexutil.Bytes(header.Extra),
+		"size":             hexutil.Uint64(block.Size()),
+		"gasLimit":         hexutil.Uint64(header.GasLimit),
+		"gasUsed":          hexutil.Uint64(header.GasUsed),
+		"timestamp":        hexutil.Uint64(header.Time),
+		"transactionsRoot": header.TxHash,
+		"receiptsRoot":     header.ReceiptHash,
+		"baseFeePerGas":    (*hexutil.Big)(header.BaseFee),
+		"uncles":           []common.Hash{},
+		"transactions":     transactions,
+		"calls":            calls,
+	}
+}
+
+func applySimulatedMessage(ctx context.Context, evm *vm.EVM, msg *core.Message, gp *core.GasPool) (*core.ExecutionResult, error) {
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			evm.Cancel()
+		case <-done:
+		}
+	}()
+	res, err := core.ApplyMessage(evm, msg, gp)
+	if evm.Cancelled() {
+		return nil, fmt.Errorf("execution aborted: %w", ctx.Err())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("err: %w (supplied gas %d)", err, msg.GasLimit)
+	}
+	return res, nil
+}
+
+// simulateTracer collects the logs of a simulated call, dropping the ones of reverted frames,
+// and optionally adds an ERC-7528 Transfer log for every native value transfer
+type simulateTracer struct {
+	traceTransfers bool
+	frames         [][]*ethtypes.Log
+	collected      []*ethtypes.Log
+}
+
+func (t *simulateTracer) hooks() *tracing.Hooks {
+	return &tracing.Hooks{
+		OnEnter: t.onEnter,
+		OnExit:  t.onExit,
+		OnLog:   t.onLog,
+	}
+}
+
+func (t *simulateTracer) reset() {
+	t.frames = nil
+	t.collected = nil
+}
+
+func (t *simulateTracer) logs() []*ethtypes.Log {
+	return t.collected
+}
+
+func (t *simulateTracer) onEnter(_ int, typ byte, from common.Address, to common.Address, _ []byte, _ uint64, value *big.Int) {
+	t.frames = append(t.frames, []*ethtypes.Log{})
+	if t.traceTransfers && value != nil && value.Sign() > 0 && vm.OpCode(typ) != vm.DELEGATECALL {
+		t.onLog(&ethtypes.Log{
+			Address: simulateTransferAddress,
+			Topics:  []common.Hash{simulateTransferTopic, common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes())},
+			Data:    common.BigToHash(value).Bytes(),
+		})
+	}
+}
+
+func (t *simulateTracer) onExit(_ int, _ []byte, _ uint64, _ error, reverted bool) {
+	if len(t.frames) == 0 {
+		return
+	}
+	logs := t.frames[len(t.frames)-1]
+	t.frames = t.frames[:len(t.frames)-1]
+	if reverted {
+		return
+	}
+	if len(t.frames) == 0 {
+		t.collected = append(t.collected, logs...)
+		return
+	}
+	t.frames[len(t.frames)-1] = append(t.frames[len(t.frames)-1], logs...)
+}
+
+func (t *simulateTracer) onLog(log *ethtypes.Log) {
+	if len(t.frames) == 0 {
+		t.collected = append(t.collected, log)
+		return
+	}
+	t.frames[len(t.frames)-1] = append(t.frames[len(t.frames)-1], log)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store/cachekv"
 	iavlstore "github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/storev2/commitment"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -18,6 +19,7 @@ import (
 	"github.com/sei-protocol/sei-chain/x/evm/state"
 	"github.com/sei-protocol/sei-chain/x/evm/types"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/proto/tendermint/crypto"
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
 	"github.com/tendermint/tendermint/rpc/coretypes"
@@ -28,10 +30,11 @@ type StateAPI struct {
 	keeper         *keeper.Keeper
 	ctxProvider    func(int64) sdk.Context
 	connectionType ConnectionType
+	proofSnapshots *ProofSnapshots
 }
 
-func NewStateAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, connectionType ConnectionType) *StateAPI {
-	return &StateAPI{tmClient: tmClient, keeper: k, ctxProvider: ctxProvider, connectionType: connectionType}
+func NewStateAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, connectionType ConnectionType, proofSnapshots *ProofSnapshots) *StateAPI {
+	return &StateAPI{tmClient: tmClient, keeper: k, ctxProvider: ctxProvider, connectionType: connectionType, proofSnapshots: proofSnapshots}
 }
 
 func (a *StateAPI) GetBalance(ctx context.Context, address common.Address, blockNrOrHash rpc.BlockNumberOrHash) (result *hexutil.Big, returnErr error) {
@@ -132,33 +135,37 @@ func (a *StateAPI) GetProof(ctx context.Context, address common.Address, storage
 	if err := CheckVersion(sdkCtx, a.keeper); err != nil {
 		return nil, err
 	}
-	var iavl *iavlstore.Store
-	s := sdkCtx.MultiStore().GetKVStore((a.keeper.GetStoreKey()))
-OUTER:
-	for {
-		switch cast := s.(type) {
-		case *iavlstore.Store:
-			iavl = cast
-			break OUTER
-		case *cachekv.Store:
-			if cast.GetParent() == nil {
-				return nil, errors.New("cannot find EVM IAVL store")
-			}
-			s = cast.GetParent()
-		default:
-			return nil, errors.New("cannot find EVM IAVL store")
+	store := findProofStore(sdkCtx.MultiStore().GetKVStore(a.keeper.GetStoreKey()))
+	if store == nil || !store.VersionExists(block.Block.Height) {
+		// the height is no longer held by the commitment store, so fall back to loading it
+		// from a retained snapshot
+		if a.proofSnapshots == nil {
+			return nil, fmt.Errorf("proofs at height %d are not available", block.Block.Height)
+		}
+		snapshot, err := a.proofSnapshots.acquire(ctx, block.Block.Height)
+		if err != nil {
+			return nil, fmt.Errorf("proofs at height %d are not available: %w", block.Block.Height, err)
+		}
+		defer a.proofSnapshots.release(snapshot)
+		tree := snapshot.store.GetTreeByName(a.keeper.GetStoreKey().Name())
+		if tree == nil {
+			return nil, errors.New("cannot find EVM commitment store")
 		}
+		store = commitment.NewStore(tree, log.NewNopLogger())
 	}
 	proofResult := ProofResult{Address: address}
 	for _, key := range storageKeys {
 		paddedKey := common.BytesToHash([]byte(key))
 		formattedKey := append(types.StateKey(address), paddedKey[:]...)
-		qres := iavl.Query(abci.RequestQuery{
+		qres := store.Query(abci.RequestQuery{
 			Path:   "/key",
 			Data:   formattedKey,
 			Height: block.Block.Height,
 			Prove:  true,
 		})
+		if !qres.IsOK() {
+			return nil, fmt.Errorf("failed to prove key %s: %s", key, qres.Log)
+		}
 		proofResult.HexValues = append(proofResult.HexValues, hex.EncodeToString(qres.Value))
 		proofResult.StorageProof = append(proofResult.StorageProof, qres.ProofOps)
 	}
@@ -166,6 +173,32 @@ OUTER:
 	return &proofResult, nil
 }
 
+// proofStore is a store that can prove its contents at the versions it holds
+type proofStore interface {
+	Query(abci.RequestQuery) abci.ResponseQuery
+	VersionExists(version int64) bool
+}
+
+// findProofStore returns the IAVL or memiavl commitment store underneath a KV store, or nil
+// if there is none, like for stores of historical heights backed by the state store
+func findProofStore(s sdk.KVStore) proofStore {
+	for {
+		switch cast := s.(type) {
+		case *iavlstore.Store:
+			return cast
+		case *commitment.Store:
+			return cast
+		case *cachekv.Store:
+			if cast.GetParent() == nil {
+				return nil
+			}
+			s = cast.GetParent()
+		default:
+			return nil
+		}
+	}
+}
+
 func (a *StateAPI) GetNonce(_ context.Context, address common.Address) uint64 {
 	startTime := time.Now()
 	defer recordMetrics("eth_getNonce", a.connectionType, startTime)
@@ -6,16 +6,23 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math/big"
 	"net/http"
 	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/iavl"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/sei-protocol/sei-chain/app"
 	"github.com/sei-protocol/sei-chain/evmrpc"
 	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	evmtypes "github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/sei-protocol/sei-db/common/logger"
+	"github.com/sei-protocol/sei-db/config"
+	"github.com/sei-protocol/sei-db/proto"
+	"github.com/sei-protocol/sei-db/sc"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
@@ -216,7 +223,7 @@ func TestGetProof(t *testing.T) {
 		_, err := testApp.Commit(context.Background())
 		require.Nil(t, err)
 	}
-	stateAPI := evmrpc.NewStateAPI(&MockClient{}, &testApp.EvmKeeper, func(int64) sdk.Context { return testApp.GetCheckCtx() }, evmrpc.ConnectionTypeHTTP)
+	stateAPI := evmrpc.NewStateAPI(&MockClient{}, &testApp.EvmKeeper, func(int64) sdk.Context { return testApp.GetCheckCtx() }, evmrpc.ConnectionTypeHTTP, nil)
 	require.Equal(t, "0x0000000000000000000000000000000000000000000000000000000000616263", testApp.EvmKeeper.GetState(testApp.GetCheckCtx(), evmAddr, common.BytesToHash(key)).Hex())
 	tests := []struct {
 		key         string
@@ -249,3 +256,52 @@ func TestGetProof(t *testing.T) {
 		require.Equal(t, "ics23:iavl", proofs[0].Ops[0].Type)
 	}
 }
+
+func TestGetProofFromSnapshot(t *testing.T) {
+	testApp := app.Setup(false, false, false)
+	testApp.FinalizeBlock(context.Background(), &abci.RequestFinalizeBlock{Height: 1})
+	testApp.SetDeliverStateToCommit()
+	_, err := testApp.Commit(context.Background())
+	require.NoError(t, err)
+	_, evmAddr := testkeeper.MockAddressPair()
+	key := []byte("test")
+	storeName := testApp.EvmKeeper.GetStoreKey().Name()
+	stateKey := append(evmtypes.StateKey(evmAddr), common.BytesToHash(key).Bytes()...)
+
+	// a memiavl commitment store holding versions the IAVL store of the app doesn't have
+	commitStore := sc.NewCommitStore(t.TempDir(), logger.NewNopLogger(), config.StateCommitConfig{})
+	commitStore.Initialize([]string{storeName})
+	_, err = commitStore.LoadVersion(0, false)
+	require.NoError(t, err)
+	defer commitStore.Close()
+	for i := 1; i <= 3; i++ {
+		require.NoError(t, commitStore.ApplyChangeSets([]*proto.NamedChangeSet{{
+			Name:      storeName,
+			Changeset: iavl.ChangeSet{Pairs: []*iavl.KVPair{{Key: stateKey, Value: common.BigToHash(big.NewInt(int64(i))).Bytes()}}},
+		}}))
+		_, err := commitStore.Commit()
+		require.NoError(t, err)
+	}
+
+	snapshots := evmrpc.NewProofSnapshots(commitStore, 1)
+	stateAPI := evmrpc.NewStateAPI(&MockClient{}, &testApp.EvmKeeper, func(int64) sdk.Context { return testApp.GetCheckCtx() }, evmrpc.ConnectionTypeHTTP, snapshots)
+	for _, height := range []int64{2, 3, 2} {
+		blockNr := rpc.BlockNumber(height)
+		res, err := stateAPI.GetProof(context.Background(), evmAddr, []string{string(key)}, rpc.BlockNumberOrHash{BlockNumber: &blockNr})
+		require.NoError(t, err)
+		require.Equal(t, common.BigToHash(big.NewInt(height)), common.HexToHash(res.HexValues[0]))
+		require.Equal(t, "ics23:iavl", res.StorageProof[0].Ops[0].Type)
+	}
+	require.Equal(t, 1, snapshots.Len())
+
+	// versions past the commitment store can't be loaded
+	blockNr := rpc.BlockNumber(4)
+	_, err = stateAPI.GetProof(context.Background(), evmAddr, []string{string(key)}, rpc.BlockNumberOrHash{BlockNumber: &blockNr})
+	require.Error(t, err)
+
+	// without snapshots, only the heights held by the IAVL store can be proven
+	stateAPI = evmrpc.NewStateAPI(&MockClient{}, &testApp.EvmKeeper, func(int64) sdk.Context { return testApp.GetCheckCtx() }, evmrpc.ConnectionTypeHTTP, nil)
+	blockNr = rpc.BlockNumber(2)
+	_, err = stateAPI.GetProof(context.Background(), evmAddr, []string{string(key)}, rpc.BlockNumberOrHash{BlockNumber: &blockNr})
+	require.ErrorContains(t, err, "proofs at height 2 are not available")
+}
@@ -13,9 +13,11 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/export"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/sei-protocol/sei-chain/utils"
 	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
 	"github.com/tendermint/tendermint/rpc/coretypes"
 	tmtypes "github.com/tendermint/tendermint/types"
@@ -23,6 +25,7 @@ import (
 
 const SleepInterval = 5 * time.Second
 const NewHeadsListenerBuffer = 10
+const PendingTxsPollInterval = 500 * time.Millisecond
 
 type SubscriptionAPI struct {
 	tmClient            rpcclient.Client
@@ -33,14 +36,26 @@ type SubscriptionAPI struct {
 	newHeadListenersMtx *sync.RWMutex
 	newHeadListeners    map[rpc.ID]chan map[string]interface{}
 	connectionType      ConnectionType
+
+	keeper                *keeper.Keeper
+	ctxProvider           func(int64) sdk.Context
+	txPoolConfig          *TxPoolConfig
+	pendingTxPollerOnce   *sync.Once
+	pendingTxListenersMtx *sync.RWMutex
+	pendingTxListeners    map[rpc.ID]*pendingTxListener
+}
+
+type pendingTxListener struct {
+	ch chan *ethtypes.Transaction
 }
 
 type SubscriptionConfig struct {
 	subscriptionCapacity int
 	newHeadLimit         uint64
+	pendingTxLimit       uint64
 }
 
-func NewSubscriptionAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, logFetcher *LogFetcher, subscriptionConfig *SubscriptionConfig, filterConfig *FilterConfig, connectionType ConnectionType) *SubscriptionAPI {
+func NewSubscriptionAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, logFetcher *LogFetcher, subscriptionConfig *SubscriptionConfig, txPoolConfig *TxPoolConfig, filterConfig *FilterConfig, connectionType ConnectionType) *SubscriptionAPI {
 	logFetcher.filterConfig = filterConfig
 	api := &SubscriptionAPI{
 		tmClient:            tmClient,
@@ -50,6 +65,13 @@ func NewSubscriptionAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider
 		newHeadListenersMtx: &sync.RWMutex{},
 		newHeadListeners:    make(map[rpc.ID]chan map[string]interface{}),
 		connectionType:      connectionType,
+
+		keeper:                k,
+		ctxProvider:           ctxProvider,
+		txPoolConfig:          txPoolConfig,
+		pendingTxPollerOnce:   &sync.Once{},
+		pendingTxListenersMtx: &sync.RWMutex{},
+		pendingTxListeners:    make(map[rpc.ID]*pendingTxListener),
 	}
 	id, subCh, err := api.subscriptionManager.Subscribe(context.Background(), NewHeadQueryBuilder(), api.subscriptonConfig.subscriptionCapacity)
 	if err != nil {
@@ -86,11 +108,11 @@ func NewSubscriptionAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider
 	return api
 }
 
-func handleListener(c chan map[string]interface{}, ethHeader map[string]interface{}) bool {
+func handleListener[T any](c chan T, event T) bool {
 	// if the channel is already closed, sending to it/closing it will panic
 	defer func() { _ = recover() }()
 	select {
-	case c <- ethHeader:
+	case c <- event:
 		return true
 	default:
 		// this path is hit when the buffer is full, meaning that the subscriber is not consuming
@@ -142,6 +164,129 @@ func (a *SubscriptionAPI) NewHeads(ctx context.Context) (s *rpc.Subscription, er
 	return rpcSub, nil
 }
 
+// NewPendingTransactions streams the hashes of the EVM txs entering the mempool, or the full
+// txs if fullTx is set. Txs already in the mempool when the subscription is created are not
+// sent. Subscribers that do not keep up are dropped like newHeads subscribers.
+func (a *SubscriptionAPI) NewPendingTransactions(ctx context.Context, fullTx *bool) (s *rpc.Subscription, err error) {
+	defer recordMetrics("eth_newPendingTransactions", a.connectionType, time.Now())
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	rpcSub := notifier.CreateSubscription()
+	// a single poll forwards at most maxNumTxs txs
+	listener := make(chan *ethtypes.Transaction, a.txPoolConfig.maxNumTxs)
+	a.pendingTxListenersMtx.Lock()
+	defer a.pendingTxListenersMtx.Unlock()
+	if uint64(len(a.pendingTxListeners)) >= a.subscriptonConfig.pendingTxLimit {
+		return nil, errors.New("no new subscription can be created")
+	}
+	a.pendingTxListeners[rpcSub.ID] = &pendingTxListener{ch: listener}
+	a.pendingTxPollerOnce.Do(func() { go a.pollPendingTxs() })
+
+	chainConfig := types.DefaultChainConfig().EthereumConfig(a.keeper.ChainID(a.ctxProvider(LatestCtxHeight)))
+	go func() {
+		defer recoverAndLog()
+	OUTER:
+		for {
+			select {
+			case tx, ok := <-listener:
+				if !ok {
+					break OUTER
+				}
+				var res interface{} = tx.Hash()
+				if fullTx != nil && *fullTx {
+					res = export.NewRPCPendingTransaction(tx, nil, chainConfig)
+				}
+				if err := notifier.Notify(rpcSub.ID, res); err != nil {
+					break OUTER
+				}
+			case <-rpcSub.Err():
+				break OUTER
+			}
+		}
+		a.pendingTxListenersMtx.Lock()
+		defer a.pendingTxListenersMtx.Unlock()
+		delete(a.pendingTxListeners, rpcSub.ID)
+		defer func() { _ = recover() }() // might have already been closed
+		close(listener)
+	}()
+
+	return rpcSub, nil
+}
+
+// pollPendingTxs forwards the EVM txs that entered the mempool since the previous poll to the
+// pending tx subscribers, since the mempool does not publish events for the txs it admits. Txs
+// that enter and leave the mempool between two polls are therefore never forwarded.
+func (a *SubscriptionAPI) pollPendingTxs() {
+	defer recoverAndLog()
+	tracker := &pendingTxTracker{}
+	for ; ; time.Sleep(PendingTxsPollInterval) {
+		a.pendingTxListenersMtx.RLock()
+		numListeners := len(a.pendingTxListeners)
+		a.pendingTxListenersMtx.RUnlock()
+		if numListeners == 0 {
+			tracker.reset()
+			continue
+		}
+
+		decoder := a.logFetcher.txConfigProvider(LatestCtxHeight).TxDecoder()
+		ethTxs, err := getPendingEthTxs(context.Background(), a.tmClient, decoder, a.txPoolConfig.maxNumTxs)
+		if err != nil {
+			fmt.Printf("error fetching pending txs due to %s\n", err)
+			continue
+		}
+		newTxs := tracker.update(ethTxs)
+		if len(newTxs) == 0 {
+			continue
+		}
+
+		a.pendingTxListenersMtx.Lock()
+		toDelete := []rpc.ID{}
+		for id, l := range a.pendingTxListeners {
+			for _, ethTx := range newTxs {
+				if !handleListener(l.ch, ethTx) {
+					toDelete = append(toDelete, id)
+					break
+				}
+			}
+		}
+		for _, id := range toDelete {
+			delete(a.pendingTxListeners, id)
+		}
+		a.pendingTxListenersMtx.Unlock()
+	}
+}
+
+// pendingTxTracker remembers the EVM txs of the previous mempool poll. The first poll after a
+// reset only seeds it, so the txs already in the mempool are never reported as new.
+type pendingTxTracker struct {
+	seen map[common.Hash]struct{}
+}
+
+// update returns the txs that were not in the previous poll and remembers the current ones.
+// Only the hashes still in the mempool are kept, which bounds the tracker by maxNumTxs.
+func (t *pendingTxTracker) update(ethTxs []*ethtypes.Transaction) []*ethtypes.Transaction {
+	current := make(map[common.Hash]struct{}, len(ethTxs))
+	var newTxs []*ethtypes.Transaction
+	for _, ethTx := range ethTxs {
+		current[ethTx.Hash()] = struct{}{}
+		if t.seen == nil {
+			continue
+		}
+		if _, ok := t.seen[ethTx.Hash()]; !ok {
+			newTxs = append(newTxs, ethTx)
+		}
+	}
+	t.seen = current
+	return newTxs
+}
+
+func (t *pendingTxTracker) reset() {
+	t.seen = nil
+}
+
 func (a *SubscriptionAPI) Logs(ctx context.Context, filter *filters.FilterCriteria) (s *rpc.Subscription, err error) {
 	defer recordMetrics("eth_logs", a.connectionType, time.Now())
 	notifier, supported := rpc.NotifierFromContext(ctx)
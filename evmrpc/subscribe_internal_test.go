@@ -0,0 +1,26 @@
+package evmrpc
+
+import (
+	"testing"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPendingTxTracker(t *testing.T) {
+	tx1 := ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: 1})
+	tx2 := ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: 2})
+	tx3 := ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: 3})
+	tracker := &pendingTxTracker{}
+
+	// The first poll only seeds the tracker
+	require.Empty(t, tracker.update([]*ethtypes.Transaction{tx1, tx2}))
+	require.Equal(t, []*ethtypes.Transaction{tx3}, tracker.update([]*ethtypes.Transaction{tx1, tx2, tx3}))
+	require.Empty(t, tracker.update([]*ethtypes.Transaction{tx2, tx3}))
+
+	// A tx that left the mempool is new again once it reenters
+	require.Equal(t, []*ethtypes.Transaction{tx1}, tracker.update([]*ethtypes.Transaction{tx1, tx3}))
+
+	tracker.reset()
+	require.Empty(t, tracker.update([]*ethtypes.Transaction{tx1, tx2, tx3}))
+}
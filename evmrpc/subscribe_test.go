@@ -83,6 +83,32 @@ func TestSubscribeNewHeads(t *testing.T) {
 	}
 }
 
+func TestSubscribeNewPendingTransactions(t *testing.T) {
+	t.Parallel()
+	for _, fullTx := range []bool{false, true} {
+		recvCh, done := sendWSRequestGood(t, "subscribe", "newPendingTransactions", fullTx)
+
+		var subscriptionId string
+		timer := time.NewTimer(2 * time.Second)
+	OUTER:
+		for {
+			select {
+			case resObj := <-recvCh:
+				if _, ok := resObj["error"]; ok {
+					t.Fatal("Received error:", resObj["error"])
+				}
+				// the tx already in the mock mempool is not sent as a new pending tx
+				require.Empty(t, subscriptionId, "unexpected notification %v", resObj)
+				subscriptionId = resObj["result"].(string)
+			case <-timer.C:
+				break OUTER
+			}
+		}
+		done <- struct{}{}
+		require.NotEmpty(t, subscriptionId)
+	}
+}
+
 func TestSubscribeEmptyLogs(t *testing.T) {
 	t.Parallel()
 	recvCh, done := sendWSRequestGood(t, "subscribe", "logs")
@@ -0,0 +1,477 @@
+package evmrpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	flatCallTracerName = "flatCallTracer"
+	prestateTracerName = "prestateTracer"
+	muxTracerName      = "muxTracer"
+
+	TraceTypeTrace     = "trace"
+	TraceTypeStateDiff = "stateDiff"
+	TraceTypeVMTrace   = "vmTrace"
+)
+
+var flatCallTracerConfig = json.RawMessage(`{"convertParityErrors":true}`)
+
+// TraceAPI serves the Parity/OpenEthereum style trace_* endpoints. It traces with the
+// flatCallTracer of the debug API, and shares its concurrency limit, look-back limit and
+// timeout.
+type TraceAPI struct {
+	debugAPI       *DebugAPI
+	connectionType ConnectionType
+}
+
+type TraceFilterArgs struct {
+	FromBlock   *rpc.BlockNumber `json:"fromBlock"`
+	ToBlock     *rpc.BlockNumber `json:"toBlock"`
+	FromAddress []common.Address `json:"fromAddress"`
+	ToAddress   []common.Address `json:"toAddress"`
+	After       *uint64          `json:"after"`
+	Count       *uint64          `json:"count"`
+}
+
+// TraceReplayResult is the result of trace_replayTransaction. Fields that were not
+// requested are left empty.
+type TraceReplayResult struct {
+	Output    hexutil.Bytes                        `json:"output"`
+	StateDiff map[common.Address]*AccountStateDiff `json:"stateDiff"`
+	Trace     []json.RawMessage                    `json:"trace"`
+	VMTrace   interface{}                          `json:"vmTrace"`
+}
+
+// AccountStateDiff is the Parity representation of how a tx changed an account. Every
+// field is either "=" (unchanged), {"+": value} (created), {"-": value} (deleted) or
+// {"*": {"from": value, "to": value}} (modified).
+type AccountStateDiff struct {
+	Balance interface{}                 `json:"balance"`
+	Code    interface{}                 `json:"code"`
+	Nonce   interface{}                 `json:"nonce"`
+	Storage map[common.Hash]interface{} `json:"storage"`
+}
+
+// flatTrace holds the fields of a flatCallTracer frame that trace_filter matches on
+type flatTrace struct {
+	Action struct {
+		From           *common.Address `json:"from"`
+		To             *common.Address `json:"to"`
+		SelfDestructed *common.Address `json:"address"`
+		RefundAddress  *common.Address `json:"refundAddress"`
+	} `json:"action"`
+	Result *struct {
+		Address *common.Address `json:"address"`
+		Output  hexutil.Bytes   `json:"output"`
+	} `json:"result"`
+}
+
+// prestateAccount is an account in the diff mode output of the prestateTracer
+type prestateAccount struct {
+	Balance *hexutil.Big                `json:"balance"`
+	Code    *hexutil.Bytes              `json:"code"`
+	Nonce   *uint64                     `json:"nonce"`
+	Storage map[common.Hash]common.Hash `json:"storage"`
+}
+
+type prestateDiff struct {
+	Pre  map[common.Address]*prestateAccount `json:"pre"`
+	Post map[common.Address]*prestateAccount `json:"post"`
+}
+
+func NewTraceAPI(debugAPI *DebugAPI, connectionType ConnectionType) *TraceAPI {
+	return &TraceAPI{debugAPI: debugAPI, connectionType: connectionType}
+}
+
+// Block returns the flat call traces of every tx in a block. Txs that cannot be traced
+// (e.g. panicked txs) are left out, like in the *ExcludeTraceFail endpoints.
+func (api *TraceAPI) Block(ctx context.Context, number rpc.BlockNumber) (result json.RawMessage, returnErr error) {
+	release := api.debugAPI.acquireTraceSemaphore()
+	defer release()
+
+	ctx, cancel := context.WithTimeout(ctx, api.debugAPI.traceTimeout)
+	defer cancel()
+
+	if err := api.checkLookback(number.Int64()); err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	defer recordMetrics("trace_block", api.connectionType, startTime)
+	traces, err := api.traceBlock(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+	if ctx.Err() != nil {
+		return nil, fmt.Errorf("trace timed out: %w", ctx.Err())
+	}
+	return encodeArrayResult(ctx, traces)
+}
+
+// Transaction returns the flat call traces of a tx
+func (api *TraceAPI) Transaction(ctx context.Context, hash common.Hash) (result []json.RawMessage, returnErr error) {
+	release := api.debugAPI.acquireTraceSemaphore()
+	defer release()
+
+	ctx, cancel := context.WithTimeout(ctx, api.debugAPI.traceTimeout)
+	defer cancel()
+
+	startTime := time.Now()
+	defer recordMetrics("trace_transaction", api.connectionType, startTime)
+	res, err := api.debugAPI.tracersAPI.TraceTransaction(ctx, hash, newTraceConfig(flatCallTracerName, flatCallTracerConfig))
+	if err != nil {
+		return nil, err
+	}
+	if ctx.Err() != nil {
+		return nil, fmt.Errorf("trace timed out: %w", ctx.Err())
+	}
+	return decodeFlatTraces(res)
+}
+
+// Filter returns the flat call traces in a block range that were made from or to one of
+// the given addresses. Without addresses every trace matches. "after" skips the first
+// matching traces and "count" caps how many are returned.
+func (api *TraceAPI) Filter(ctx context.Context, args TraceFilterArgs) (result []json.RawMessage, returnErr error) {
+	release := api.debugAPI.acquireTraceSemaphore()
+	defer release()
+
+	ctx, cancel := context.WithTimeout(ctx, api.debugAPI.traceTimeout)
+	defer cancel()
+
+	startTime := time.Now()
+	defer recordMetrics("trace_filter", api.connectionType, startTime)
+
+	latest := api.debugAPI.ctxProvider(LatestCtxHeight).BlockHeight()
+	fromBlock := resolveTraceBlockNumber(args.FromBlock, latest)
+	toBlock := resolveTraceBlockNumber(args.ToBlock, latest)
+	if fromBlock > toBlock {
+		return nil, fmt.Errorf("fromBlock %d is after toBlock %d", fromBlock, toBlock)
+	}
+	if err := api.checkLookback(fromBlock); err != nil {
+		return nil, err
+	}
+
+	var skip, count uint64
+	if args.After != nil {
+		skip = *args.After
+	}
+	if args.Count != nil {
+		count = *args.Count
+	}
+	fromAddresses := addressSet(args.FromAddress)
+	toAddresses := addressSet(args.ToAddress)
+
+	// Traces are only collected until they exceed the max response size, since the range
+	// may hold many more of them
+	limit := maxResponseSize(ctx)
+	size := 0
+	result = []json.RawMessage{}
+	for height := fromBlock; height <= toBlock; height++ {
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("trace timed out: %w", err)
+		}
+		traces, err := api.traceBlock(ctx, rpc.BlockNumber(height))
+		if err != nil {
+			return nil, err
+		}
+		for _, trace := range traces {
+			matches, err := matchFlatTrace(trace, fromAddresses, toAddresses)
+			if err != nil {
+				return nil, err
+			}
+			if !matches {
+				continue
+			}
+			if skip > 0 {
+				skip--
+				continue
+			}
+			result = append(result, trace)
+			size += len(trace) + 1
+			if limit > 0 && size > limit {
+				return nil, &responseTooLargeError{limit: limit}
+			}
+			if args.Count != nil && uint64(len(result)) >= count {
+				return result, nil
+			}
+		}
+	}
+	return result, nil
+}
+
+// ReplayTransaction re-executes a tx and returns the requested trace types, which can be
+// "trace" and "stateDiff". "vmTrace" is not supported.
+func (api *TraceAPI) ReplayTransaction(ctx context.Context, hash common.Hash, traceTypes []string) (result *TraceReplayResult, returnErr error) {
+	release := api.debugAPI.acquireTraceSemaphore()
+	defer release()
+
+	ctx, cancel := context.WithTimeout(ctx, api.debugAPI.traceTimeout)
+	defer cancel()
+
+	startTime := time.Now()
+	defer recordMetrics("trace_replayTransaction", api.connectionType, startTime)
+
+	var withTrace, withStateDiff bool
+	for _, traceType := range traceTypes {
+		switch traceType {
+		case TraceTypeTrace:
+			withTrace = true
+		case TraceTypeStateDiff:
+			withStateDiff = true
+		case TraceTypeVMTrace:
+			return nil, errors.New("vmTrace is not supported")
+		default:
+			return nil, fmt.Errorf("unknown trace type %q", traceType)
+		}
+	}
+
+	// Trace the call frames and the state changes in a single re-execution
+	muxConfig := map[string]json.RawMessage{flatCallTracerName: flatCallTracerConfig}
+	if withStateDiff {
+		muxConfig[prestateTracerName] = json.RawMessage(`{"diffMode":true}`)
+	}
+	muxConfigBz, err := json.Marshal(muxConfig)
+	if err != nil {
+		return nil, err
+	}
+	res, err := api.debugAPI.tracersAPI.TraceTransaction(ctx, hash, newTraceConfig(muxTracerName, muxConfigBz))
+	if err != nil {
+		return nil, err
+	}
+	if ctx.Err() != nil {
+		return nil, fmt.Errorf("trace timed out: %w", ctx.Err())
+	}
+	resBz, ok := res.(json.RawMessage)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type: %T", res)
+	}
+	muxResult := map[string]json.RawMessage{}
+	if err := json.Unmarshal(resBz, &muxResult); err != nil {
+		return nil, err
+	}
+
+	traces, err := decodeFlatTraces(muxResult[flatCallTracerName])
+	if err != nil {
+		return nil, err
+	}
+	result = &TraceReplayResult{Output: hexutil.Bytes{}, Trace: []json.RawMessage{}}
+	if len(traces) > 0 {
+		top := flatTrace{}
+		if err := json.Unmarshal(traces[0], &top); err != nil {
+			return nil, err
+		}
+		if top.Result != nil && top.Result.Output != nil {
+			result.Output = top.Result.Output
+		}
+	}
+	if withTrace {
+		result.Trace = traces
+	}
+	if withStateDiff {
+		diff := prestateDiff{}
+		if err := json.Unmarshal(muxResult[prestateTracerName], &diff); err != nil {
+			return nil, err
+		}
+		result.StateDiff = toParityStateDiff(diff)
+	}
+	return result, nil
+}
+
+func (api *TraceAPI) traceBlock(ctx context.Context, number rpc.BlockNumber) ([]json.RawMessage, error) {
+	txTraces, err := api.debugAPI.tracersAPI.TraceBlockByNumber(ctx, number, newTraceConfig(flatCallTracerName, flatCallTracerConfig))
+	if err != nil {
+		return nil, err
+	}
+	traces := []json.RawMessage{}
+	for _, txTrace := range txTraces {
+		if len(txTrace.Error) > 0 {
+			continue
+		}
+		txFrames, err := decodeFlatTraces(txTrace.Result)
+		if err != nil {
+			return nil, err
+		}
+		traces = append(traces, txFrames...)
+	}
+	return traces, nil
+}
+
+func (api *TraceAPI) checkLookback(number int64) error {
+	latest := api.debugAPI.ctxProvider(LatestCtxHeight).BlockHeight()
+	if api.debugAPI.maxBlockLookback >= 0 && number < latest-api.debugAPI.maxBlockLookback {
+		return fmt.Errorf("block number %d is beyond max lookback of %d", number, api.debugAPI.maxBlockLookback)
+	}
+	return nil
+}
+
+func newTraceConfig(tracer string, tracerConfig json.RawMessage) *tracers.TraceConfig {
+	return &tracers.TraceConfig{Tracer: &tracer, TracerConfig: tracerConfig}
+}
+
+// resolveTraceBlockNumber maps an omitted block number and the latest/safe/finalized/pending
+// tags to the latest height
+func resolveTraceBlockNumber(number *rpc.BlockNumber, latest int64) int64 {
+	if number == nil || number.Int64() < 0 {
+		return latest
+	}
+	return number.Int64()
+}
+
+func decodeFlatTraces(res interface{}) ([]json.RawMessage, error) {
+	var bz []byte
+	switch res := res.(type) {
+	case json.RawMessage:
+		bz = res
+	case []byte:
+		bz = res
+	default:
+		return nil, fmt.Errorf("unexpected type: %T", res)
+	}
+	traces := []json.RawMessage{}
+	if len(bz) == 0 {
+		return traces, nil
+	}
+	if err := json.Unmarshal(bz, &traces); err != nil {
+		return nil, err
+	}
+	return traces, nil
+}
+
+func addressSet(addresses []common.Address) map[common.Address]struct{} {
+	set := make(map[common.Address]struct{}, len(addresses))
+	for _, address := range addresses {
+		set[address] = struct{}{}
+	}
+	return set
+}
+
+// matchFlatTrace returns whether a trace was made from one of fromAddresses and to one of
+// toAddresses, where an empty set matches everything. The recipient of a contract creation
+// is the created contract and that of a self-destruct is the refund address.
+func matchFlatTrace(trace json.RawMessage, fromAddresses, toAddresses map[common.Address]struct{}) (bool, error) {
+	if len(fromAddresses) == 0 && len(toAddresses) == 0 {
+		return true, nil
+	}
+	decoded := flatTrace{}
+	if err := json.Unmarshal(trace, &decoded); err != nil {
+		return false, err
+	}
+	from := decoded.Action.From
+	if from == nil {
+		from = decoded.Action.SelfDestructed
+	}
+	to := decoded.Action.To
+	if to == nil && decoded.Result != nil {
+		to = decoded.Result.Address
+	}
+	if to == nil {
+		to = decoded.Action.RefundAddress
+	}
+	return matchAddress(from, fromAddresses) && matchAddress(to, toAddresses), nil
+}
+
+func matchAddress(address *common.Address, set map[common.Address]struct{}) bool {
+	if len(set) == 0 {
+		return true
+	}
+	if address == nil {
+		return false
+	}
+	_, ok := set[*address]
+	return ok
+}
+
+// toParityStateDiff converts the diff mode output of the prestateTracer, whose "post" only
+// holds the fields that changed, into the Parity state diff format
+func toParityStateDiff(diff prestateDiff) map[common.Address]*AccountStateDiff {
+	stateDiff := make(map[common.Address]*AccountStateDiff, len(diff.Pre)+len(diff.Post))
+	for address, pre := range diff.Pre {
+		post, ok := diff.Post[address]
+		if !ok {
+			// Self-destructed accounts are only in "pre"
+			accountDiff := &AccountStateDiff{
+				Balance: map[string]interface{}{"-": pre.balance()},
+				Code:    map[string]interface{}{"-": pre.code()},
+				Nonce:   map[string]interface{}{"-": pre.nonce()},
+				Storage: make(map[common.Hash]interface{}, len(pre.Storage)),
+			}
+			for key, value := range pre.Storage {
+				accountDiff.Storage[key] = map[string]interface{}{"-": value}
+			}
+			stateDiff[address] = accountDiff
+			continue
+		}
+		accountDiff := &AccountStateDiff{Balance: "=", Code: "=", Nonce: "=", Storage: map[common.Hash]interface{}{}}
+		if post.Balance != nil {
+			accountDiff.Balance = changedValue(pre.balance(), post.balance())
+		}
+		if post.Code != nil {
+			accountDiff.Code = changedValue(pre.code(), post.code())
+		}
+		if post.Nonce != nil {
+			accountDiff.Nonce = changedValue(pre.nonce(), post.nonce())
+		}
+		// "pre" only keeps the slots that changed, and cleared slots are left out of "post"
+		for key, value := range pre.Storage {
+			accountDiff.Storage[key] = changedValue(value, post.Storage[key])
+		}
+		for key, value := range post.Storage {
+			if _, ok := pre.Storage[key]; !ok {
+				accountDiff.Storage[key] = changedValue(common.Hash{}, value)
+			}
+		}
+		stateDiff[address] = accountDiff
+	}
+	for address, post := range diff.Post {
+		if _, ok := diff.Pre[address]; ok {
+			continue
+		}
+		// Accounts created by the tx are only in "post"
+		accountDiff := &AccountStateDiff{
+			Balance: map[string]interface{}{"+": post.balance()},
+			Code:    map[string]interface{}{"+": post.code()},
+			Nonce:   map[string]interface{}{"+": post.nonce()},
+			Storage: make(map[common.Hash]interface{}, len(post.Storage)),
+		}
+		for key, value := range post.Storage {
+			accountDiff.Storage[key] = map[string]interface{}{"+": value}
+		}
+		stateDiff[address] = accountDiff
+	}
+	return stateDiff
+}
+
+func changedValue(from, to interface{}) map[string]interface{} {
+	return map[string]interface{}{"*": map[string]interface{}{"from": from, "to": to}}
+}
+
+func (a *prestateAccount) balance() *hexutil.Big {
+	if a.Balance == nil {
+		return (*hexutil.Big)(new(big.Int))
+	}
+	return a.Balance
+}
+
+func (a *prestateAccount) code() hexutil.Bytes {
+	if a.Code == nil {
+		return hexutil.Bytes{}
+	}
+	return *a.Code
+}
+
+func (a *prestateAccount) nonce() hexutil.Uint64 {
+	if a.Nonce == nil {
+		return 0
+	}
+	return hexutil.Uint64(*a.Nonce)
+}
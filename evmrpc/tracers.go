@@ -175,14 +175,7 @@ func (api *SeiDebugAPI) TraceBlockByNumberExcludeTraceFail(ctx context.Context,
 	if !ok {
 		return nil, fmt.Errorf("unexpected type: %T", result)
 	}
-	finalTraces := make([]*tracers.TxTraceResult, 0, len(traces))
-	for _, trace := range traces {
-		if len(trace.Error) > 0 {
-			continue
-		}
-		finalTraces = append(finalTraces, trace)
-	}
-	return finalTraces, nil
+	return encodeArrayResult(ctx, excludeFailedTraces(traces))
 }
 
 func (api *SeiDebugAPI) TraceBlockByHashExcludeTraceFail(ctx context.Context, hash common.Hash, config *tracers.TraceConfig) (result interface{}, returnErr error) {
@@ -203,14 +196,21 @@ func (api *SeiDebugAPI) TraceBlockByHashExcludeTraceFail(ctx context.Context, ha
 	if !ok {
 		return nil, fmt.Errorf("unexpected type: %T", result)
 	}
-	finalTraces := make([]*tracers.TxTraceResult, 0, len(traces))
+	return encodeArrayResult(ctx, excludeFailedTraces(traces))
+}
+
+// excludeFailedTraces drops the traces of txs that failed to trace, in place, so that no other
+// slice keeps the remaining traces alive while they are encoded
+func excludeFailedTraces(traces []*tracers.TxTraceResult) []*tracers.TxTraceResult {
+	finalTraces := traces[:0]
 	for _, trace := range traces {
 		if len(trace.Error) > 0 {
 			continue
 		}
 		finalTraces = append(finalTraces, trace)
 	}
-	return finalTraces, nil
+	clear(traces[len(finalTraces):])
+	return finalTraces
 }
 
 // isPanicOrSyntheticTx returns true if the tx is a panic tx or if it is a synthetic tx. Used in the *ExcludeTraceFail endpoints.
@@ -280,8 +280,11 @@ func (api *DebugAPI) TraceBlockByNumber(ctx context.Context, number rpc.BlockNum
 
 	startTime := time.Now()
 	defer recordMetrics("debug_traceBlockByNumber", api.connectionType, startTime)
-	result, returnErr = api.tracersAPI.TraceBlockByNumber(ctx, number, config)
-	return
+	traces, err := api.tracersAPI.TraceBlockByNumber(ctx, number, config)
+	if err != nil {
+		return nil, err
+	}
+	return encodeArrayResult(ctx, traces)
 }
 
 func (api *DebugAPI) TraceBlockByHash(ctx context.Context, hash common.Hash, config *tracers.TraceConfig) (result interface{}, returnErr error) {
@@ -293,8 +296,11 @@ func (api *DebugAPI) TraceBlockByHash(ctx context.Context, hash common.Hash, con
 
 	startTime := time.Now()
 	defer recordMetrics("debug_traceBlockByHash", api.connectionType, startTime)
-	result, returnErr = api.tracersAPI.TraceBlockByHash(ctx, hash, config)
-	return
+	traces, err := api.tracersAPI.TraceBlockByHash(ctx, hash, config)
+	if err != nil {
+		return nil, err
+	}
+	return encodeArrayResult(ctx, traces)
 }
 
 func (api *DebugAPI) TraceCall(ctx context.Context, args export.TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash, config *tracers.TraceCallConfig) (result interface{}, returnErr error) {
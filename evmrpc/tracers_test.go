@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
 	"github.com/stretchr/testify/require"
 )
@@ -96,3 +97,127 @@ func TestTraceBlockByNumberUnlimitedLookback(t *testing.T) {
 	_, ok = resObj["result"]
 	require.True(t, ok, "expected result to be present")
 }
+
+const (
+	debugTraceFrom = "0x5b4eba929f3811980f5ae0c5d04fa200f837df4e"
+	debugTraceTo   = "0x0000000000000000000000000000000000010203"
+
+	debugTraceNonPanicHash = "0x376201ffbb2f6e9a0b4ade3dfe6d90d536aedd3bf3e6522ae93b41d6bcd4f12d"
+	debugTracePanicHash    = "0x5d5f69325c86b01562e11aaff42212739dd978b489fe8f7cbabf5e176daaed6b"
+)
+
+func requireDebugTraceTxTrace(t *testing.T, trace map[string]interface{}, txHash string, height int) {
+	action := trace["action"].(map[string]interface{})
+	require.Equal(t, "call", trace["type"])
+	require.Equal(t, "call", action["callType"])
+	require.Equal(t, debugTraceFrom, action["from"])
+	require.Equal(t, debugTraceTo, action["to"])
+	require.Equal(t, "0x3e8", action["value"])
+	require.Equal(t, "0x616263", action["input"])
+	require.Equal(t, txHash, trace["transactionHash"])
+	require.Equal(t, float64(height), trace["blockNumber"])
+	require.Equal(t, []interface{}{}, trace["traceAddress"])
+}
+
+func TestTraceTransactionParity(t *testing.T) {
+	resObj := sendRequestGoodWithNamespace(t, "trace", "transaction", DebugTraceHashHex)
+	result := resObj["result"].([]interface{})
+	require.Len(t, result, 1)
+	requireDebugTraceTxTrace(t, result[0].(map[string]interface{}), DebugTraceHashHex, MockHeight101)
+}
+
+func TestTraceReplayTransaction(t *testing.T) {
+	resObj := sendRequestGoodWithNamespace(t, "trace", "replayTransaction", DebugTraceHashHex, []interface{}{"trace", "stateDiff"})
+	result := resObj["result"].(map[string]interface{})
+	require.Equal(t, "0x", result["output"])
+	require.Nil(t, result["vmTrace"])
+	traces := result["trace"].([]interface{})
+	require.Len(t, traces, 1)
+	requireDebugTraceTxTrace(t, traces[0].(map[string]interface{}), DebugTraceHashHex, MockHeight101)
+
+	stateDiff := result["stateDiff"].(map[string]interface{})
+	sender := stateDiff[debugTraceFrom].(map[string]interface{})
+	senderBalance := sender["balance"].(map[string]interface{})["*"].(map[string]interface{})
+	require.NotEqual(t, senderBalance["from"], senderBalance["to"])
+	senderNonce := sender["nonce"].(map[string]interface{})["*"].(map[string]interface{})
+	require.NotEqual(t, senderNonce["from"], senderNonce["to"])
+	require.Equal(t, "=", sender["code"])
+
+	// Only the requested trace types are returned
+	resObj = sendRequestGoodWithNamespace(t, "trace", "replayTransaction", DebugTraceHashHex, []interface{}{"trace"})
+	result = resObj["result"].(map[string]interface{})
+	require.Nil(t, result["stateDiff"])
+	require.Len(t, result["trace"].([]interface{}), 1)
+
+	resObj = sendRequestGoodWithNamespace(t, "trace", "replayTransaction", DebugTraceHashHex, []interface{}{"vmTrace"})
+	require.Contains(t, resObj["error"].(map[string]interface{})["message"], "vmTrace is not supported")
+}
+
+func TestTraceFilterLookbackLimit(t *testing.T) {
+	// Using the strict server (look‑back = 1). Block 0 is far behind.
+	resObj := sendRequestStrictWithNamespace(t, "trace", "filter", map[string]interface{}{"fromBlock": "0x0"})
+	errObj, ok := resObj["error"].(map[string]interface{})
+	require.True(t, ok, "expected look‑back guard to trigger")
+	require.Contains(t, errObj["message"].(string), "beyond max lookback")
+
+	resObj = sendRequestStrictWithNamespace(t, "trace", "block", "0x0")
+	errObj, ok = resObj["error"].(map[string]interface{})
+	require.True(t, ok, "expected look‑back guard to trigger")
+	require.Contains(t, errObj["message"].(string), "beyond max lookback")
+}
+
+// Tracing block 0x67 replays a tx of the same sender as DebugTraceHashHex into the shared
+// mock state, after which its txs fail the ante handler, so these run after the other tests
+// and only check fields that do not depend on the tx outcome.
+func TestTraceBlock(t *testing.T) {
+	resObj := sendRequestGoodWithNamespace(t, "trace", "block", "0x67")
+	result := resObj["result"].([]interface{})
+	require.Len(t, result, 2)
+	for i, txHash := range []string{debugTraceNonPanicHash, debugTracePanicHash} {
+		requireTraceOf(t, result[i].(map[string]interface{}), txHash, i)
+	}
+}
+
+func TestTraceFilter(t *testing.T) {
+	filter := func(args map[string]interface{}) []interface{} {
+		args["fromBlock"] = "0x67"
+		args["toBlock"] = "0x67"
+		resObj := sendRequestGoodWithNamespace(t, "trace", "filter", args)
+		return resObj["result"].([]interface{})
+	}
+	from := []common.Address{common.HexToAddress(debugTraceFrom)}
+
+	result := filter(map[string]interface{}{"fromAddress": from})
+	require.Len(t, result, 2)
+
+	result = filter(map[string]interface{}{"toAddress": []common.Address{common.HexToAddress(debugTraceTo)}})
+	require.Len(t, result, 2)
+
+	result = filter(map[string]interface{}{"fromAddress": from, "toAddress": []common.Address{common.HexToAddress("0x1")}})
+	require.Empty(t, result)
+
+	result = filter(map[string]interface{}{"fromAddress": from, "after": 1})
+	require.Len(t, result, 1)
+	requireTraceOf(t, result[0].(map[string]interface{}), debugTracePanicHash, 1)
+
+	result = filter(map[string]interface{}{"fromAddress": from, "count": 1})
+	require.Len(t, result, 1)
+	requireTraceOf(t, result[0].(map[string]interface{}), debugTraceNonPanicHash, 0)
+}
+
+func requireTraceOf(t *testing.T, trace map[string]interface{}, txHash string, txPosition int) {
+	action := trace["action"].(map[string]interface{})
+	require.Equal(t, common.HexToAddress(debugTraceFrom), common.HexToAddress(action["from"].(string)))
+	require.Equal(t, debugTraceTo, action["to"])
+	require.Equal(t, txHash, trace["transactionHash"])
+	require.Equal(t, float64(txPosition), trace["transactionPosition"])
+	require.Equal(t, float64(MockHeight103), trace["blockNumber"])
+}
+
+func TestTraceBlockExecutionNotAvailable(t *testing.T) {
+	// the test app does not keep OCC executions
+	resObj := sendSeiRequestGood(t, "traceBlockExecution", "0x8")
+	errObj, ok := resObj["error"].(map[string]interface{})
+	require.True(t, ok)
+	require.Contains(t, errObj["message"].(string), "execution of block 8 is not available")
+}
@@ -45,6 +45,7 @@ type TransactionAPI struct {
 	includeSynthetic   bool
 	globalBlockCache   BlockCache
 	cacheCreationMutex *sync.Mutex
+	responseCache      *ResponseCache
 }
 
 type SeiTransactionAPI struct {
@@ -62,6 +63,7 @@ func NewTransactionAPI(
 	connectionType ConnectionType,
 	globalBlockCache BlockCache,
 	cacheCreationMutex *sync.Mutex,
+	responseCache *ResponseCache,
 ) *TransactionAPI {
 	return &TransactionAPI{
 		tmClient:           tmClient,
@@ -73,6 +75,7 @@ func NewTransactionAPI(
 		connectionType:     connectionType,
 		globalBlockCache:   globalBlockCache,
 		cacheCreationMutex: cacheCreationMutex,
+		responseCache:      responseCache,
 	}
 }
 
@@ -87,8 +90,9 @@ func NewSeiTransactionAPI(
 	isPanicTx func(ctx context.Context, hash common.Hash) (bool, error),
 	globalBlockCache BlockCache,
 	cacheCreationMutex *sync.Mutex,
+	responseCache *ResponseCache,
 ) *SeiTransactionAPI {
-	baseAPI := NewTransactionAPI(tmClient, k, ctxProvider, txConfigProvider, earliestVersion, homeDir, connectionType, globalBlockCache, cacheCreationMutex)
+	baseAPI := NewTransactionAPI(tmClient, k, ctxProvider, txConfigProvider, earliestVersion, homeDir, connectionType, globalBlockCache, cacheCreationMutex, responseCache)
 	baseAPI.includeSynthetic = true
 	return &SeiTransactionAPI{TransactionAPI: baseAPI, isPanicTx: isPanicTx}
 }
@@ -123,6 +127,11 @@ func getTransactionReceipt(
 		}
 	}
 
+	cacheKey := fmt.Sprintf("%s:%t", hash.Hex(), includeSynthetic)
+	if cached, ok := getCachedResponse[map[string]interface{}](t.responseCache, "eth_getTransactionReceipt", cacheKey); ok {
+		return cached, nil
+	}
+
 	receipt, err := t.keeper.GetReceipt(sdkctx, hash)
 	if err != nil {
 		if strings.Contains(err.Error(), "not found") {
@@ -172,7 +181,12 @@ func getTransactionReceipt(
 	if err != nil {
 		return nil, err
 	}
-	return encodeReceipt(t.ctxProvider, t.txConfigProvider, t.earliestVersion, receipt, t.keeper, block, includeSynthetic, t.globalBlockCache, t.cacheCreationMutex)
+	encodedReceipt, err := encodeReceipt(t.ctxProvider, t.txConfigProvider, t.earliestVersion, receipt, t.keeper, block, includeSynthetic, t.globalBlockCache, t.cacheCreationMutex)
+	if err != nil {
+		return nil, err
+	}
+	addCachedResponse(t.responseCache, "eth_getTransactionReceipt", cacheKey, height, sdkctx.BlockHeight(), encodedReceipt)
+	return encodedReceipt, nil
 }
 
 func (t *TransactionAPI) GetVMError(hash common.Hash) (result string, returnErr error) {
@@ -2,12 +2,17 @@ package evmrpc
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/export"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/sei-protocol/sei-chain/evmrpc/rpcutils"
 	"github.com/sei-protocol/sei-chain/x/evm/keeper"
 	"github.com/sei-protocol/sei-chain/x/evm/types"
@@ -40,35 +45,130 @@ func (t *TxPoolAPI) Content(ctx context.Context) (result map[string]map[string]m
 		"queued":  make(map[string]map[string]*export.RPCTransaction),
 	}
 
-	total := t.txPoolConfig.maxNumTxs
-	resUnconfirmedTxs, err := t.tmClient.UnconfirmedTxs(ctx, nil, &total)
+	pending, err := t.pendingTxs(ctx)
 	if err != nil {
 		return nil, err
 	}
+	chainConfig := t.chainConfig()
+	for fromAddr, txs := range pending {
+		content["pending"][fromAddr.String()] = make(map[string]*export.RPCTransaction, len(txs))
+		for nonceStr, ethTx := range txs {
+			content["pending"][fromAddr.String()][nonceStr] = export.NewRPCPendingTransaction(ethTx, nil, chainConfig)
+		}
+	}
+	return content, nil
+}
 
-	sdkCtx := t.ctxProvider(LatestCtxHeight)
-	for _, tx := range resUnconfirmedTxs.Txs {
-		ethTx := getEthTxForTxBz(tx, t.txConfigProvider(LatestCtxHeight).TxDecoder())
-		if ethTx == nil { // not an evm tx
-			continue
+// ContentFrom returns the unconfirmed txs sent by a single address
+func (t *TxPoolAPI) ContentFrom(ctx context.Context, address common.Address) (result map[string]map[string]*export.RPCTransaction, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("txpool_contentFrom", t.connectionType, startTime)
+	content := map[string]map[string]*export.RPCTransaction{
+		"pending": make(map[string]*export.RPCTransaction),
+		"queued":  make(map[string]*export.RPCTransaction),
+	}
+
+	pending, err := t.pendingTxs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	chainConfig := t.chainConfig()
+	for nonceStr, ethTx := range pending[address] {
+		content["pending"][nonceStr] = export.NewRPCPendingTransaction(ethTx, nil, chainConfig)
+	}
+	return content, nil
+}
+
+// Inspect returns a one-line summary of every unconfirmed tx, in the same format as geth
+func (t *TxPoolAPI) Inspect(ctx context.Context) (result map[string]map[string]map[string]string, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("txpool_inspect", t.connectionType, startTime)
+	content := map[string]map[string]map[string]string{
+		"pending": make(map[string]map[string]string),
+		"queued":  make(map[string]map[string]string),
+	}
+
+	pending, err := t.pendingTxs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for fromAddr, txs := range pending {
+		content["pending"][fromAddr.String()] = make(map[string]string, len(txs))
+		for nonceStr, ethTx := range txs {
+			content["pending"][fromAddr.String()][nonceStr] = inspectTx(ethTx)
 		}
+	}
+	return content, nil
+}
 
+// Status returns the number of unconfirmed txs
+func (t *TxPoolAPI) Status(ctx context.Context) (result map[string]hexutil.Uint, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("txpool_status", t.connectionType, startTime)
+	pending, err := t.pendingTxs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	numPending := 0
+	for _, txs := range pending {
+		numPending += len(txs)
+	}
+	return map[string]hexutil.Uint{
+		"pending": hexutil.Uint(numPending),
+		"queued":  hexutil.Uint(0),
+	}, nil
+}
+
+// pendingTxs returns the EVM txs among the first maxNumTxs txs of the mempool, keyed by
+// sender and nonce
+func (t *TxPoolAPI) pendingTxs(ctx context.Context) (map[common.Address]map[string]*ethtypes.Transaction, error) {
+	ethTxs, err := getPendingEthTxs(ctx, t.tmClient, t.txConfigProvider(LatestCtxHeight).TxDecoder(), t.txPoolConfig.maxNumTxs)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := t.ctxProvider(LatestCtxHeight)
+	pending := make(map[common.Address]map[string]*ethtypes.Transaction)
+	for _, ethTx := range ethTxs {
 		fromAddr, err := rpcutils.RecoverEVMSender(ethTx, sdkCtx.BlockHeight(), sdkCtx.BlockTime().Unix())
 		if err != nil {
 			return nil, err
 		}
+		if pending[fromAddr] == nil {
+			pending[fromAddr] = make(map[string]*ethtypes.Transaction)
+		}
+		pending[fromAddr][strconv.FormatUint(ethTx.Nonce(), 10)] = ethTx
+	}
+	return pending, nil
+}
 
-		nonce := ethTx.Nonce()
-		chainConfig := types.DefaultChainConfig().EthereumConfig(t.keeper.ChainID(sdkCtx))
-		res := export.NewRPCPendingTransaction(ethTx, nil, chainConfig)
-		nonceStr := strconv.FormatUint(nonce, 10)
-		if content["pending"][fromAddr.String()] == nil {
-			content["pending"][fromAddr.String()] = map[string]*export.RPCTransaction{
-				nonceStr: res,
-			}
-		} else {
-			content["pending"][fromAddr.String()][nonceStr] = res
+func (t *TxPoolAPI) chainConfig() *params.ChainConfig {
+	sdkCtx := t.ctxProvider(LatestCtxHeight)
+	return types.DefaultChainConfig().EthereumConfig(t.keeper.ChainID(sdkCtx))
+}
+
+// getPendingEthTxs returns the EVM txs among the first maxNumTxs txs of the mempool, in
+// mempool order
+func getPendingEthTxs(ctx context.Context, tmClient rpcclient.Client, decoder sdk.TxDecoder, maxNumTxs int) ([]*ethtypes.Transaction, error) {
+	total := maxNumTxs
+	resUnconfirmedTxs, err := tmClient.UnconfirmedTxs(ctx, nil, &total)
+	if err != nil {
+		return nil, err
+	}
+	ethTxs := make([]*ethtypes.Transaction, 0, len(resUnconfirmedTxs.Txs))
+	for _, tx := range resUnconfirmedTxs.Txs {
+		ethTx := getEthTxForTxBz(tx, decoder)
+		if ethTx == nil { // not an evm tx
+			continue
 		}
+		ethTxs = append(ethTxs, ethTx)
 	}
-	return content, nil
+	return ethTxs, nil
+}
+
+func inspectTx(tx *ethtypes.Transaction) string {
+	if to := tx.To(); to != nil {
+		return fmt.Sprintf("%s: %v wei + %v gas × %v wei", to.Hex(), tx.Value(), tx.Gas(), tx.GasPrice())
+	}
+	return fmt.Sprintf("contract creation: %v wei + %v gas × %v wei", tx.Value(), tx.Gas(), tx.GasPrice())
 }
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 )
 
@@ -65,6 +66,45 @@ func TestTxPoolContent(t *testing.T) {
 	require.Equal(t, 0, len(queuedMap))
 }
 
+func TestTxPoolStatus(t *testing.T) {
+	resObj := sendRequestGoodWithNamespace(t, "txpool", "status")
+	result := resObj["result"].(map[string]interface{})
+	require.Equal(t, "0x1", result["pending"])
+	require.Equal(t, "0x0", result["queued"])
+}
+
+func TestTxPoolInspect(t *testing.T) {
+	resObj := sendRequestGoodWithNamespace(t, "txpool", "inspect")
+	result := resObj["result"].(map[string]interface{})
+	pendingMap := result["pending"].(map[string]interface{})
+	require.Equal(t, 1, len(pendingMap))
+	for _, txns := range pendingMap {
+		summary := txns.(map[string]interface{})["2"].(string)
+		require.Equal(t, "0x0000000000000000000000000000000000010203: 2000 wei + 1000 gas × 10 wei", summary)
+	}
+	require.Equal(t, 0, len(result["queued"].(map[string]interface{})))
+}
+
+func TestTxPoolContentFrom(t *testing.T) {
+	content := sendRequestGoodWithNamespace(t, "txpool", "content")["result"].(map[string]interface{})
+	var fromAddr string
+	for addr := range content["pending"].(map[string]interface{}) {
+		fromAddr = addr
+	}
+
+	resObj := sendRequestGoodWithNamespace(t, "txpool", "contentFrom", common.HexToAddress(fromAddr))
+	result := resObj["result"].(map[string]interface{})
+	pendingMap := result["pending"].(map[string]interface{})
+	require.Equal(t, 1, len(pendingMap))
+	tx := pendingMap["2"].(map[string]interface{})
+	require.Equal(t, strings.ToLower(fromAddr), strings.ToLower(tx["from"].(string)))
+	require.Equal(t, 0, len(result["queued"].(map[string]interface{})))
+
+	resObj = sendRequestGoodWithNamespace(t, "txpool", "contentFrom", common.HexToAddress("0x0000000000000000000000000000000000000001"))
+	result = resObj["result"].(map[string]interface{})
+	require.Equal(t, 0, len(result["pending"].(map[string]interface{})))
+}
+
 func requireNotZeroHex(t *testing.T, hexStr string) {
 	if strings.HasPrefix(hexStr, "0x") {
 		hexStr = hexStr[2:]
@@ -1,11 +1,27 @@
 package evmrpc
 
 import (
+	"encoding/json"
 	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gorilla/websocket"
 	"github.com/sei-protocol/sei-chain/utils/metrics"
 )
 
+// Same as the websocket server of go-ethereum
+const (
+	wsReadBuffer       = 1024
+	wsWriteBuffer      = 1024
+	wsPingInterval     = 30 * time.Second
+	wsPingWriteTimeout = 5 * time.Second
+)
+
 type wsConnectionHandler struct {
 	underlying http.Handler
 }
@@ -18,3 +34,176 @@ func (h *wsConnectionHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 func NewWSConnectionHandler(handler http.Handler) http.Handler {
 	return &wsConnectionHandler{underlying: handler}
 }
+
+// newWebsocketHandler serves JSON-RPC to websocket connections like
+// rpc.Server.WebsocketHandler, except that:
+//   - if limiter is set, every message is checked against the rate limit of the client that
+//     opened the connection. Rejected messages are answered with a JSON-RPC error without
+//     reaching the server.
+//   - if maxResponseSize is positive, responses and notifications larger than it are
+//     replaced with a JSON-RPC error.
+func newWebsocketHandler(srv *rpc.Server, allowedOrigins []string, readLimit int64, limiter *RateLimiter, maxResponseSize int) http.Handler {
+	upgrader := websocket.Upgrader{
+		ReadBufferSize:  wsReadBuffer,
+		WriteBufferSize: wsWriteBuffer,
+		CheckOrigin:     wsHandshakeValidator(allowedOrigins),
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		clientKey := ""
+		if limiter != nil {
+			clientKey = limiter.ClientKey(r)
+		}
+		srv.ServeCodec(newWebsocketCodec(conn, readLimit, limiter, clientKey, maxResponseSize), 0)
+	})
+}
+
+type websocketConn struct {
+	conn            *websocket.Conn
+	limiter         *RateLimiter
+	clientKey       string
+	maxResponseSize int
+	writeMtx        sync.Mutex // the codec serializes its own writes, but not with the ones made here
+	closeOnce       sync.Once
+	closed          chan struct{}
+}
+
+func newWebsocketCodec(conn *websocket.Conn, readLimit int64, limiter *RateLimiter, clientKey string, maxResponseSize int) rpc.ServerCodec {
+	conn.SetReadLimit(readLimit)
+	c := &websocketConn{conn: conn, limiter: limiter, clientKey: clientKey, maxResponseSize: maxResponseSize, closed: make(chan struct{})}
+	go c.pingLoop()
+	return rpc.NewFuncCodec(conn, c.writeJSON, c.readJSON)
+}
+
+func (c *websocketConn) writeJSON(v interface{}, _ bool) error {
+	msg, err := limitMessageSize(v, c.maxResponseSize)
+	if err != nil {
+		return err
+	}
+	c.writeMtx.Lock()
+	defer c.writeMtx.Unlock()
+	// Terminated with a newline like the messages of websocket.Conn.WriteJSON
+	return c.conn.WriteMessage(websocket.TextMessage, append(msg, '\n'))
+}
+
+// readJSON returns the next message within the rate limit of the client
+func (c *websocketConn) readJSON(v interface{}) error {
+	for {
+		_, msg, err := c.conn.ReadMessage()
+		if err != nil {
+			c.closeOnce.Do(func() { close(c.closed) })
+			return err
+		}
+		if c.limiter == nil {
+			return json.Unmarshal(msg, v)
+		}
+		allowed, _, response := c.limiter.allowMessage(c.clientKey, msg, ConnectionTypeWS)
+		if allowed {
+			return json.Unmarshal(msg, v)
+		}
+		if err := c.writeJSON(response, true); err != nil {
+			return err
+		}
+	}
+}
+
+// pingLoop keeps idle connections alive, e.g. those that only receive subscription events
+func (c *websocketConn) pingLoop() {
+	ticker := time.NewTicker(wsPingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.closed:
+			return
+		case <-ticker.C:
+			if err := c.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsPingWriteTimeout)); err != nil {
+				_ = c.conn.Close()
+				return
+			}
+		}
+	}
+}
+
+// wsHandshakeValidator returns an origin check that behaves like the one of
+// rpc.Server.WebsocketHandler. Requests without an Origin header come from non-browser
+// clients, for which checking the origin adds no security.
+func wsHandshakeValidator(allowedOrigins []string) func(*http.Request) bool {
+	origins := []string{}
+	allowAllOrigins := false
+	for _, origin := range allowedOrigins {
+		if origin == "*" {
+			allowAllOrigins = true
+		}
+		if origin != "" {
+			origins = append(origins, origin)
+		}
+	}
+	// allow localhost if no allowedOrigins are specified.
+	if len(origins) == 0 {
+		origins = append(origins, "http://localhost")
+		if hostname, err := os.Hostname(); err == nil {
+			origins = append(origins, "http://"+hostname)
+		}
+	}
+
+	return func(req *http.Request) bool {
+		if _, ok := req.Header["Origin"]; !ok {
+			return true
+		}
+		origin := strings.ToLower(req.Header.Get("Origin"))
+		if allowAllOrigins {
+			return true
+		}
+		for _, allowedOrigin := range origins {
+			if ruleAllowsOrigin(allowedOrigin, origin) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func ruleAllowsOrigin(allowedOrigin string, browserOrigin string) bool {
+	allowedScheme, allowedHostname, allowedPort, err := parseOriginURL(allowedOrigin)
+	if err != nil {
+		return false
+	}
+	browserScheme, browserHostname, browserPort, err := parseOriginURL(browserOrigin)
+	if err != nil {
+		return false
+	}
+	if allowedScheme != "" && allowedScheme != browserScheme {
+		return false
+	}
+	if allowedHostname != "" && allowedHostname != browserHostname {
+		return false
+	}
+	if allowedPort != "" && allowedPort != browserPort {
+		return false
+	}
+	return true
+}
+
+func parseOriginURL(origin string) (string, string, string, error) {
+	parsedURL, err := url.Parse(strings.ToLower(origin))
+	if err != nil {
+		return "", "", "", err
+	}
+	var scheme, hostname, port string
+	if strings.Contains(origin, "://") {
+		scheme = parsedURL.Scheme
+		hostname = parsedURL.Hostname()
+		port = parsedURL.Port()
+	} else {
+		scheme = ""
+		hostname = parsedURL.Scheme
+		port = parsedURL.Opaque
+		if hostname == "" {
+			hostname = origin
+		}
+	}
+	return scheme, hostname, port, nil
+}
@@ -13,6 +13,7 @@ import (
 	addrv606 "github.com/sei-protocol/sei-chain/precompiles/addr/legacy/v606"
 	addrv610 "github.com/sei-protocol/sei-chain/precompiles/addr/legacy/v610"
 	addrv614 "github.com/sei-protocol/sei-chain/precompiles/addr/legacy/v614"
+	addrv620 "github.com/sei-protocol/sei-chain/precompiles/addr/legacy/v620"
 	"github.com/sei-protocol/sei-chain/precompiles/utils"
 )
 
@@ -30,6 +31,7 @@ func GetVersioned(latestUpgrade string, keepers utils.Keepers) utils.VersionedPr
 		"v6.0.6":      check(addrv606.NewPrecompile(keepers)),
 		"v6.1.0":      check(addrv610.NewPrecompile(keepers)),
 		"v6.1.4":      check(addrv614.NewPrecompile(keepers)),
+		"v6.2.0":      check(addrv620.NewPrecompile(keepers)),
 	}
 }
 
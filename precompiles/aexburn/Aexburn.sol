@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+address constant AEXBURN_PRECOMPILE_ADDRESS = 0x000000000000000000000000000000000000100D;
+
+IAexburn constant AEXBURN_CONTRACT = IAexburn(AEXBURN_PRECOMPILE_ADDRESS);
+
+/**
+ * @dev Interface for reading the AEX fee burn and inflation state of the aexburn module.
+ * Amounts are in uaex, rates are fixed point numbers with 18 decimals.
+ */
+interface IAexburn {
+    // Queries
+    /**
+     * @dev Burn rate applied to the fees of the next block.
+     * @return rate Burn rate, 18 decimals (5e17 = 50%).
+     */
+    function burnRate() external view returns (uint256 rate);
+
+    /**
+     * @dev Total uaex burned by the aexburn module.
+     */
+    function totalBurned() external view returns (uint256 amount);
+
+    /**
+     * @dev Total uaex minted by aexburn inflation.
+     */
+    function totalMinted() external view returns (uint256 amount);
+
+    /**
+     * @dev Net supply change (minted - burned) over the last 12 months, negative when deflationary.
+     */
+    function netSupply12Month() external view returns (int256 amount);
+
+    /**
+     * @dev Reverse brake state, which lowers the burn rate after consecutive deflationary periods.
+     */
+    function reverseBrake() external view returns (ReverseBrake memory state);
+
+    /**
+     * @dev uaex held in the income smoothing buffer.
+     */
+    function incomeBuffer() external view returns (uint256 balance);
+
+    // Structs
+    struct ReverseBrake {
+        bool active;
+        uint32 consecutiveNegativePeriods;
+        // Burn rate reduction, 18 decimals
+        uint256 currentReduction;
+        uint64 lastCheckEpoch;
+        int256 lastNetSupply;
+    }
+}
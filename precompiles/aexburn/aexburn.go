@@ -0,0 +1,181 @@
+package aexburn
+
+import (
+	"embed"
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/vm"
+	pcommon "github.com/sei-protocol/sei-chain/precompiles/common"
+	"github.com/sei-protocol/sei-chain/precompiles/utils"
+)
+
+const (
+	BurnRateMethod         = "burnRate"
+	TotalBurnedMethod      = "totalBurned"
+	TotalMintedMethod      = "totalMinted"
+	NetSupply12MonthMethod = "netSupply12Month"
+	ReverseBrakeMethod     = "reverseBrake"
+	IncomeBufferMethod     = "incomeBuffer"
+)
+
+const (
+	AexburnAddress = "0x000000000000000000000000000000000000100D"
+)
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+type PrecompileExecutor struct {
+	evmKeeper     utils.EVMKeeper
+	aexburnKeeper utils.AexburnKeeper
+
+	BurnRateId         []byte
+	TotalBurnedId      []byte
+	TotalMintedId      []byte
+	NetSupply12MonthId []byte
+	ReverseBrakeId     []byte
+	IncomeBufferId     []byte
+}
+
+// ReverseBrake mirrors types.ReverseBrakeState with decimals scaled to 18 places
+type ReverseBrake struct {
+	Active                     bool     `json:"active"`
+	ConsecutiveNegativePeriods uint32   `json:"consecutiveNegativePeriods"`
+	CurrentReduction           *big.Int `json:"currentReduction"`
+	LastCheckEpoch             uint64   `json:"lastCheckEpoch"`
+	LastNetSupply              *big.Int `json:"lastNetSupply"`
+}
+
+func NewPrecompile(keepers utils.Keepers) (*pcommon.DynamicGasPrecompile, error) {
+	newAbi := pcommon.MustGetABI(f, "abi.json")
+
+	p := &PrecompileExecutor{
+		evmKeeper:     keepers.EVMK(),
+		aexburnKeeper: keepers.AexburnK(),
+	}
+
+	for name, m := range newAbi.Methods {
+		switch name {
+		case BurnRateMethod:
+			p.BurnRateId = m.ID
+		case TotalBurnedMethod:
+			p.TotalBurnedId = m.ID
+		case TotalMintedMethod:
+			p.TotalMintedId = m.ID
+		case NetSupply12MonthMethod:
+			p.NetSupply12MonthId = m.ID
+		case ReverseBrakeMethod:
+			p.ReverseBrakeId = m.ID
+		case IncomeBufferMethod:
+			p.IncomeBufferId = m.ID
+		}
+	}
+
+	return pcommon.NewDynamicGasPrecompile(newAbi, p, common.HexToAddress(AexburnAddress), "aexburn"), nil
+}
+
+// RequiredGas returns the required bare minimum gas to execute the precompile.
+func (p PrecompileExecutor) RequiredGas(input []byte, method *abi.Method) uint64 {
+	return pcommon.DefaultGasCost(input, p.IsTransaction(method.Name))
+}
+
+func (p PrecompileExecutor) Execute(ctx sdk.Context, method *abi.Method, caller common.Address, callingContract common.Address, args []interface{}, value *big.Int, readOnly bool, evm *vm.EVM, suppliedGas uint64, hooks *tracing.Hooks) (bz []byte, remainingGas uint64, err error) {
+	// Needed to catch gas meter panics
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("execution reverted: %v", r)
+		}
+	}()
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		return nil, 0, err
+	}
+	if err := pcommon.ValidateArgsLength(args, 0); err != nil {
+		return nil, 0, err
+	}
+
+	switch method.Name {
+	case BurnRateMethod:
+		return p.burnRate(ctx, method)
+	case TotalBurnedMethod:
+		return p.totalBurned(ctx, method)
+	case TotalMintedMethod:
+		return p.totalMinted(ctx, method)
+	case NetSupply12MonthMethod:
+		return p.netSupply12Month(ctx, method)
+	case ReverseBrakeMethod:
+		return p.reverseBrake(ctx, method)
+	case IncomeBufferMethod:
+		return p.incomeBuffer(ctx, method)
+	}
+	return
+}
+
+// burnRate returns the burn rate the next block's fees will be burned at, as an
+// 18 decimal fixed point number
+func (p PrecompileExecutor) burnRate(ctx sdk.Context, method *abi.Method) ([]byte, uint64, error) {
+	rate := p.aexburnKeeper.CalculateDynamicBurnRate(ctx, p.aexburnKeeper.GetParams(ctx))
+	bz, err := method.Outputs.Pack(rate.BigInt())
+	return bz, pcommon.GetRemainingGas(ctx, p.evmKeeper), err
+}
+
+func (p PrecompileExecutor) totalBurned(ctx sdk.Context, method *abi.Method) ([]byte, uint64, error) {
+	stats := p.aexburnKeeper.GetBurnStats(ctx)
+	bz, err := method.Outputs.Pack(intOrZero(stats.TotalBurned))
+	return bz, pcommon.GetRemainingGas(ctx, p.evmKeeper), err
+}
+
+func (p PrecompileExecutor) totalMinted(ctx sdk.Context, method *abi.Method) ([]byte, uint64, error) {
+	stats := p.aexburnKeeper.GetInflationStats(ctx)
+	bz, err := method.Outputs.Pack(intOrZero(stats.TotalMinted))
+	return bz, pcommon.GetRemainingGas(ctx, p.evmKeeper), err
+}
+
+func (p PrecompileExecutor) netSupply12Month(ctx sdk.Context, method *abi.Method) ([]byte, uint64, error) {
+	bz, err := method.Outputs.Pack(intOrZero(p.aexburnKeeper.Get12MonthNetSupply(ctx)))
+	return bz, pcommon.GetRemainingGas(ctx, p.evmKeeper), err
+}
+
+func (p PrecompileExecutor) reverseBrake(ctx sdk.Context, method *abi.Method) ([]byte, uint64, error) {
+	state := p.aexburnKeeper.GetReverseBrakeState(ctx)
+	currentReduction := big.NewInt(0)
+	if !state.CurrentReduction.IsNil() {
+		currentReduction = state.CurrentReduction.BigInt()
+	}
+	bz, err := method.Outputs.Pack(ReverseBrake{
+		Active:                     state.IsBrakeActive,
+		ConsecutiveNegativePeriods: state.ConsecutiveNegativePeriods,
+		CurrentReduction:           currentReduction,
+		LastCheckEpoch:             state.LastCheckEpoch,
+		LastNetSupply:              intOrZero(state.LastNetSupply),
+	})
+	return bz, pcommon.GetRemainingGas(ctx, p.evmKeeper), err
+}
+
+func (p PrecompileExecutor) incomeBuffer(ctx sdk.Context, method *abi.Method) ([]byte, uint64, error) {
+	buffer := p.aexburnKeeper.GetIncomeBuffer(ctx)
+	bz, err := method.Outputs.Pack(intOrZero(buffer.Balance))
+	return bz, pcommon.GetRemainingGas(ctx, p.evmKeeper), err
+}
+
+func (p PrecompileExecutor) EVMKeeper() utils.EVMKeeper {
+	return p.evmKeeper
+}
+
+func (PrecompileExecutor) IsTransaction(string) bool {
+	return false
+}
+
+func intOrZero(i sdk.Int) *big.Int {
+	if i.IsNil() {
+		return big.NewInt(0)
+	}
+	return i.BigInt()
+}
@@ -0,0 +1,106 @@
+package aexburn_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/sei-protocol/sei-chain/precompiles/aexburn"
+	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	aexburntypes "github.com/sei-protocol/sei-chain/x/aexburn/types"
+	"github.com/sei-protocol/sei-chain/x/evm/state"
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestAexburnViews(t *testing.T) {
+	testApp := testkeeper.EVMTestApp
+	ctx := testApp.NewContext(false, tmtypes.Header{}).WithBlockHeight(2)
+	k := &testApp.EvmKeeper
+	aexburnKeeper := testApp.AexburnKeeper
+
+	params := aexburntypes.DefaultParams()
+	aexburnKeeper.SetParams(ctx, params)
+	burnStats := aexburnKeeper.GetBurnStats(ctx)
+	burnStats.TotalBurned = sdk.NewInt(1_000_000)
+	aexburnKeeper.SetBurnStats(ctx, burnStats)
+	inflationStats := aexburnKeeper.GetInflationStats(ctx)
+	inflationStats.TotalMinted = sdk.NewInt(400_000)
+	aexburnKeeper.SetInflationStats(ctx, inflationStats)
+	aexburnKeeper.SetMonthlyBurnData(ctx, aexburntypes.MonthlyBurnData{
+		MonthIndex:   aexburntypes.CalendarMonthIndex(ctx.BlockTime()),
+		BurnedAmount: sdk.NewInt(1_000_000),
+		MintedAmount: sdk.NewInt(400_000),
+	})
+	aexburnKeeper.SetReverseBrakeState(ctx, aexburntypes.ReverseBrakeState{
+		ConsecutiveNegativePeriods: 3,
+		IsBrakeActive:              true,
+		CurrentReduction:           sdk.NewDecWithPrec(1, 1),
+		LastCheckEpoch:             30,
+		LastNetSupply:              sdk.NewInt(-600_000),
+	})
+	buffer := aexburnKeeper.GetIncomeBuffer(ctx)
+	buffer.Balance = sdk.NewInt(250_000)
+	aexburnKeeper.SetIncomeBuffer(ctx, buffer)
+
+	privKey := testkeeper.MockPrivateKey()
+	senderAddr, senderEVMAddr := testkeeper.PrivateKeyToAddresses(privKey)
+	k.SetAddressMapping(ctx, senderAddr, senderEVMAddr)
+	statedb := state.NewDBImpl(ctx, k, true)
+	evm := vm.EVM{
+		StateDB:   statedb,
+		TxContext: vm.TxContext{Origin: senderEVMAddr},
+	}
+
+	p, err := aexburn.NewPrecompile(testApp.GetPrecompileKeepers())
+	require.Nil(t, err)
+	executor := p.GetExecutor().(*aexburn.PrecompileExecutor)
+
+	call := func(methodID []byte) []interface{} {
+		method, err := p.ABI.MethodById(methodID)
+		require.Nil(t, err)
+		res, _, err := p.RunAndCalculateGas(&evm, common.Address{}, common.Address{}, methodID, 100000, nil, nil, true, false)
+		require.Nil(t, err)
+		outputs, err := method.Outputs.Unpack(res)
+		require.Nil(t, err)
+		return outputs
+	}
+
+	expectedRate := aexburnKeeper.CalculateDynamicBurnRate(ctx, params)
+	require.Equal(t, expectedRate.BigInt(), call(executor.BurnRateId)[0])
+	require.Equal(t, big.NewInt(1_000_000), call(executor.TotalBurnedId)[0])
+	require.Equal(t, big.NewInt(400_000), call(executor.TotalMintedId)[0])
+	require.Equal(t, big.NewInt(-600_000), call(executor.NetSupply12MonthId)[0])
+	require.Equal(t, big.NewInt(250_000), call(executor.IncomeBufferId)[0])
+
+	require.Equal(t, struct {
+		Active                     bool     `json:"active"`
+		ConsecutiveNegativePeriods uint32   `json:"consecutiveNegativePeriods"`
+		CurrentReduction           *big.Int `json:"currentReduction"`
+		LastCheckEpoch             uint64   `json:"lastCheckEpoch"`
+		LastNetSupply              *big.Int `json:"lastNetSupply"`
+	}{
+		Active:                     true,
+		ConsecutiveNegativePeriods: 3,
+		CurrentReduction:           sdk.NewDecWithPrec(1, 1).BigInt(),
+		LastCheckEpoch:             30,
+		LastNetSupply:              big.NewInt(-600_000),
+	}, call(executor.ReverseBrakeId)[0])
+}
+
+func TestAexburnRejectsValue(t *testing.T) {
+	testApp := testkeeper.EVMTestApp
+	ctx := testApp.NewContext(false, tmtypes.Header{}).WithBlockHeight(2)
+	k := &testApp.EvmKeeper
+	statedb := state.NewDBImpl(ctx, k, true)
+	evm := vm.EVM{StateDB: statedb}
+
+	p, err := aexburn.NewPrecompile(testApp.GetPrecompileKeepers())
+	require.Nil(t, err)
+	executor := p.GetExecutor().(*aexburn.PrecompileExecutor)
+
+	_, _, err = p.RunAndCalculateGas(&evm, common.Address{}, common.Address{}, executor.TotalBurnedId, 100000, big.NewInt(1), nil, false, false)
+	require.NotNil(t, err)
+}
@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+address constant AUTHZ_PRECOMPILE_ADDRESS = 0x000000000000000000000000000000000000100E;
+
+IAuthz constant AUTHZ_CONTRACT = IAuthz(
+    AUTHZ_PRECOMPILE_ADDRESS
+);
+
+struct Coin {
+    uint256 amount;
+    string denom;
+}
+
+/**
+ * @dev Interface for granting and revoking x/authz authorizations.
+ * The granter is always the caller, which must be associated with a Sei address.
+ * Grantees must be associated as well. Calls through staticcall or delegatecall revert.
+ */
+interface IAuthz {
+    // Transactions
+    /**
+     * @dev Allows `grantee` to execute any message of type `msgTypeUrl` on behalf of the caller.
+     * @param grantee EVM address of the grantee.
+     * @param msgTypeUrl Message type URL, e.g. "/cosmos.gov.v1beta1.MsgVote".
+     * @param expiration Unix timestamp in seconds, must be after the current block time.
+     */
+    function grantGenericAuthorization(
+        address grantee,
+        string memory msgTypeUrl,
+        int64 expiration
+    ) external returns (bool success);
+
+    /**
+     * @dev Allows `grantee` to send up to `spendLimit` from the caller's account.
+     * @param grantee EVM address of the grantee.
+     * @param spendLimit Coins the grantee may send in total, must not be empty.
+     * @param expiration Unix timestamp in seconds, must be after the current block time.
+     */
+    function grantSendAuthorization(
+        address grantee,
+        Coin[] memory spendLimit,
+        int64 expiration
+    ) external returns (bool success);
+
+    /**
+     * @dev Revokes the authorization of `grantee` for `msgTypeUrl`.
+     * Send authorizations are revoked with "/cosmos.bank.v1beta1.MsgSend".
+     */
+    function revokeAuthorization(
+        address grantee,
+        string memory msgTypeUrl
+    ) external returns (bool success);
+}
@@ -0,0 +1,176 @@
+package authz
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/vm"
+	pcommon "github.com/sei-protocol/sei-chain/precompiles/common"
+	"github.com/sei-protocol/sei-chain/precompiles/utils"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+const (
+	GrantGenericAuthorizationMethod = "grantGenericAuthorization"
+	GrantSendAuthorizationMethod    = "grantSendAuthorization"
+	RevokeAuthorizationMethod       = "revokeAuthorization"
+)
+
+const (
+	AuthzAddress = "0x000000000000000000000000000000000000100E"
+)
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+type PrecompileExecutor struct {
+	evmKeeper      utils.EVMKeeper
+	authzMsgServer utils.AuthzMsgServer
+
+	GrantGenericAuthorizationID []byte
+	GrantSendAuthorizationID    []byte
+	RevokeAuthorizationID       []byte
+}
+
+func NewPrecompile(keepers utils.Keepers) (*pcommon.DynamicGasPrecompile, error) {
+	newAbi := pcommon.MustGetABI(f, "abi.json")
+
+	p := &PrecompileExecutor{
+		evmKeeper:      keepers.EVMK(),
+		authzMsgServer: keepers.AuthzMS(),
+	}
+
+	for name, m := range newAbi.Methods {
+		switch name {
+		case GrantGenericAuthorizationMethod:
+			p.GrantGenericAuthorizationID = m.ID
+		case GrantSendAuthorizationMethod:
+			p.GrantSendAuthorizationID = m.ID
+		case RevokeAuthorizationMethod:
+			p.RevokeAuthorizationID = m.ID
+		}
+	}
+
+	return pcommon.NewDynamicGasPrecompile(newAbi, p, common.HexToAddress(AuthzAddress), "authz"), nil
+}
+
+// RequiredGas returns the required bare minimum gas to execute the precompile.
+func (p PrecompileExecutor) RequiredGas(input []byte, method *abi.Method) uint64 {
+	return pcommon.DefaultGasCost(input, p.IsTransaction(method.Name))
+}
+
+func (p PrecompileExecutor) Execute(ctx sdk.Context, method *abi.Method, caller common.Address, callingContract common.Address, args []interface{}, value *big.Int, readOnly bool, evm *vm.EVM, suppliedGas uint64, hooks *tracing.Hooks) (bz []byte, remainingGas uint64, err error) {
+	// Needed to catch gas meter panics
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("execution reverted: %v", r)
+		}
+	}()
+	if readOnly {
+		return nil, 0, errors.New("cannot call authz precompile from staticcall")
+	}
+	// grants are always made on behalf of the immediate caller
+	if ctx.EVMPrecompileCalledFromDelegateCall() {
+		return nil, 0, errors.New("cannot delegatecall authz")
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		return nil, 0, err
+	}
+
+	switch method.Name {
+	case GrantGenericAuthorizationMethod:
+		return p.grantGenericAuthorization(ctx, method, caller, args)
+	case GrantSendAuthorizationMethod:
+		return p.grantSendAuthorization(ctx, method, caller, args)
+	case RevokeAuthorizationMethod:
+		return p.revokeAuthorization(ctx, method, caller, args)
+	}
+	return
+}
+
+func (p PrecompileExecutor) grantGenericAuthorization(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}) ([]byte, uint64, error) {
+	if err := pcommon.ValidateArgsLength(args, 3); err != nil {
+		return nil, 0, err
+	}
+	return p.grant(ctx, method, caller, args[0], authztypes.NewGenericAuthorization(args[1].(string)), args[2].(int64))
+}
+
+func (p PrecompileExecutor) grantSendAuthorization(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}) ([]byte, uint64, error) {
+	if err := pcommon.ValidateArgsLength(args, 3); err != nil {
+		return nil, 0, err
+	}
+	spendLimit, err := pcommon.GetCoinsFromArg(args[1])
+	if err != nil {
+		return nil, 0, err
+	}
+	return p.grant(ctx, method, caller, args[0], banktypes.NewSendAuthorization(spendLimit), args[2].(int64))
+}
+
+func (p PrecompileExecutor) grant(ctx sdk.Context, method *abi.Method, caller common.Address, granteeArg interface{}, authorization authztypes.Authorization, expiration int64) ([]byte, uint64, error) {
+	granter, found := p.evmKeeper.GetSeiAddress(ctx, caller)
+	if !found {
+		return nil, 0, types.NewAssociationMissingErr(caller.Hex())
+	}
+	grantee, err := pcommon.GetSeiAddressFromArg(ctx, granteeArg, p.evmKeeper)
+	if err != nil {
+		return nil, 0, err
+	}
+	expiresAt := time.Unix(expiration, 0).UTC()
+	if !expiresAt.After(ctx.BlockTime()) {
+		return nil, 0, errors.New("expiration must be after the current block time")
+	}
+	msg, err := authztypes.NewMsgGrant(granter, grantee, authorization, expiresAt)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, 0, err
+	}
+	if _, err := p.authzMsgServer.Grant(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, 0, err
+	}
+	bz, err := method.Outputs.Pack(true)
+	return bz, pcommon.GetRemainingGas(ctx, p.evmKeeper), err
+}
+
+func (p PrecompileExecutor) revokeAuthorization(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}) ([]byte, uint64, error) {
+	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+		return nil, 0, err
+	}
+	granter, found := p.evmKeeper.GetSeiAddress(ctx, caller)
+	if !found {
+		return nil, 0, types.NewAssociationMissingErr(caller.Hex())
+	}
+	grantee, err := pcommon.GetSeiAddressFromArg(ctx, args[0], p.evmKeeper)
+	if err != nil {
+		return nil, 0, err
+	}
+	msg := authztypes.NewMsgRevoke(granter, grantee, args[1].(string))
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, 0, err
+	}
+	if _, err := p.authzMsgServer.Revoke(sdk.WrapSDKContext(ctx), &msg); err != nil {
+		return nil, 0, err
+	}
+	bz, err := method.Outputs.Pack(true)
+	return bz, pcommon.GetRemainingGas(ctx, p.evmKeeper), err
+}
+
+func (p PrecompileExecutor) EVMKeeper() utils.EVMKeeper {
+	return p.evmKeeper
+}
+
+func (PrecompileExecutor) IsTransaction(string) bool {
+	return true
+}
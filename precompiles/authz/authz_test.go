@@ -0,0 +1,197 @@
+package authz_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/sei-protocol/sei-chain/app"
+	"github.com/sei-protocol/sei-chain/precompiles/authz"
+	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/state"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+const voteMsgTypeURL = "/cosmos.gov.v1beta1.MsgVote"
+
+type coin struct {
+	Amount *big.Int
+	Denom  string
+}
+
+func TestAuthz(t *testing.T) {
+	testApp := testkeeper.EVMTestApp
+	ctx := testApp.NewContext(false, tmtypes.Header{}).WithBlockHeight(2).WithBlockTime(time.Now())
+	k := &testApp.EvmKeeper
+
+	granterSeiAddr, granterEVMAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, granterSeiAddr, granterEVMAddr)
+	granteeSeiAddr, granteeEVMAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, granteeSeiAddr, granteeEVMAddr)
+	_, unassociatedEVMAddr := testkeeper.MockAddressPair()
+
+	p, err := authz.NewPrecompile(app.NewPrecompileKeepers(testApp))
+	require.Nil(t, err)
+	statedb := state.NewDBImpl(ctx, k, true)
+	evm := vm.EVM{
+		StateDB:   statedb,
+		TxContext: vm.TxContext{Origin: granterEVMAddr},
+	}
+	run := func(caller common.Address, method string, readOnly bool, isFromDelegateCall bool, args ...interface{}) error {
+		input, err := p.ABI.Pack(method, args...)
+		require.Nil(t, err)
+		_, _, err = p.RunAndCalculateGas(&evm, caller, caller, input, 2000000, nil, nil, readOnly, isFromDelegateCall)
+		return err
+	}
+	expiration := ctx.BlockTime().Add(time.Hour).Unix()
+
+	// generic authorization
+	require.Nil(t, run(granterEVMAddr, authz.GrantGenericAuthorizationMethod, false, false, granteeEVMAddr, voteMsgTypeURL, expiration))
+	auth, exp := testApp.AuthzKeeper.GetCleanAuthorization(statedb.Ctx(), granteeSeiAddr, granterSeiAddr, voteMsgTypeURL)
+	require.NotNil(t, auth)
+	require.Equal(t, expiration, exp.Unix())
+
+	require.Nil(t, run(granterEVMAddr, authz.RevokeAuthorizationMethod, false, false, granteeEVMAddr, voteMsgTypeURL))
+	auth, _ = testApp.AuthzKeeper.GetCleanAuthorization(statedb.Ctx(), granteeSeiAddr, granterSeiAddr, voteMsgTypeURL)
+	require.Nil(t, auth)
+
+	// send authorization
+	spendLimit := []coin{{Amount: big.NewInt(1000), Denom: "uaex"}}
+	require.Nil(t, run(granterEVMAddr, authz.GrantSendAuthorizationMethod, false, false, granteeEVMAddr, spendLimit, expiration))
+	auth, _ = testApp.AuthzKeeper.GetCleanAuthorization(statedb.Ctx(), granteeSeiAddr, granterSeiAddr, sdk.MsgTypeURL(&banktypes.MsgSend{}))
+	require.NotNil(t, auth)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uaex", sdk.NewInt(1000))), auth.(*banktypes.SendAuthorization).SpendLimit)
+
+	// the grantee's sends on the granter's behalf deplete the spend limit until the grant is gone
+	funds := sdk.NewCoins(sdk.NewCoin("uaex", sdk.NewInt(10000)))
+	require.Nil(t, testApp.BankKeeper.MintCoins(statedb.Ctx(), minttypes.ModuleName, funds))
+	require.Nil(t, testApp.BankKeeper.SendCoinsFromModuleToAccount(statedb.Ctx(), minttypes.ModuleName, granterSeiAddr, funds))
+	recipientSeiAddr, _ := testkeeper.MockAddressPair()
+	send := func(amount int64) error {
+		msg := banktypes.NewMsgSend(granterSeiAddr, recipientSeiAddr, sdk.NewCoins(sdk.NewCoin("uaex", sdk.NewInt(amount))))
+		_, err := testApp.AuthzKeeper.DispatchActions(statedb.Ctx(), granteeSeiAddr, []sdk.Msg{msg})
+		return err
+	}
+	require.Nil(t, send(600))
+	auth, _ = testApp.AuthzKeeper.GetCleanAuthorization(statedb.Ctx(), granteeSeiAddr, granterSeiAddr, sdk.MsgTypeURL(&banktypes.MsgSend{}))
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uaex", sdk.NewInt(400))), auth.(*banktypes.SendAuthorization).SpendLimit)
+	require.NotNil(t, send(500))
+	require.Nil(t, send(400))
+	auth, _ = testApp.AuthzKeeper.GetCleanAuthorization(statedb.Ctx(), granteeSeiAddr, granterSeiAddr, sdk.MsgTypeURL(&banktypes.MsgSend{}))
+	require.Nil(t, auth)
+	require.Equal(t, sdk.NewInt(1000), testApp.BankKeeper.GetBalance(statedb.Ctx(), recipientSeiAddr, "uaex").Amount)
+
+	// failures
+	require.NotNil(t, run(granterEVMAddr, authz.GrantSendAuthorizationMethod, false, false, granteeEVMAddr, []coin{}, expiration))
+	require.NotNil(t, run(granterEVMAddr, authz.GrantGenericAuthorizationMethod, false, false, granteeEVMAddr, "/not.a.Msg", expiration))
+	require.NotNil(t, run(granterEVMAddr, authz.GrantGenericAuthorizationMethod, false, false, granterEVMAddr, voteMsgTypeURL, expiration))
+	require.NotNil(t, run(granterEVMAddr, authz.GrantGenericAuthorizationMethod, false, false, unassociatedEVMAddr, voteMsgTypeURL, expiration))
+	require.NotNil(t, run(granterEVMAddr, authz.RevokeAuthorizationMethod, false, false, granteeEVMAddr, voteMsgTypeURL))
+}
+
+func TestAuthzCallRules(t *testing.T) {
+	testApp := testkeeper.EVMTestApp
+	ctx := testApp.NewContext(false, tmtypes.Header{}).WithBlockHeight(2).WithBlockTime(time.Now())
+	k := &testApp.EvmKeeper
+
+	granterSeiAddr, granterEVMAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, granterSeiAddr, granterEVMAddr)
+	granteeSeiAddr, granteeEVMAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, granteeSeiAddr, granteeEVMAddr)
+	_, unassociatedEVMAddr := testkeeper.MockAddressPair()
+
+	p, err := authz.NewPrecompile(app.NewPrecompileKeepers(testApp))
+	require.Nil(t, err)
+	expiration := ctx.BlockTime().Add(time.Hour).Unix()
+	spendLimit := []coin{{Amount: big.NewInt(1000), Denom: "uaex"}}
+	genericArgs := []interface{}{granteeEVMAddr, voteMsgTypeURL, expiration}
+	sendArgs := []interface{}{granteeEVMAddr, spendLimit, expiration}
+	revokeArgs := []interface{}{granteeEVMAddr, voteMsgTypeURL}
+
+	tests := []struct {
+		name               string
+		caller             common.Address
+		method             string
+		args               []interface{}
+		value              *big.Int
+		readOnly           bool
+		isFromDelegateCall bool
+		wantErrMsg         string
+	}{
+		{name: "generic grant from staticcall", caller: granterEVMAddr, method: authz.GrantGenericAuthorizationMethod, args: genericArgs, readOnly: true, wantErrMsg: "cannot call authz precompile from staticcall"},
+		{name: "send grant from staticcall", caller: granterEVMAddr, method: authz.GrantSendAuthorizationMethod, args: sendArgs, readOnly: true, wantErrMsg: "cannot call authz precompile from staticcall"},
+		{name: "revoke from staticcall", caller: granterEVMAddr, method: authz.RevokeAuthorizationMethod, args: revokeArgs, readOnly: true, wantErrMsg: "cannot call authz precompile from staticcall"},
+		{name: "generic grant from delegatecall", caller: granterEVMAddr, method: authz.GrantGenericAuthorizationMethod, args: genericArgs, isFromDelegateCall: true, wantErrMsg: "cannot delegatecall authz"},
+		{name: "send grant from delegatecall", caller: granterEVMAddr, method: authz.GrantSendAuthorizationMethod, args: sendArgs, isFromDelegateCall: true, wantErrMsg: "cannot delegatecall authz"},
+		{name: "revoke from delegatecall", caller: granterEVMAddr, method: authz.RevokeAuthorizationMethod, args: revokeArgs, isFromDelegateCall: true, wantErrMsg: "cannot delegatecall authz"},
+		{name: "generic grant with value", caller: granterEVMAddr, method: authz.GrantGenericAuthorizationMethod, args: genericArgs, value: big.NewInt(1), wantErrMsg: "sending funds to a non-payable function"},
+		{name: "send grant with value", caller: granterEVMAddr, method: authz.GrantSendAuthorizationMethod, args: sendArgs, value: big.NewInt(1), wantErrMsg: "sending funds to a non-payable function"},
+		{name: "revoke with value", caller: granterEVMAddr, method: authz.RevokeAuthorizationMethod, args: revokeArgs, value: big.NewInt(1), wantErrMsg: "sending funds to a non-payable function"},
+		{name: "generic grant expiring at the block time", caller: granterEVMAddr, method: authz.GrantGenericAuthorizationMethod, args: []interface{}{granteeEVMAddr, voteMsgTypeURL, ctx.BlockTime().Unix()}, wantErrMsg: "expiration must be after the current block time"},
+		{name: "send grant expiring before the block time", caller: granterEVMAddr, method: authz.GrantSendAuthorizationMethod, args: []interface{}{granteeEVMAddr, spendLimit, ctx.BlockTime().Add(-time.Hour).Unix()}, wantErrMsg: "expiration must be after the current block time"},
+		{name: "grant from an unassociated caller", caller: unassociatedEVMAddr, method: authz.GrantGenericAuthorizationMethod, args: genericArgs, wantErrMsg: types.NewAssociationMissingErr(unassociatedEVMAddr.Hex()).Error()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statedb := state.NewDBImpl(ctx, k, true)
+			evm := vm.EVM{
+				StateDB:   statedb,
+				TxContext: vm.TxContext{Origin: tt.caller},
+			}
+			input, err := p.ABI.Pack(tt.method, tt.args...)
+			require.Nil(t, err)
+			ret, _, err := p.RunAndCalculateGas(&evm, tt.caller, tt.caller, input, 2000000, tt.value, nil, tt.readOnly, tt.isFromDelegateCall)
+			require.Equal(t, vm.ErrExecutionReverted, err)
+			require.Equal(t, tt.wantErrMsg, string(ret))
+			require.Empty(t, testApp.AuthzKeeper.GetAuthorizations(statedb.Ctx(), granteeSeiAddr, granterSeiAddr))
+		})
+	}
+}
+
+func TestAuthzGranterIsCaller(t *testing.T) {
+	testApp := testkeeper.EVMTestApp
+	ctx := testApp.NewContext(false, tmtypes.Header{}).WithBlockHeight(2).WithBlockTime(time.Now())
+	k := &testApp.EvmKeeper
+
+	callerSeiAddr, callerEVMAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, callerSeiAddr, callerEVMAddr)
+	originSeiAddr, originEVMAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, originSeiAddr, originEVMAddr)
+	granteeSeiAddr, granteeEVMAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, granteeSeiAddr, granteeEVMAddr)
+
+	p, err := authz.NewPrecompile(app.NewPrecompileKeepers(testApp))
+	require.Nil(t, err)
+	statedb := state.NewDBImpl(ctx, k, true)
+	// the call comes from a contract, so the caller differs from the tx origin
+	evm := vm.EVM{
+		StateDB:   statedb,
+		TxContext: vm.TxContext{Origin: originEVMAddr},
+	}
+	run := func(caller common.Address, method string, args ...interface{}) error {
+		input, err := p.ABI.Pack(method, args...)
+		require.Nil(t, err)
+		_, _, err = p.RunAndCalculateGas(&evm, caller, caller, input, 2000000, nil, nil, false, false)
+		return err
+	}
+
+	require.Nil(t, run(callerEVMAddr, authz.GrantGenericAuthorizationMethod, granteeEVMAddr, voteMsgTypeURL, ctx.BlockTime().Add(time.Hour).Unix()))
+	auth, _ := testApp.AuthzKeeper.GetCleanAuthorization(statedb.Ctx(), granteeSeiAddr, callerSeiAddr, voteMsgTypeURL)
+	require.NotNil(t, auth)
+	require.Empty(t, testApp.AuthzKeeper.GetAuthorizations(statedb.Ctx(), granteeSeiAddr, originSeiAddr))
+
+	// another account cannot revoke the caller's grant
+	require.NotNil(t, run(originEVMAddr, authz.RevokeAuthorizationMethod, granteeEVMAddr, voteMsgTypeURL))
+	auth, _ = testApp.AuthzKeeper.GetCleanAuthorization(statedb.Ctx(), granteeSeiAddr, callerSeiAddr, voteMsgTypeURL)
+	require.NotNil(t, auth)
+	require.Nil(t, run(callerEVMAddr, authz.RevokeAuthorizationMethod, granteeEVMAddr, voteMsgTypeURL))
+	auth, _ = testApp.AuthzKeeper.GetCleanAuthorization(statedb.Ctx(), granteeSeiAddr, callerSeiAddr, voteMsgTypeURL)
+	require.Nil(t, auth)
+}
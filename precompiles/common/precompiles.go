@@ -299,3 +299,23 @@ func GetSeiAddressFromArg(ctx sdk.Context, arg interface{}, evmKeeper putils.EVM
 	}
 	return GetSeiAddressByEvmAddress(ctx, addr, evmKeeper)
 }
+
+// GetCoinsFromArg decodes a Coin[] argument, given as (amount, denom) tuples, into sdk.Coins.
+// Every coin must have a valid denom and a positive amount.
+func GetCoinsFromArg(arg interface{}) (sdk.Coins, error) {
+	coins := arg.([]struct {
+		Amount *big.Int `json:"amount"`
+		Denom  string   `json:"denom"`
+	})
+	res := sdk.NewCoins()
+	for _, c := range coins {
+		if err := sdk.ValidateDenom(c.Denom); err != nil {
+			return nil, err
+		}
+		if c.Amount == nil || c.Amount.Sign() <= 0 {
+			return nil, fmt.Errorf("invalid amount for %s", c.Denom)
+		}
+		res = res.Add(sdk.NewCoin(c.Denom, sdk.NewIntFromBigInt(c.Amount)))
+	}
+	return res, nil
+}
@@ -39,6 +39,25 @@ func TestValidteNonPayable(t *testing.T) {
 	require.NotNil(t, err)
 }
 
+func TestGetCoinsFromArg(t *testing.T) {
+	type coin = struct {
+		Amount *big.Int `json:"amount"`
+		Denom  string   `json:"denom"`
+	}
+	coins, err := common.GetCoinsFromArg([]coin{{Amount: big.NewInt(5), Denom: "usei"}, {Amount: big.NewInt(3), Denom: "uaex"}, {Amount: big.NewInt(2), Denom: "usei"}})
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uaex", sdk.NewInt(3)), sdk.NewCoin("usei", sdk.NewInt(7))), coins)
+	coins, err = common.GetCoinsFromArg([]coin{})
+	require.Nil(t, err)
+	require.True(t, coins.Empty())
+	_, err = common.GetCoinsFromArg([]coin{{Amount: big.NewInt(0), Denom: "usei"}})
+	require.NotNil(t, err)
+	_, err = common.GetCoinsFromArg([]coin{{Amount: nil, Denom: "usei"}})
+	require.NotNil(t, err)
+	_, err = common.GetCoinsFromArg([]coin{{Amount: big.NewInt(1), Denom: "!"}})
+	require.NotNil(t, err)
+}
+
 func TestHandlePrecompileError(t *testing.T) {
 	_, evmAddr := testkeeper.MockAddressPair()
 	k := &testkeeper.EVMTestApp.EvmKeeper
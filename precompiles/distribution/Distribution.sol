@@ -41,6 +41,11 @@ interface IDistr {
     /// @return success True if commission was withdrawn successfully
     function withdrawValidatorCommission(string memory validator) external returns (bool success);
 
+    /// @notice Funds the community pool with the uaex sent with the call
+    /// @dev The caller must have a valid associated Sei address. Emits CommunityPoolFunded.
+    /// @return success True if the community pool was funded successfully
+    function fundCommunityPool() payable external returns (bool success);
+
     // Queries
     
     /// @notice Gets all pending rewards for a delegator
@@ -49,6 +54,35 @@ interface IDistr {
     /// @return rewards Structured data containing all pending rewards
     function rewards(address delegatorAddress) external view returns (Rewards rewards);
 
+    /// @notice Gets the balance of the community pool
+    /// @return coins The coins in the community pool
+    function communityPool() external view returns (Coin[] memory coins);
+
+    /// @notice Gets the rewards of a validator not yet withdrawn by its delegators, including its commission
+    /// @param validator The validator's Sei address (e.g., "seivaloper1...")
+    /// @return rewards The outstanding rewards
+    function validatorOutstandingRewards(string memory validator) external view returns (Coin[] memory rewards);
+
+    /// @notice Gets the commission a validator has accumulated and not yet withdrawn
+    /// @param validator The validator's Sei address (e.g., "seivaloper1...")
+    /// @return commission The accumulated commission
+    function validatorCommission(string memory validator) external view returns (Coin[] memory commission);
+
+    /// @notice Gets the address a delegator's rewards are withdrawn to
+    /// @dev The delegator's own address unless setWithdrawAddress was called
+    /// @param delegatorAddress The EVM address of the delegator
+    /// @return withdrawAddr The EVM address rewards are withdrawn to
+    /// @return seiWithdrawAddr The Sei address rewards are withdrawn to
+    function withdrawAddress(address delegatorAddress) external view returns (address withdrawAddr, string memory seiWithdrawAddr);
+
+    // Events
+
+    /// @notice Emitted when the community pool is funded through the precompile
+    /// @param depositor The EVM address that funded the community pool
+    /// @param denom The denomination of the coins deposited
+    /// @param amount The amount deposited
+    event CommunityPoolFunded(address indexed depositor, string denom, uint256 amount);
+
     /// @notice Represents a coin/token with amount, decimals, and denomination
     /// @dev Used to represent various tokens in the Cosmos ecosystem
     struct Coin {
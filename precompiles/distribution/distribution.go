@@ -12,6 +12,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 
 	pcommon "github.com/sei-protocol/sei-chain/precompiles/common"
@@ -25,6 +26,15 @@ const (
 	WithdrawMultipleDelegationRewardsMethod = "withdrawMultipleDelegationRewards"
 	WithdrawValidatorCommissionMethod       = "withdrawValidatorCommission"
 	RewardsMethod                           = "rewards"
+	FundCommunityPoolMethod                 = "fundCommunityPool"
+	CommunityPoolMethod                     = "communityPool"
+	ValidatorOutstandingRewardsMethod       = "validatorOutstandingRewards"
+	ValidatorCommissionMethod               = "validatorCommission"
+	WithdrawAddressMethod                   = "withdrawAddress"
+)
+
+const (
+	CommunityPoolFundedEvent = "CommunityPoolFunded"
 )
 
 const (
@@ -39,13 +49,20 @@ var f embed.FS
 type PrecompileExecutor struct {
 	distrKeeper utils.DistributionKeeper
 	evmKeeper   utils.EVMKeeper
+	bankKeeper  utils.BankKeeper
 	address     common.Address
+	abi         abi.ABI
 
 	SetWithdrawAddrID                   []byte
 	WithdrawDelegationRewardsID         []byte
 	WithdrawMultipleDelegationRewardsID []byte
 	WithdrawValidatorCommissionID       []byte
 	RewardsID                           []byte
+	FundCommunityPoolID                 []byte
+	CommunityPoolID                     []byte
+	ValidatorOutstandingRewardsID       []byte
+	ValidatorCommissionID               []byte
+	WithdrawAddressID                   []byte
 }
 
 func NewPrecompile(keepers utils.Keepers) (*pcommon.DynamicGasPrecompile, error) {
@@ -54,7 +71,9 @@ func NewPrecompile(keepers utils.Keepers) (*pcommon.DynamicGasPrecompile, error)
 	p := &PrecompileExecutor{
 		distrKeeper: keepers.DistributionK(),
 		evmKeeper:   keepers.EVMK(),
+		bankKeeper:  keepers.BankK(),
 		address:     common.HexToAddress(DistrAddress),
+		abi:         newAbi,
 	}
 
 	for name, m := range newAbi.Methods {
@@ -69,6 +88,16 @@ func NewPrecompile(keepers utils.Keepers) (*pcommon.DynamicGasPrecompile, error)
 			p.WithdrawValidatorCommissionID = m.ID
 		case RewardsMethod:
 			p.RewardsID = m.ID
+		case FundCommunityPoolMethod:
+			p.FundCommunityPoolID = m.ID
+		case CommunityPoolMethod:
+			p.CommunityPoolID = m.ID
+		case ValidatorOutstandingRewardsMethod:
+			p.ValidatorOutstandingRewardsID = m.ID
+		case ValidatorCommissionMethod:
+			p.ValidatorCommissionID = m.ID
+		case WithdrawAddressMethod:
+			p.WithdrawAddressID = m.ID
 		}
 	}
 
@@ -100,8 +129,21 @@ func (p PrecompileExecutor) Execute(ctx sdk.Context, method *abi.Method, caller
 			return nil, 0, errors.New("cannot call distr precompile from staticcall")
 		}
 		return p.withdrawValidatorCommission(ctx, method, caller, args, value)
+	case FundCommunityPoolMethod:
+		if readOnly {
+			return nil, 0, errors.New("cannot call distr precompile from staticcall")
+		}
+		return p.fundCommunityPool(ctx, method, caller, args, value, evm, hooks)
 	case RewardsMethod:
 		return p.rewards(ctx, method, args)
+	case CommunityPoolMethod:
+		return p.communityPool(ctx, method, args, value)
+	case ValidatorOutstandingRewardsMethod:
+		return p.validatorOutstandingRewards(ctx, method, args, value)
+	case ValidatorCommissionMethod:
+		return p.validatorCommission(ctx, method, args, value)
+	case WithdrawAddressMethod:
+		return p.withdrawAddress(ctx, method, args, value)
 	}
 	return
 }
@@ -310,32 +352,15 @@ func (p PrecompileExecutor) rewards(ctx sdk.Context, method *abi.Method, args []
 func getResponseOutput(response *distrtypes.QueryDelegationTotalRewardsResponse) Rewards {
 	rewards := make([]Reward, 0, len(response.Rewards))
 	for _, rewardInfo := range response.Rewards {
-		coins := make([]Coin, 0, len(rewardInfo.Reward))
-		for _, coin := range rewardInfo.Reward {
-			coins = append(coins, Coin{
-				Amount:   coin.Amount.BigInt(),
-				Denom:    coin.Denom,
-				Decimals: big.NewInt(sdk.Precision),
-			})
-		}
 		rewards = append(rewards, Reward{
 			ValidatorAddress: rewardInfo.ValidatorAddress,
-			Coins:            coins,
-		})
-	}
-
-	totalCoins := make([]Coin, 0, len(response.Total))
-	for _, coin := range response.Total {
-		totalCoins = append(totalCoins, Coin{
-			Amount:   coin.Amount.BigInt(),
-			Denom:    coin.Denom,
-			Decimals: big.NewInt(sdk.Precision),
+			Coins:            toCoins(rewardInfo.Reward),
 		})
 	}
 
 	return Rewards{
 		Rewards: rewards,
-		Total:   totalCoins,
+		Total:   toCoins(response.Total),
 	}
 }
 
@@ -382,3 +407,157 @@ func (p PrecompileExecutor) getValidatorFromArg(ctx sdk.Context, arg interface{}
 	}
 	return validator, nil
 }
+
+func (p PrecompileExecutor) fundCommunityPool(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}, value *big.Int, evm *vm.EVM, hooks *tracing.Hooks) (ret []byte, remainingGas uint64, rerr error) {
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s", err)
+			return
+		}
+	}()
+	if err := pcommon.ValidateArgsLength(args, 0); err != nil {
+		rerr = err
+		return
+	}
+	if value == nil || value.Sign() == 0 {
+		rerr = errors.New("set `value` field to non-zero to fund the community pool")
+		return
+	}
+	depositor, err := p.getDelegator(ctx, caller)
+	if err != nil {
+		rerr = err
+		return
+	}
+	coin, err := pcommon.HandlePaymentUaex(ctx, p.evmKeeper.GetSeiAddressOrDefault(ctx, p.address), depositor, value, p.bankKeeper, p.evmKeeper, hooks, evm.GetDepth())
+	if err != nil {
+		rerr = err
+		return
+	}
+	if err := p.distrKeeper.FundCommunityPool(ctx, sdk.NewCoins(coin), depositor); err != nil {
+		rerr = err
+		return
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, distrtypes.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, depositor.String()),
+		),
+	)
+	data, err := p.abi.Events[CommunityPoolFundedEvent].Inputs.NonIndexed().Pack(coin.Denom, coin.Amount.BigInt())
+	if err != nil {
+		rerr = err
+		return
+	}
+	evm.StateDB.AddLog(&ethtypes.Log{
+		Address: p.address,
+		Topics:  []common.Hash{p.abi.Events[CommunityPoolFundedEvent].ID, common.BytesToHash(caller.Bytes())},
+		Data:    data,
+	})
+	ret, rerr = method.Outputs.Pack(true)
+	remainingGas = pcommon.GetRemainingGas(ctx, p.evmKeeper)
+	return
+}
+
+func (p PrecompileExecutor) communityPool(ctx sdk.Context, method *abi.Method, args []interface{}, value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s", err)
+			return
+		}
+	}()
+	if err := p.validateInput(value, args, 0); err != nil {
+		rerr = err
+		return
+	}
+	ret, rerr = method.Outputs.Pack(toCoins(p.distrKeeper.GetFeePoolCommunityCoins(ctx)))
+	remainingGas = pcommon.GetRemainingGas(ctx, p.evmKeeper)
+	return
+}
+
+func (p PrecompileExecutor) validatorOutstandingRewards(ctx sdk.Context, method *abi.Method, args []interface{}, value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s", err)
+			return
+		}
+	}()
+	if err := p.validateInput(value, args, 1); err != nil {
+		rerr = err
+		return
+	}
+	validator, err := p.getValidatorFromArg(ctx, args[0])
+	if err != nil {
+		rerr = err
+		return
+	}
+	ret, rerr = method.Outputs.Pack(toCoins(p.distrKeeper.GetValidatorOutstandingRewardsCoins(ctx, validator)))
+	remainingGas = pcommon.GetRemainingGas(ctx, p.evmKeeper)
+	return
+}
+
+func (p PrecompileExecutor) validatorCommission(ctx sdk.Context, method *abi.Method, args []interface{}, value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s", err)
+			return
+		}
+	}()
+	if err := p.validateInput(value, args, 1); err != nil {
+		rerr = err
+		return
+	}
+	validator, err := p.getValidatorFromArg(ctx, args[0])
+	if err != nil {
+		rerr = err
+		return
+	}
+	ret, rerr = method.Outputs.Pack(toCoins(p.distrKeeper.GetValidatorAccumulatedCommission(ctx, validator).Commission))
+	remainingGas = pcommon.GetRemainingGas(ctx, p.evmKeeper)
+	return
+}
+
+func (p PrecompileExecutor) withdrawAddress(ctx sdk.Context, method *abi.Method, args []interface{}, value *big.Int) (ret []byte, remainingGas uint64, rerr error) {
+	defer func() {
+		if err := recover(); err != nil {
+			ret = nil
+			remainingGas = 0
+			rerr = fmt.Errorf("%s", err)
+			return
+		}
+	}()
+	if err := p.validateInput(value, args, 1); err != nil {
+		rerr = err
+		return
+	}
+	delegator, err := pcommon.GetSeiAddressFromArg(ctx, args[0], p.evmKeeper)
+	if err != nil {
+		rerr = err
+		return
+	}
+	// the delegator itself unless a withdraw address was set
+	withdrawAddr := p.distrKeeper.GetDelegatorWithdrawAddr(ctx, delegator)
+	ret, rerr = method.Outputs.Pack(p.evmKeeper.GetEVMAddressOrDefault(ctx, withdrawAddr), withdrawAddr.String())
+	remainingGas = pcommon.GetRemainingGas(ctx, p.evmKeeper)
+	return
+}
+
+func toCoins(decCoins sdk.DecCoins) []Coin {
+	coins := make([]Coin, 0, len(decCoins))
+	for _, coin := range decCoins {
+		coins = append(coins, Coin{
+			Amount:   coin.Amount.BigInt(),
+			Denom:    coin.Denom,
+			Decimals: big.NewInt(sdk.Precision),
+		})
+	}
+	return coins
+}
@@ -850,7 +850,9 @@ func TestPrecompile_RunAndCalculateGas_SetWithdrawAddress(t *testing.T) {
 	}
 }
 
-type TestDistributionKeeper struct{}
+type TestDistributionKeeper struct {
+	utils.DistributionKeeper
+}
 
 func (tk *TestDistributionKeeper) SetWithdrawAddr(ctx sdk.Context, delegatorAddr sdk.AccAddress, withdrawAddr sdk.AccAddress) error {
 	return nil
@@ -888,7 +890,9 @@ func (tk *TestDistributionKeeper) DelegationTotalRewards(ctx context.Context, re
 	return &distrtypes.QueryDelegationTotalRewardsResponse{Rewards: rewards, Total: allDecCoins}, nil
 }
 
-type TestEmptyRewardsDistributionKeeper struct{}
+type TestEmptyRewardsDistributionKeeper struct {
+	utils.DistributionKeeper
+}
 
 func (tk *TestEmptyRewardsDistributionKeeper) SetWithdrawAddr(ctx sdk.Context, delegatorAddr sdk.AccAddress, withdrawAddr sdk.AccAddress) error {
 	return nil
@@ -1403,3 +1407,129 @@ func TestWithdrawValidatorCommission_InputValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestFundCommunityPool(t *testing.T) {
+	testApp := testkeeper.EVMTestApp
+	ctx := testApp.NewContext(false, tmtypes.Header{}).WithBlockHeight(2)
+	k := &testApp.EvmKeeper
+	privKey := testkeeper.MockPrivateKey()
+	seiAddr, evmAddr := testkeeper.PrivateKeyToAddresses(privKey)
+	k.SetAddressMapping(ctx, seiAddr, evmAddr)
+	amt := sdk.NewCoins(sdk.NewCoin(k.GetBaseDenom(ctx), sdk.NewInt(200000000)))
+	require.Nil(t, k.BankKeeper().MintCoins(ctx, evmtypes.ModuleName, amt))
+	require.Nil(t, k.BankKeeper().SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, seiAddr, amt))
+	poolBefore := testApp.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(k.GetBaseDenom(ctx))
+
+	abi := pcommon.MustGetABI(f, "abi.json")
+	args, err := abi.Pack(distribution.FundCommunityPoolMethod)
+	require.Nil(t, err)
+	testPrivHex := hex.EncodeToString(privKey.Bytes())
+	key, _ := crypto.HexToECDSA(testPrivHex)
+	addr := common.HexToAddress(distribution.DistrAddress)
+	txData := ethtypes.LegacyTx{
+		GasPrice: big.NewInt(1000000000000),
+		Gas:      20000000,
+		To:       &addr,
+		Value:    big.NewInt(100_000_000_000_000),
+		Data:     args,
+		Nonce:    0,
+	}
+	chainID := k.ChainID(ctx)
+	chainCfg := evmtypes.DefaultChainConfig()
+	ethCfg := chainCfg.EthereumConfig(chainID)
+	blockNum := big.NewInt(ctx.BlockHeight())
+	signer := ethtypes.MakeSigner(ethCfg, blockNum, uint64(ctx.BlockTime().Unix()))
+	tx, err := ethtypes.SignTx(ethtypes.NewTx(&txData), signer, key)
+	require.Nil(t, err)
+	txwrapper, err := ethtx.NewLegacyTx(tx)
+	require.Nil(t, err)
+	req, err := evmtypes.NewMsgEVMTransaction(txwrapper)
+	require.Nil(t, err)
+
+	msgServer := keeper.NewMsgServerImpl(k)
+	ante.Preprocess(ctx, req, k.ChainID(ctx))
+	res, err := msgServer.EVMTransaction(sdk.WrapSDKContext(ctx), req)
+	require.Nil(t, err)
+	require.Empty(t, res.VmError)
+
+	poolAfter := testApp.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(k.GetBaseDenom(ctx))
+	require.Equal(t, sdk.NewDec(100), poolAfter.Sub(poolBefore))
+
+	require.Len(t, res.Logs, 1)
+	event := abi.Events[distribution.CommunityPoolFundedEvent]
+	require.Equal(t, addr.Hex(), res.Logs[0].Address)
+	require.Equal(t, []string{event.ID.Hex(), common.BytesToHash(evmAddr.Bytes()).Hex()}, res.Logs[0].Topics)
+	data, err := event.Inputs.NonIndexed().Unpack(res.Logs[0].Data)
+	require.Nil(t, err)
+	require.Equal(t, k.GetBaseDenom(ctx), data[0])
+	require.Equal(t, big.NewInt(100), data[1])
+}
+
+func TestDistributionQueries(t *testing.T) {
+	testApp := testkeeper.EVMTestApp
+	ctx := testApp.NewContext(false, tmtypes.Header{}).WithBlockHeight(2)
+	k := &testApp.EvmKeeper
+	val := setupValidator(t, ctx, testApp, stakingtypes.Unbonded, secp256k1.GenPrivKey().PubKey())
+	testApp.DistrKeeper.SetValidatorOutstandingRewards(ctx, val, distrtypes.ValidatorOutstandingRewards{
+		Rewards: sdk.NewDecCoins(sdk.NewDecCoinFromDec("uaex", sdk.MustNewDecFromStr("1.5"))),
+	})
+	testApp.DistrKeeper.SetValidatorAccumulatedCommission(ctx, val, distrtypes.ValidatorAccumulatedCommission{
+		Commission: sdk.NewDecCoins(sdk.NewDecCoinFromDec("uaex", sdk.MustNewDecFromStr("0.5"))),
+	})
+	seiAddr, evmAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, seiAddr, evmAddr)
+
+	p, err := distribution.NewPrecompile(app.NewPrecompileKeepers(testApp))
+	require.Nil(t, err)
+	evm := vm.EVM{StateDB: state.NewDBImpl(ctx, k, true), TxContext: vm.TxContext{Origin: evmAddr}}
+	call := func(method string, args ...interface{}) []interface{} {
+		input, err := p.ABI.Pack(method, args...)
+		require.Nil(t, err)
+		// queries can be called from staticcall
+		ret, _, err := p.RunAndCalculateGas(&evm, evmAddr, evmAddr, input, 1000000, nil, nil, true, false)
+		require.Nil(t, err, string(ret))
+		out, err := p.ABI.Unpack(method, ret)
+		require.Nil(t, err)
+		return out
+	}
+	// fields in the order of the ABI
+	type coin struct {
+		Amount   *big.Int
+		Decimals *big.Int
+		Denom    string
+	}
+	coins := func(out interface{}) []coin {
+		return *abitypes.ConvertType(out, new([]coin)).(*[]coin)
+	}
+
+	out := call(distribution.CommunityPoolMethod)
+	pool := testApp.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+	require.Len(t, coins(out[0]), len(pool))
+
+	out = call(distribution.ValidatorOutstandingRewardsMethod, val.String())
+	require.Equal(t, []coin{{Amount: sdk.MustNewDecFromStr("1.5").BigInt(), Decimals: big.NewInt(18), Denom: "uaex"}}, coins(out[0]))
+
+	out = call(distribution.ValidatorCommissionMethod, val.String())
+	require.Equal(t, []coin{{Amount: sdk.MustNewDecFromStr("0.5").BigInt(), Decimals: big.NewInt(18), Denom: "uaex"}}, coins(out[0]))
+
+	// rewards are withdrawn to the delegator until a withdraw address is set
+	out = call(distribution.WithdrawAddressMethod, evmAddr)
+	require.Equal(t, evmAddr, out[0])
+	require.Equal(t, seiAddr.String(), out[1])
+	withdrawSeiAddr, withdrawEvmAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, withdrawSeiAddr, withdrawEvmAddr)
+	distrParams := testApp.DistrKeeper.GetParams(ctx)
+	distrParams.WithdrawAddrEnabled = true
+	testApp.DistrKeeper.SetParams(ctx, distrParams)
+	require.Nil(t, testApp.DistrKeeper.SetWithdrawAddr(ctx, seiAddr, withdrawSeiAddr))
+	out = call(distribution.WithdrawAddressMethod, evmAddr)
+	require.Equal(t, withdrawEvmAddr, out[0])
+	require.Equal(t, withdrawSeiAddr.String(), out[1])
+
+	// funding the community pool is a tx
+	input, err := p.ABI.Pack(distribution.FundCommunityPoolMethod)
+	require.Nil(t, err)
+	ret, _, err := p.RunAndCalculateGas(&evm, evmAddr, evmAddr, input, 1000000, big.NewInt(1), nil, true, false)
+	require.NotNil(t, err)
+	require.Equal(t, "cannot call distr precompile from staticcall", string(ret))
+}
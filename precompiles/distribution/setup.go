@@ -10,6 +10,7 @@ import (
 	distributionv606 "github.com/sei-protocol/sei-chain/precompiles/distribution/legacy/v606"
 	distributionv610 "github.com/sei-protocol/sei-chain/precompiles/distribution/legacy/v610"
 	distributionv614 "github.com/sei-protocol/sei-chain/precompiles/distribution/legacy/v614"
+	distributionv620 "github.com/sei-protocol/sei-chain/precompiles/distribution/legacy/v620"
 	"github.com/sei-protocol/sei-chain/precompiles/utils"
 )
 
@@ -24,6 +25,7 @@ func GetVersioned(latestUpgrade string, keepers utils.Keepers) utils.VersionedPr
 		"v6.0.6":      check(distributionv606.NewPrecompile(keepers)),
 		"v6.1.0":      check(distributionv610.NewPrecompile(keepers)),
 		"v6.1.4":      check(distributionv614.NewPrecompile(keepers)),
+		"v6.2.0":      check(distributionv620.NewPrecompile(keepers)),
 	}
 }
 
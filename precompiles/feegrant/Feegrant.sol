@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+address constant FEEGRANT_PRECOMPILE_ADDRESS = 0x000000000000000000000000000000000000100F;
+
+IFeegrant constant FEEGRANT_CONTRACT = IFeegrant(
+    FEEGRANT_PRECOMPILE_ADDRESS
+);
+
+struct Coin {
+    uint256 amount;
+    string denom;
+}
+
+/**
+ * @dev Interface for granting and revoking x/feegrant fee allowances.
+ * The granter is always the caller, which must be associated with a Sei address.
+ * Grantees must be associated as well. Calls through staticcall or delegatecall revert.
+ * A grantee can only hold one allowance per granter; revoke it before granting a new one.
+ */
+interface IFeegrant {
+    // Transactions
+    /**
+     * @dev Lets `grantee` pay fees from the caller's account.
+     * @param grantee EVM address of the grantee.
+     * @param spendLimit Total fees the grantee may spend, empty for no limit.
+     * @param expiration Unix timestamp in seconds, 0 for no expiration.
+     */
+    function grantBasicAllowance(
+        address grantee,
+        Coin[] memory spendLimit,
+        int64 expiration
+    ) external returns (bool success);
+
+    /**
+     * @dev Same as grantBasicAllowance, additionally capping fees to `periodSpendLimit`
+     * every `period` seconds. The first period starts at the current block.
+     * @param period Length of a period in seconds, must be positive.
+     * @param periodSpendLimit Fees the grantee may spend per period.
+     */
+    function grantPeriodicAllowance(
+        address grantee,
+        Coin[] memory spendLimit,
+        int64 expiration,
+        int64 period,
+        Coin[] memory periodSpendLimit
+    ) external returns (bool success);
+
+    /**
+     * @dev Removes the allowance the caller granted to `grantee`.
+     */
+    function revokeAllowance(
+        address grantee
+    ) external returns (bool success);
+}
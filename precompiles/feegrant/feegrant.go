@@ -0,0 +1,213 @@
+package feegrant
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/vm"
+	pcommon "github.com/sei-protocol/sei-chain/precompiles/common"
+	"github.com/sei-protocol/sei-chain/precompiles/utils"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+const (
+	GrantBasicAllowanceMethod    = "grantBasicAllowance"
+	GrantPeriodicAllowanceMethod = "grantPeriodicAllowance"
+	RevokeAllowanceMethod        = "revokeAllowance"
+)
+
+const (
+	FeegrantAddress = "0x000000000000000000000000000000000000100F"
+)
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+type PrecompileExecutor struct {
+	evmKeeper         utils.EVMKeeper
+	feegrantMsgServer utils.FeegrantMsgServer
+
+	GrantBasicAllowanceID    []byte
+	GrantPeriodicAllowanceID []byte
+	RevokeAllowanceID        []byte
+}
+
+func NewPrecompile(keepers utils.Keepers) (*pcommon.DynamicGasPrecompile, error) {
+	newAbi := pcommon.MustGetABI(f, "abi.json")
+
+	p := &PrecompileExecutor{
+		evmKeeper:         keepers.EVMK(),
+		feegrantMsgServer: keepers.FeegrantMS(),
+	}
+
+	for name, m := range newAbi.Methods {
+		switch name {
+		case GrantBasicAllowanceMethod:
+			p.GrantBasicAllowanceID = m.ID
+		case GrantPeriodicAllowanceMethod:
+			p.GrantPeriodicAllowanceID = m.ID
+		case RevokeAllowanceMethod:
+			p.RevokeAllowanceID = m.ID
+		}
+	}
+
+	return pcommon.NewDynamicGasPrecompile(newAbi, p, common.HexToAddress(FeegrantAddress), "feegrant"), nil
+}
+
+// RequiredGas returns the required bare minimum gas to execute the precompile.
+func (p PrecompileExecutor) RequiredGas(input []byte, method *abi.Method) uint64 {
+	return pcommon.DefaultGasCost(input, p.IsTransaction(method.Name))
+}
+
+func (p PrecompileExecutor) Execute(ctx sdk.Context, method *abi.Method, caller common.Address, callingContract common.Address, args []interface{}, value *big.Int, readOnly bool, evm *vm.EVM, suppliedGas uint64, hooks *tracing.Hooks) (bz []byte, remainingGas uint64, err error) {
+	// Needed to catch gas meter panics
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("execution reverted: %v", r)
+		}
+	}()
+	if readOnly {
+		return nil, 0, errors.New("cannot call feegrant precompile from staticcall")
+	}
+	// allowances are always granted on behalf of the immediate caller
+	if ctx.EVMPrecompileCalledFromDelegateCall() {
+		return nil, 0, errors.New("cannot delegatecall feegrant")
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		return nil, 0, err
+	}
+
+	switch method.Name {
+	case GrantBasicAllowanceMethod:
+		return p.grantBasicAllowance(ctx, method, caller, args)
+	case GrantPeriodicAllowanceMethod:
+		return p.grantPeriodicAllowance(ctx, method, caller, args)
+	case RevokeAllowanceMethod:
+		return p.revokeAllowance(ctx, method, caller, args)
+	}
+	return
+}
+
+func (p PrecompileExecutor) grantBasicAllowance(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}) ([]byte, uint64, error) {
+	if err := pcommon.ValidateArgsLength(args, 3); err != nil {
+		return nil, 0, err
+	}
+	basic, err := basicAllowanceFromArgs(ctx, args[1], args[2].(int64))
+	if err != nil {
+		return nil, 0, err
+	}
+	return p.grant(ctx, method, caller, args[0], &basic)
+}
+
+func (p PrecompileExecutor) grantPeriodicAllowance(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}) ([]byte, uint64, error) {
+	if err := pcommon.ValidateArgsLength(args, 5); err != nil {
+		return nil, 0, err
+	}
+	basic, err := basicAllowanceFromArgs(ctx, args[1], args[2].(int64))
+	if err != nil {
+		return nil, 0, err
+	}
+	period := args[3].(int64)
+	if period <= 0 {
+		return nil, 0, errors.New("period must be positive")
+	}
+	periodSpendLimit, err := pcommon.GetCoinsFromArg(args[4])
+	if err != nil {
+		return nil, 0, err
+	}
+	periodDuration := time.Duration(period) * time.Second
+	// same initial state as the CLI: the first period starts now with the full limit available
+	periodic := &feegranttypes.PeriodicAllowance{
+		Basic:            basic,
+		Period:           periodDuration,
+		PeriodSpendLimit: periodSpendLimit,
+		PeriodCanSpend:   periodSpendLimit,
+		PeriodReset:      ctx.BlockTime().Add(periodDuration),
+	}
+	return p.grant(ctx, method, caller, args[0], periodic)
+}
+
+func (p PrecompileExecutor) grant(ctx sdk.Context, method *abi.Method, caller common.Address, granteeArg interface{}, allowance feegranttypes.FeeAllowanceI) ([]byte, uint64, error) {
+	granter, found := p.evmKeeper.GetSeiAddress(ctx, caller)
+	if !found {
+		return nil, 0, types.NewAssociationMissingErr(caller.Hex())
+	}
+	grantee, err := pcommon.GetSeiAddressFromArg(ctx, granteeArg, p.evmKeeper)
+	if err != nil {
+		return nil, 0, err
+	}
+	msg, err := feegranttypes.NewMsgGrantAllowance(allowance, granter, grantee)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, 0, err
+	}
+	if _, err := p.feegrantMsgServer.GrantAllowance(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, 0, err
+	}
+	bz, err := method.Outputs.Pack(true)
+	return bz, pcommon.GetRemainingGas(ctx, p.evmKeeper), err
+}
+
+func (p PrecompileExecutor) revokeAllowance(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}) ([]byte, uint64, error) {
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		return nil, 0, err
+	}
+	granter, found := p.evmKeeper.GetSeiAddress(ctx, caller)
+	if !found {
+		return nil, 0, types.NewAssociationMissingErr(caller.Hex())
+	}
+	grantee, err := pcommon.GetSeiAddressFromArg(ctx, args[0], p.evmKeeper)
+	if err != nil {
+		return nil, 0, err
+	}
+	msg := feegranttypes.NewMsgRevokeAllowance(granter, grantee)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, 0, err
+	}
+	if _, err := p.feegrantMsgServer.RevokeAllowance(sdk.WrapSDKContext(ctx), &msg); err != nil {
+		return nil, 0, err
+	}
+	bz, err := method.Outputs.Pack(true)
+	return bz, pcommon.GetRemainingGas(ctx, p.evmKeeper), err
+}
+
+func (p PrecompileExecutor) EVMKeeper() utils.EVMKeeper {
+	return p.evmKeeper
+}
+
+func (PrecompileExecutor) IsTransaction(string) bool {
+	return true
+}
+
+// basicAllowanceFromArgs builds a BasicAllowance where an empty spend limit means
+// unlimited and an expiration of 0 means the allowance never expires.
+func basicAllowanceFromArgs(ctx sdk.Context, spendLimitArg interface{}, expiration int64) (feegranttypes.BasicAllowance, error) {
+	spendLimit, err := pcommon.GetCoinsFromArg(spendLimitArg)
+	if err != nil {
+		return feegranttypes.BasicAllowance{}, err
+	}
+	basic := feegranttypes.BasicAllowance{}
+	if !spendLimit.Empty() {
+		basic.SpendLimit = spendLimit
+	}
+	if expiration != 0 {
+		expiresAt := time.Unix(expiration, 0).UTC()
+		if !expiresAt.After(ctx.BlockTime()) {
+			return feegranttypes.BasicAllowance{}, errors.New("expiration must be after the current block time")
+		}
+		basic.Expiration = &expiresAt
+	}
+	return basic, nil
+}
@@ -0,0 +1,206 @@
+package feegrant_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/sei-protocol/sei-chain/app"
+	"github.com/sei-protocol/sei-chain/precompiles/feegrant"
+	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/state"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+type coin struct {
+	Amount *big.Int
+	Denom  string
+}
+
+func TestFeegrant(t *testing.T) {
+	testApp := testkeeper.EVMTestApp
+	ctx := testApp.NewContext(false, tmtypes.Header{}).WithBlockHeight(2).WithBlockTime(time.Now())
+	k := &testApp.EvmKeeper
+
+	granterSeiAddr, granterEVMAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, granterSeiAddr, granterEVMAddr)
+	granteeSeiAddr, granteeEVMAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, granteeSeiAddr, granteeEVMAddr)
+	_, unassociatedEVMAddr := testkeeper.MockAddressPair()
+
+	p, err := feegrant.NewPrecompile(app.NewPrecompileKeepers(testApp))
+	require.Nil(t, err)
+	statedb := state.NewDBImpl(ctx, k, true)
+	evm := vm.EVM{
+		StateDB:   statedb,
+		TxContext: vm.TxContext{Origin: granterEVMAddr},
+	}
+	run := func(caller common.Address, method string, readOnly bool, isFromDelegateCall bool, args ...interface{}) error {
+		input, err := p.ABI.Pack(method, args...)
+		require.Nil(t, err)
+		_, _, err = p.RunAndCalculateGas(&evm, caller, caller, input, 2000000, nil, nil, readOnly, isFromDelegateCall)
+		return err
+	}
+	expiration := ctx.BlockTime().Add(time.Hour).Unix()
+	spendLimit := []coin{{Amount: big.NewInt(1000), Denom: "uaex"}}
+
+	// basic allowance without limit or expiration
+	require.Nil(t, run(granterEVMAddr, feegrant.GrantBasicAllowanceMethod, false, false, granteeEVMAddr, []coin{}, int64(0)))
+	allowance, err := testApp.FeeGrantKeeper.GetAllowance(statedb.Ctx(), granterSeiAddr, granteeSeiAddr)
+	require.Nil(t, err)
+	basic := allowance.(*feegranttypes.BasicAllowance)
+	require.Nil(t, basic.SpendLimit)
+	require.Nil(t, basic.Expiration)
+	// only one allowance per granter/grantee pair
+	require.NotNil(t, run(granterEVMAddr, feegrant.GrantBasicAllowanceMethod, false, false, granteeEVMAddr, spendLimit, expiration))
+
+	require.Nil(t, run(granterEVMAddr, feegrant.RevokeAllowanceMethod, false, false, granteeEVMAddr))
+	_, err = testApp.FeeGrantKeeper.GetAllowance(statedb.Ctx(), granterSeiAddr, granteeSeiAddr)
+	require.NotNil(t, err)
+
+	// periodic allowance
+	periodSpendLimit := []coin{{Amount: big.NewInt(100), Denom: "uaex"}}
+	require.Nil(t, run(granterEVMAddr, feegrant.GrantPeriodicAllowanceMethod, false, false, granteeEVMAddr, spendLimit, expiration, int64(60), periodSpendLimit))
+	allowance, err = testApp.FeeGrantKeeper.GetAllowance(statedb.Ctx(), granterSeiAddr, granteeSeiAddr)
+	require.Nil(t, err)
+	periodic := allowance.(*feegranttypes.PeriodicAllowance)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uaex", sdk.NewInt(1000))), periodic.Basic.SpendLimit)
+	require.Equal(t, expiration, periodic.Basic.Expiration.Unix())
+	require.Equal(t, time.Minute, periodic.Period)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uaex", sdk.NewInt(100))), periodic.PeriodSpendLimit)
+	require.Equal(t, periodic.PeriodSpendLimit, periodic.PeriodCanSpend)
+	require.Equal(t, ctx.BlockTime().Add(time.Minute).Unix(), periodic.PeriodReset.Unix())
+
+	// fees are capped per period and the cap resets once the period has elapsed
+	useFees := func(ctx sdk.Context, amount int64) error {
+		fee := sdk.NewCoins(sdk.NewCoin("uaex", sdk.NewInt(amount)))
+		return testApp.FeeGrantKeeper.UseGrantedFees(ctx, granterSeiAddr, granteeSeiAddr, fee, nil)
+	}
+	require.Nil(t, useFees(statedb.Ctx(), 60))
+	require.NotNil(t, useFees(statedb.Ctx(), 50))
+	nextPeriodCtx := statedb.Ctx().WithBlockTime(ctx.BlockTime().Add(time.Minute + time.Second))
+	require.Nil(t, useFees(nextPeriodCtx, 50))
+	allowance, err = testApp.FeeGrantKeeper.GetAllowance(nextPeriodCtx, granterSeiAddr, granteeSeiAddr)
+	require.Nil(t, err)
+	periodic = allowance.(*feegranttypes.PeriodicAllowance)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uaex", sdk.NewInt(890))), periodic.Basic.SpendLimit)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uaex", sdk.NewInt(50))), periodic.PeriodCanSpend)
+	require.Nil(t, run(granterEVMAddr, feegrant.RevokeAllowanceMethod, false, false, granteeEVMAddr))
+
+	// failures
+	require.NotNil(t, run(granterEVMAddr, feegrant.GrantPeriodicAllowanceMethod, false, false, granteeEVMAddr, spendLimit, expiration, int64(0), periodSpendLimit))
+	require.NotNil(t, run(granterEVMAddr, feegrant.GrantPeriodicAllowanceMethod, false, false, granteeEVMAddr, spendLimit, expiration, int64(60), []coin{{Amount: big.NewInt(100), Denom: "other"}}))
+	require.NotNil(t, run(granterEVMAddr, feegrant.GrantBasicAllowanceMethod, false, false, granteeEVMAddr, []coin{{Amount: big.NewInt(0), Denom: "uaex"}}, expiration))
+	require.NotNil(t, run(granterEVMAddr, feegrant.GrantBasicAllowanceMethod, false, false, granterEVMAddr, spendLimit, expiration))
+	require.NotNil(t, run(granterEVMAddr, feegrant.GrantBasicAllowanceMethod, false, false, unassociatedEVMAddr, spendLimit, expiration))
+	require.NotNil(t, run(granterEVMAddr, feegrant.RevokeAllowanceMethod, false, false, granteeEVMAddr))
+}
+
+func TestFeegrantCallRules(t *testing.T) {
+	testApp := testkeeper.EVMTestApp
+	ctx := testApp.NewContext(false, tmtypes.Header{}).WithBlockHeight(2).WithBlockTime(time.Now())
+	k := &testApp.EvmKeeper
+
+	granterSeiAddr, granterEVMAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, granterSeiAddr, granterEVMAddr)
+	granteeSeiAddr, granteeEVMAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, granteeSeiAddr, granteeEVMAddr)
+	_, unassociatedEVMAddr := testkeeper.MockAddressPair()
+
+	p, err := feegrant.NewPrecompile(app.NewPrecompileKeepers(testApp))
+	require.Nil(t, err)
+	expiration := ctx.BlockTime().Add(time.Hour).Unix()
+	spendLimit := []coin{{Amount: big.NewInt(1000), Denom: "uaex"}}
+	periodSpendLimit := []coin{{Amount: big.NewInt(100), Denom: "uaex"}}
+	basicArgs := []interface{}{granteeEVMAddr, spendLimit, expiration}
+	periodicArgs := []interface{}{granteeEVMAddr, spendLimit, expiration, int64(60), periodSpendLimit}
+	revokeArgs := []interface{}{granteeEVMAddr}
+
+	tests := []struct {
+		name               string
+		caller             common.Address
+		method             string
+		args               []interface{}
+		value              *big.Int
+		readOnly           bool
+		isFromDelegateCall bool
+		wantErrMsg         string
+	}{
+		{name: "basic grant from staticcall", caller: granterEVMAddr, method: feegrant.GrantBasicAllowanceMethod, args: basicArgs, readOnly: true, wantErrMsg: "cannot call feegrant precompile from staticcall"},
+		{name: "periodic grant from staticcall", caller: granterEVMAddr, method: feegrant.GrantPeriodicAllowanceMethod, args: periodicArgs, readOnly: true, wantErrMsg: "cannot call feegrant precompile from staticcall"},
+		{name: "revoke from staticcall", caller: granterEVMAddr, method: feegrant.RevokeAllowanceMethod, args: revokeArgs, readOnly: true, wantErrMsg: "cannot call feegrant precompile from staticcall"},
+		{name: "basic grant from delegatecall", caller: granterEVMAddr, method: feegrant.GrantBasicAllowanceMethod, args: basicArgs, isFromDelegateCall: true, wantErrMsg: "cannot delegatecall feegrant"},
+		{name: "periodic grant from delegatecall", caller: granterEVMAddr, method: feegrant.GrantPeriodicAllowanceMethod, args: periodicArgs, isFromDelegateCall: true, wantErrMsg: "cannot delegatecall feegrant"},
+		{name: "revoke from delegatecall", caller: granterEVMAddr, method: feegrant.RevokeAllowanceMethod, args: revokeArgs, isFromDelegateCall: true, wantErrMsg: "cannot delegatecall feegrant"},
+		{name: "basic grant with value", caller: granterEVMAddr, method: feegrant.GrantBasicAllowanceMethod, args: basicArgs, value: big.NewInt(1), wantErrMsg: "sending funds to a non-payable function"},
+		{name: "periodic grant with value", caller: granterEVMAddr, method: feegrant.GrantPeriodicAllowanceMethod, args: periodicArgs, value: big.NewInt(1), wantErrMsg: "sending funds to a non-payable function"},
+		{name: "revoke with value", caller: granterEVMAddr, method: feegrant.RevokeAllowanceMethod, args: revokeArgs, value: big.NewInt(1), wantErrMsg: "sending funds to a non-payable function"},
+		{name: "basic grant expiring at the block time", caller: granterEVMAddr, method: feegrant.GrantBasicAllowanceMethod, args: []interface{}{granteeEVMAddr, spendLimit, ctx.BlockTime().Unix()}, wantErrMsg: "expiration must be after the current block time"},
+		{name: "periodic grant expiring before the block time", caller: granterEVMAddr, method: feegrant.GrantPeriodicAllowanceMethod, args: []interface{}{granteeEVMAddr, spendLimit, ctx.BlockTime().Add(-time.Hour).Unix(), int64(60), periodSpendLimit}, wantErrMsg: "expiration must be after the current block time"},
+		{name: "grant from an unassociated caller", caller: unassociatedEVMAddr, method: feegrant.GrantBasicAllowanceMethod, args: basicArgs, wantErrMsg: types.NewAssociationMissingErr(unassociatedEVMAddr.Hex()).Error()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statedb := state.NewDBImpl(ctx, k, true)
+			evm := vm.EVM{
+				StateDB:   statedb,
+				TxContext: vm.TxContext{Origin: tt.caller},
+			}
+			input, err := p.ABI.Pack(tt.method, tt.args...)
+			require.Nil(t, err)
+			ret, _, err := p.RunAndCalculateGas(&evm, tt.caller, tt.caller, input, 2000000, tt.value, nil, tt.readOnly, tt.isFromDelegateCall)
+			require.Equal(t, vm.ErrExecutionReverted, err)
+			require.Equal(t, tt.wantErrMsg, string(ret))
+			_, err = testApp.FeeGrantKeeper.GetAllowance(statedb.Ctx(), granterSeiAddr, granteeSeiAddr)
+			require.NotNil(t, err)
+		})
+	}
+}
+
+func TestFeegrantGranterIsCaller(t *testing.T) {
+	testApp := testkeeper.EVMTestApp
+	ctx := testApp.NewContext(false, tmtypes.Header{}).WithBlockHeight(2).WithBlockTime(time.Now())
+	k := &testApp.EvmKeeper
+
+	callerSeiAddr, callerEVMAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, callerSeiAddr, callerEVMAddr)
+	originSeiAddr, originEVMAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, originSeiAddr, originEVMAddr)
+	granteeSeiAddr, granteeEVMAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, granteeSeiAddr, granteeEVMAddr)
+
+	p, err := feegrant.NewPrecompile(app.NewPrecompileKeepers(testApp))
+	require.Nil(t, err)
+	statedb := state.NewDBImpl(ctx, k, true)
+	// the call comes from a contract, so the caller differs from the tx origin
+	evm := vm.EVM{
+		StateDB:   statedb,
+		TxContext: vm.TxContext{Origin: originEVMAddr},
+	}
+	run := func(caller common.Address, method string, args ...interface{}) error {
+		input, err := p.ABI.Pack(method, args...)
+		require.Nil(t, err)
+		_, _, err = p.RunAndCalculateGas(&evm, caller, caller, input, 2000000, nil, nil, false, false)
+		return err
+	}
+
+	require.Nil(t, run(callerEVMAddr, feegrant.GrantBasicAllowanceMethod, granteeEVMAddr, []coin{}, int64(0)))
+	_, err = testApp.FeeGrantKeeper.GetAllowance(statedb.Ctx(), callerSeiAddr, granteeSeiAddr)
+	require.Nil(t, err)
+	_, err = testApp.FeeGrantKeeper.GetAllowance(statedb.Ctx(), originSeiAddr, granteeSeiAddr)
+	require.NotNil(t, err)
+
+	// another account cannot revoke the caller's allowance
+	require.NotNil(t, run(originEVMAddr, feegrant.RevokeAllowanceMethod, granteeEVMAddr))
+	_, err = testApp.FeeGrantKeeper.GetAllowance(statedb.Ctx(), callerSeiAddr, granteeSeiAddr)
+	require.Nil(t, err)
+	require.Nil(t, run(callerEVMAddr, feegrant.RevokeAllowanceMethod, granteeEVMAddr))
+	_, err = testApp.FeeGrantKeeper.GetAllowance(statedb.Ctx(), callerSeiAddr, granteeSeiAddr)
+	require.NotNil(t, err)
+}
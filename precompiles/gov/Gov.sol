@@ -12,6 +12,46 @@ struct WeightedVoteOption {
     string weight;  // Weight as decimal string (e.g., "0.7")
 }
 
+struct Coin {
+    uint256 amount;
+    string denom;
+}
+
+struct TallyResult {
+    uint256 yes;
+    uint256 abstain;
+    uint256 no;
+    uint256 noWithVeto;
+}
+
+struct ProposalInfo {
+    uint64 proposalID;
+    string title;
+    string description;
+    string proposalType;
+    int32 status;                  // 1=DepositPeriod, 2=VotingPeriod, 3=Passed, 4=Rejected, 5=Failed
+    TallyResult finalTallyResult;  // Set once the voting period has ended
+    int64 submitTime;              // Unix timestamps in seconds
+    int64 depositEndTime;
+    Coin[] totalDeposit;
+    int64 votingStartTime;
+    int64 votingEndTime;
+    bool isExpedited;
+}
+
+struct Params {
+    Coin[] minDeposit;
+    Coin[] minExpeditedDeposit;
+    int64 maxDepositPeriod;       // Periods in seconds
+    int64 votingPeriod;
+    int64 expeditedVotingPeriod;
+    uint256 quorum;               // Ratios with 18 decimals, e.g. 0.334 is 334000000000000000
+    uint256 threshold;
+    uint256 vetoThreshold;
+    uint256 expeditedQuorum;
+    uint256 expeditedThreshold;
+}
+
 interface IGov {
     /**
      * @dev Cast a simple vote on a governance proposal
@@ -65,4 +105,61 @@ interface IGov {
     function submitProposal(
         string calldata proposalJSON
     ) payable external returns (uint64 proposalID);
+
+    // Queries
+
+    /**
+     * @dev Get a proposal, reverts if it doesn't exist. Proposals that didn't reach the
+     *      minimum deposit before their deposit end time are deleted.
+     * @param proposalID The ID of the proposal
+     * @return proposal The proposal
+     */
+    function proposal(
+        uint64 proposalID
+    ) external view returns (ProposalInfo memory proposal);
+
+    /**
+     * @dev Get a page of proposals
+     * @param status Only return proposals with this status, or proposals of any status if 0
+     * @param pageKey The nextKey returned by the previous call, empty for the first page
+     * @param limit The maximum number of proposals to return, 0 for the default of 50, at most 100
+     * @return proposals The proposals
+     * @return nextKey The key of the next page, empty if there are no more proposals
+     */
+    function proposals(
+        int32 status,
+        bytes calldata pageKey,
+        uint64 limit
+    ) external view returns (ProposalInfo[] memory proposals, bytes memory nextKey);
+
+    /**
+     * @dev Get the tally of a proposal: the current tally during the voting period and the
+     *      final tally once it has ended
+     * @param proposalID The ID of the proposal
+     * @return tally The tally
+     */
+    function tally(
+        uint64 proposalID
+    ) external view returns (TallyResult memory tally);
+
+    /**
+     * @dev Get the vote of a voter on a proposal
+     * @param proposalID The ID of the proposal
+     * @param voter The EVM address of the voter
+     * @return options The weighted options voted, empty if the voter hasn't voted
+     */
+    function getVote(
+        uint64 proposalID,
+        address voter
+    ) external view returns (WeightedVoteOption[] memory options);
+
+    /**
+     * @dev Get the deposit, voting and tally params of the gov module
+     * @return params The params
+     */
+    function params() external view returns (Params memory params);
+
+    // Proposals can't be cancelled and deposits can't be withdrawn: deposits are refunded once
+    // a proposal passes or is rejected, and burned if it doesn't reach quorum, is vetoed or
+    // doesn't reach the minimum deposit in time.
 }
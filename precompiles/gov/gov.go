@@ -9,6 +9,7 @@ import (
 	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
@@ -24,6 +25,20 @@ const (
 	VoteWeightedMethod   = "voteWeighted"
 	DepositMethod        = "deposit"
 	SubmitProposalMethod = "submitProposal"
+	ProposalMethod       = "proposal"
+	ProposalsMethod      = "proposals"
+	TallyMethod          = "tally"
+	GetVoteMethod        = "getVote"
+	ParamsMethod         = "params"
+)
+
+const (
+	// DefaultPageLimit is the number of proposals returned by the proposals query called with a zero limit
+	DefaultPageLimit uint64 = 50
+	// MaxPageLimit is the most proposals returned in a single call
+	MaxPageLimit uint64 = 100
+	// PageEntryGas is the gas charged for each proposal the proposals query may return
+	PageEntryGas uint64 = 2000
 )
 
 const (
@@ -36,7 +51,9 @@ const (
 var f embed.FS
 
 type PrecompileExecutor struct {
+	govKeeper        utils.GovKeeper
 	govMsgServer     utils.GovMsgServer
+	govQuerier       utils.GovQuerier
 	evmKeeper        utils.EVMKeeper
 	bankKeeper       utils.BankKeeper
 	address          common.Address
@@ -46,13 +63,20 @@ type PrecompileExecutor struct {
 	VoteWeightedID   []byte
 	DepositID        []byte
 	SubmitProposalID []byte
+	ProposalID       []byte
+	ProposalsID      []byte
+	TallyID          []byte
+	GetVoteID        []byte
+	ParamsID         []byte
 }
 
 func NewPrecompile(keepers utils.Keepers) (*pcommon.Precompile, error) {
 	newAbi := pcommon.MustGetABI(f, "abi.json")
 
 	p := &PrecompileExecutor{
+		govKeeper:    keepers.GovK(),
 		govMsgServer: keepers.GovMS(),
+		govQuerier:   keepers.GovQ(),
 		evmKeeper:    keepers.EVMK(),
 		bankKeeper:   keepers.BankK(),
 		address:      common.HexToAddress(GovAddress),
@@ -72,6 +96,16 @@ func NewPrecompile(keepers utils.Keepers) (*pcommon.Precompile, error) {
 			p.SubmitProposalID = m.ID
 		case VoteWeightedMethod:
 			p.VoteWeightedID = m.ID
+		case ProposalMethod:
+			p.ProposalID = m.ID
+		case ProposalsMethod:
+			p.ProposalsID = m.ID
+		case TallyMethod:
+			p.TallyID = m.ID
+		case GetVoteMethod:
+			p.GetVoteID = m.ID
+		case ParamsMethod:
+			p.ParamsID = m.ID
 		}
 	}
 
@@ -87,6 +121,17 @@ func (p PrecompileExecutor) RequiredGas(input []byte, method *abi.Method) uint64
 		return 30000
 	} else if bytes.Equal(method.ID, p.SubmitProposalID) {
 		return 50000
+	} else if bytes.Equal(method.ID, p.ProposalsID) {
+		// charged for the most proposals the query may return
+		args, err := method.Inputs.Unpack(input)
+		if err != nil || len(args) == 0 {
+			return pcommon.UnknownMethodCallGas
+		}
+		limit, ok := args[len(args)-1].(uint64)
+		if !ok {
+			return pcommon.UnknownMethodCallGas
+		}
+		return pcommon.UnknownMethodCallGas + PageEntryGas*pageLimit(limit)
 	}
 
 	// This should never happen since this is going to fail during Run
@@ -94,13 +139,27 @@ func (p PrecompileExecutor) RequiredGas(input []byte, method *abi.Method) uint64
 }
 
 func (p PrecompileExecutor) Execute(ctx sdk.Context, method *abi.Method, caller common.Address, callingContract common.Address, args []interface{}, value *big.Int, readOnly bool, evm *vm.EVM, hooks *tracing.Hooks) (bz []byte, err error) {
-	if readOnly {
-		return nil, errors.New("cannot call gov precompile from staticcall")
-	}
 	if ctx.EVMPrecompileCalledFromDelegateCall() {
 		return nil, errors.New("cannot delegatecall gov")
 	}
 
+	// queries may be called from staticcall
+	switch method.Name {
+	case ProposalMethod:
+		return p.proposal(ctx, method, args, value)
+	case ProposalsMethod:
+		return p.proposals(ctx, method, args, value)
+	case TallyMethod:
+		return p.tally(ctx, method, args, value)
+	case GetVoteMethod:
+		return p.getVote(ctx, method, args, value)
+	case ParamsMethod:
+		return p.params(ctx, method, args, value)
+	}
+	if readOnly {
+		return nil, errors.New("cannot call gov precompile from staticcall")
+	}
+
 	switch method.Name {
 	case VoteMethod:
 		return p.vote(ctx, method, caller, args, value)
@@ -292,3 +351,211 @@ func (p PrecompileExecutor) submitProposal(ctx sdk.Context, method *abi.Method,
 	// Return the proposal ID
 	return method.Outputs.Pack(res.ProposalId)
 }
+
+type Coin struct {
+	Amount *big.Int
+	Denom  string
+}
+
+type TallyResult struct {
+	Yes        *big.Int
+	Abstain    *big.Int
+	No         *big.Int
+	NoWithVeto *big.Int
+}
+
+// ProposalInfo is a proposal as returned by the proposal queries, with times in unix seconds
+type ProposalInfo struct {
+	ProposalID       uint64
+	Title            string
+	Description      string
+	ProposalType     string
+	Status           int32
+	FinalTallyResult TallyResult
+	SubmitTime       int64
+	DepositEndTime   int64
+	TotalDeposit     []Coin
+	VotingStartTime  int64
+	VotingEndTime    int64
+	IsExpedited      bool
+}
+
+type WeightedVoteOption struct {
+	Option int32
+	Weight string
+}
+
+// Params are the deposit, voting and tally params of the gov module, with periods in seconds and
+// ratios as integers with 18 decimals
+type Params struct {
+	MinDeposit            []Coin
+	MinExpeditedDeposit   []Coin
+	MaxDepositPeriod      int64
+	VotingPeriod          int64
+	ExpeditedVotingPeriod int64
+	Quorum                *big.Int
+	Threshold             *big.Int
+	VetoThreshold         *big.Int
+	ExpeditedQuorum       *big.Int
+	ExpeditedThreshold    *big.Int
+}
+
+func (p PrecompileExecutor) proposal(ctx sdk.Context, method *abi.Method, args []interface{}, value *big.Int) ([]byte, error) {
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		return nil, err
+	}
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		return nil, err
+	}
+	res, err := p.govQuerier.Proposal(sdk.WrapSDKContext(ctx), &govtypes.QueryProposalRequest{ProposalId: args[0].(uint64)})
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(toProposalInfo(res.Proposal))
+}
+
+func (p PrecompileExecutor) proposals(ctx sdk.Context, method *abi.Method, args []interface{}, value *big.Int) ([]byte, error) {
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		return nil, err
+	}
+	if err := pcommon.ValidateArgsLength(args, 3); err != nil {
+		return nil, err
+	}
+	// an unspecified status matches proposals of any status
+	res, err := p.govQuerier.Proposals(sdk.WrapSDKContext(ctx), &govtypes.QueryProposalsRequest{
+		ProposalStatus: govtypes.ProposalStatus(args[0].(int32)),
+		Pagination:     &query.PageRequest{Key: args[1].([]byte), Limit: pageLimit(args[2].(uint64))},
+	})
+	if err != nil {
+		return nil, err
+	}
+	proposals := make([]ProposalInfo, 0, len(res.Proposals))
+	for _, proposal := range res.Proposals {
+		proposals = append(proposals, toProposalInfo(proposal))
+	}
+	nextKey := []byte{}
+	if res.Pagination != nil && res.Pagination.NextKey != nil {
+		nextKey = res.Pagination.NextKey
+	}
+	return method.Outputs.Pack(proposals, nextKey)
+}
+
+func (p PrecompileExecutor) tally(ctx sdk.Context, method *abi.Method, args []interface{}, value *big.Int) ([]byte, error) {
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		return nil, err
+	}
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		return nil, err
+	}
+	// the current tally of proposals in voting period, the final one of those past it. Tallying
+	// deletes the votes counted, so it's done on a cache context that is discarded.
+	cacheCtx, _ := ctx.CacheContext()
+	res, err := p.govQuerier.TallyResult(sdk.WrapSDKContext(cacheCtx), &govtypes.QueryTallyResultRequest{ProposalId: args[0].(uint64)})
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(toTallyResult(res.Tally))
+}
+
+func (p PrecompileExecutor) getVote(ctx sdk.Context, method *abi.Method, args []interface{}, value *big.Int) ([]byte, error) {
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		return nil, err
+	}
+	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+		return nil, err
+	}
+	voter, err := pcommon.GetSeiAddressFromArg(ctx, args[1], p.evmKeeper)
+	if err != nil {
+		return nil, err
+	}
+	// no options if the voter hasn't voted
+	options := []WeightedVoteOption{}
+	if vote, found := p.govKeeper.GetVote(ctx, args[0].(uint64), voter); found {
+		for _, option := range vote.Options {
+			options = append(options, WeightedVoteOption{Option: int32(option.Option), Weight: option.Weight.String()})
+		}
+	}
+	return method.Outputs.Pack(options)
+}
+
+func (p PrecompileExecutor) params(ctx sdk.Context, method *abi.Method, args []interface{}, value *big.Int) ([]byte, error) {
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		return nil, err
+	}
+	if err := pcommon.ValidateArgsLength(args, 0); err != nil {
+		return nil, err
+	}
+	goCtx := sdk.WrapSDKContext(ctx)
+	deposit, err := p.govQuerier.Params(goCtx, &govtypes.QueryParamsRequest{ParamsType: govtypes.ParamDeposit})
+	if err != nil {
+		return nil, err
+	}
+	voting, err := p.govQuerier.Params(goCtx, &govtypes.QueryParamsRequest{ParamsType: govtypes.ParamVoting})
+	if err != nil {
+		return nil, err
+	}
+	tally, err := p.govQuerier.Params(goCtx, &govtypes.QueryParamsRequest{ParamsType: govtypes.ParamTallying})
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(Params{
+		MinDeposit:            toCoins(deposit.DepositParams.MinDeposit),
+		MinExpeditedDeposit:   toCoins(deposit.DepositParams.MinExpeditedDeposit),
+		MaxDepositPeriod:      int64(deposit.DepositParams.MaxDepositPeriod.Seconds()),
+		VotingPeriod:          int64(voting.VotingParams.VotingPeriod.Seconds()),
+		ExpeditedVotingPeriod: int64(voting.VotingParams.ExpeditedVotingPeriod.Seconds()),
+		Quorum:                tally.TallyParams.Quorum.BigInt(),
+		Threshold:             tally.TallyParams.Threshold.BigInt(),
+		VetoThreshold:         tally.TallyParams.VetoThreshold.BigInt(),
+		ExpeditedQuorum:       tally.TallyParams.ExpeditedQuorum.BigInt(),
+		ExpeditedThreshold:    tally.TallyParams.ExpeditedThreshold.BigInt(),
+	})
+}
+
+func toProposalInfo(proposal govtypes.Proposal) ProposalInfo {
+	info := ProposalInfo{
+		ProposalID:       proposal.ProposalId,
+		ProposalType:     proposal.ProposalType(),
+		Status:           int32(proposal.Status),
+		FinalTallyResult: toTallyResult(proposal.FinalTallyResult),
+		SubmitTime:       proposal.SubmitTime.Unix(),
+		DepositEndTime:   proposal.DepositEndTime.Unix(),
+		TotalDeposit:     toCoins(proposal.TotalDeposit),
+		VotingStartTime:  proposal.VotingStartTime.Unix(),
+		VotingEndTime:    proposal.VotingEndTime.Unix(),
+		IsExpedited:      proposal.IsExpedited,
+	}
+	if content := proposal.GetContent(); content != nil {
+		info.Title = content.GetTitle()
+		info.Description = content.GetDescription()
+	}
+	return info
+}
+
+func toTallyResult(tally govtypes.TallyResult) TallyResult {
+	return TallyResult{
+		Yes:        tally.Yes.BigInt(),
+		Abstain:    tally.Abstain.BigInt(),
+		No:         tally.No.BigInt(),
+		NoWithVeto: tally.NoWithVeto.BigInt(),
+	}
+}
+
+func toCoins(coins sdk.Coins) []Coin {
+	res := make([]Coin, 0, len(coins))
+	for _, coin := range coins {
+		res = append(res, Coin{Amount: coin.Amount.BigInt(), Denom: coin.Denom})
+	}
+	return res
+}
+
+// pageLimit returns the number of proposals the proposals query called with the given limit returns at most
+func pageLimit(limit uint64) uint64 {
+	if limit == 0 {
+		return DefaultPageLimit
+	}
+	if limit > MaxPageLimit {
+		return MaxPageLimit
+	}
+	return limit
+}
@@ -8,17 +8,22 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
 	tmtypes "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	"github.com/sei-protocol/sei-chain/app"
 	pcommon "github.com/sei-protocol/sei-chain/precompiles/common"
 	"github.com/sei-protocol/sei-chain/precompiles/gov"
+	"github.com/sei-protocol/sei-chain/precompiles/utils"
 	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
 	"github.com/sei-protocol/sei-chain/x/evm/ante"
 	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/state"
 	evmtypes "github.com/sei-protocol/sei-chain/x/evm/types"
 	"github.com/sei-protocol/sei-chain/x/evm/types/ethtx"
 )
@@ -1010,3 +1015,123 @@ func TestPrecompileExecutor_submitProposal(t *testing.T) {
 		})
 	}
 }
+
+func TestGovQueries(t *testing.T) {
+	testApp := testkeeper.EVMTestApp
+	ctx := testApp.NewContext(false, tmtypes.Header{}).WithBlockHeight(2)
+	k := &testApp.EvmKeeper
+	content := govtypes.ContentFromProposalType("query title", "query description", govtypes.ProposalTypeText, false)
+	proposal, err := testApp.GovKeeper.SubmitProposal(ctx, content)
+	require.Nil(t, err)
+	testApp.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+	seiAddr, evmAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, seiAddr, evmAddr)
+	require.Nil(t, testApp.GovKeeper.AddVote(ctx, proposal.ProposalId, seiAddr, govtypes.WeightedVoteOptions{
+		{Option: govtypes.OptionYes, Weight: sdk.MustNewDecFromStr("0.6")},
+		{Option: govtypes.OptionNo, Weight: sdk.MustNewDecFromStr("0.4")},
+	}))
+
+	p, err := gov.NewPrecompile(app.NewPrecompileKeepers(testApp))
+	require.Nil(t, err)
+	evm := vm.EVM{StateDB: state.NewDBImpl(ctx, k, true), TxContext: vm.TxContext{Origin: evmAddr}}
+	call := func(method string, args ...interface{}) []interface{} {
+		input, err := p.ABI.Pack(method, args...)
+		require.Nil(t, err)
+		// queries can be called from staticcall
+		ret, err := p.Run(&evm, evmAddr, evmAddr, input, nil, true, false, nil)
+		require.Nil(t, err, string(ret))
+		out, err := p.ABI.Unpack(method, ret)
+		require.Nil(t, err)
+		return out
+	}
+
+	// proposal
+	out := call(gov.ProposalMethod, proposal.ProposalId)
+	got := *abi.ConvertType(out[0], new(gov.ProposalInfo)).(*gov.ProposalInfo)
+	proposal, _ = testApp.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+	require.Equal(t, proposal.ProposalId, got.ProposalID)
+	require.Equal(t, "query title", got.Title)
+	require.Equal(t, "query description", got.Description)
+	require.Equal(t, govtypes.ProposalTypeText, got.ProposalType)
+	require.Equal(t, int32(govtypes.StatusVotingPeriod), got.Status)
+	require.Equal(t, proposal.DepositEndTime.Unix(), got.DepositEndTime)
+	require.Equal(t, proposal.VotingEndTime.Unix(), got.VotingEndTime)
+	require.False(t, got.IsExpedited)
+
+	// proposals in voting period, one page at a time
+	var ids []uint64
+	pageKey := []byte{}
+	for {
+		out = call(gov.ProposalsMethod, int32(govtypes.StatusVotingPeriod), pageKey, uint64(1))
+		page := *abi.ConvertType(out[0], new([]gov.ProposalInfo)).(*[]gov.ProposalInfo)
+		require.Len(t, page, 1)
+		require.Equal(t, int32(govtypes.StatusVotingPeriod), page[0].Status)
+		ids = append(ids, page[0].ProposalID)
+		pageKey = out[1].([]byte)
+		if len(pageKey) == 0 {
+			break
+		}
+	}
+	require.Contains(t, ids, proposal.ProposalId)
+
+	// tally
+	out = call(gov.TallyMethod, proposal.ProposalId)
+	tally := *abi.ConvertType(out[0], new(gov.TallyResult)).(*gov.TallyResult)
+	cacheCtx, _ := ctx.CacheContext()
+	_, _, expectedTally := testApp.GovKeeper.Tally(cacheCtx, proposal)
+	require.Zero(t, expectedTally.Yes.BigInt().Cmp(tally.Yes))
+	require.Zero(t, expectedTally.No.BigInt().Cmp(tally.No))
+
+	// vote, which the tally query left in place
+	out = call(gov.GetVoteMethod, proposal.ProposalId, evmAddr)
+	options := *abi.ConvertType(out[0], new([]gov.WeightedVoteOption)).(*[]gov.WeightedVoteOption)
+	require.Equal(t, []gov.WeightedVoteOption{
+		{Option: int32(govtypes.OptionYes), Weight: "0.600000000000000000"},
+		{Option: int32(govtypes.OptionNo), Weight: "0.400000000000000000"},
+	}, options)
+	otherSeiAddr, otherEvmAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, otherSeiAddr, otherEvmAddr)
+	out = call(gov.GetVoteMethod, proposal.ProposalId, otherEvmAddr)
+	require.Empty(t, out[0])
+
+	// params
+	out = call(gov.ParamsMethod)
+	params := *abi.ConvertType(out[0], new(gov.Params)).(*gov.Params)
+	depositParams := testApp.GovKeeper.GetDepositParams(ctx)
+	require.Len(t, params.MinDeposit, len(depositParams.MinDeposit))
+	require.Equal(t, depositParams.MinDeposit[0].Denom, params.MinDeposit[0].Denom)
+	require.Zero(t, depositParams.MinDeposit[0].Amount.BigInt().Cmp(params.MinDeposit[0].Amount))
+	require.Equal(t, int64(depositParams.MaxDepositPeriod.Seconds()), params.MaxDepositPeriod)
+	require.Equal(t, int64(testApp.GovKeeper.GetVotingParams(ctx).VotingPeriod.Seconds()), params.VotingPeriod)
+	require.Equal(t, testApp.GovKeeper.GetTallyParams(ctx).Quorum.BigInt(), params.Quorum)
+
+	// missing proposals revert
+	input, err := p.ABI.Pack(gov.ProposalMethod, uint64(1_000_000))
+	require.Nil(t, err)
+	_, err = p.Run(&evm, evmAddr, evmAddr, input, nil, true, false, nil)
+	require.NotNil(t, err)
+
+	// txs still can't be sent from staticcall
+	input, err = p.ABI.Pack(gov.VoteMethod, proposal.ProposalId, int32(govtypes.OptionYes))
+	require.Nil(t, err)
+	ret, err := p.Run(&evm, evmAddr, evmAddr, input, nil, true, false, nil)
+	require.NotNil(t, err)
+	require.Contains(t, string(ret), "cannot call gov precompile from staticcall")
+}
+
+func TestProposalsGas(t *testing.T) {
+	p, err := gov.NewPrecompile(&utils.EmptyKeepers{})
+	require.Nil(t, err)
+	for _, tc := range []struct {
+		limit uint64
+		gas   uint64
+	}{
+		{0, pcommon.UnknownMethodCallGas + gov.DefaultPageLimit*gov.PageEntryGas},
+		{10, pcommon.UnknownMethodCallGas + 10*gov.PageEntryGas},
+		{1000, pcommon.UnknownMethodCallGas + gov.MaxPageLimit*gov.PageEntryGas},
+	} {
+		input, err := p.ABI.Pack(gov.ProposalsMethod, int32(0), []byte{}, tc.limit)
+		require.Nil(t, err)
+		require.Equal(t, tc.gas, p.RequiredGas(input))
+	}
+}
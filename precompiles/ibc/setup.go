@@ -12,6 +12,7 @@ import (
 	ibcv606 "github.com/sei-protocol/sei-chain/precompiles/ibc/legacy/v606"
 	ibcv610 "github.com/sei-protocol/sei-chain/precompiles/ibc/legacy/v610"
 	ibcv614 "github.com/sei-protocol/sei-chain/precompiles/ibc/legacy/v614"
+	ibcv620 "github.com/sei-protocol/sei-chain/precompiles/ibc/legacy/v620"
 	"github.com/sei-protocol/sei-chain/precompiles/utils"
 )
 
@@ -28,6 +29,7 @@ func GetVersioned(latestUpgrade string, keepers utils.Keepers) utils.VersionedPr
 		"v6.0.6":      check(ibcv606.NewPrecompile(keepers)),
 		"v6.1.0":      check(ibcv610.NewPrecompile(keepers)),
 		"v6.1.4":      check(ibcv614.NewPrecompile(keepers)),
+		"v6.2.0":      check(ibcv620.NewPrecompile(keepers)),
 	}
 }
 
@@ -10,6 +10,7 @@ import (
 	jsonv606 "github.com/sei-protocol/sei-chain/precompiles/json/legacy/v606"
 	jsonv610 "github.com/sei-protocol/sei-chain/precompiles/json/legacy/v610"
 	jsonv614 "github.com/sei-protocol/sei-chain/precompiles/json/legacy/v614"
+	jsonv620 "github.com/sei-protocol/sei-chain/precompiles/json/legacy/v620"
 	"github.com/sei-protocol/sei-chain/precompiles/utils"
 )
 
@@ -24,6 +25,7 @@ func GetVersioned(latestUpgrade string, keepers utils.Keepers) utils.VersionedPr
 		"v6.0.6":      check(jsonv606.NewPrecompile(keepers)),
 		"v6.1.0":      check(jsonv610.NewPrecompile(keepers)),
 		"v6.1.4":      check(jsonv614.NewPrecompile(keepers)),
+		"v6.2.0":      check(jsonv620.NewPrecompile(keepers)),
 	}
 }
 
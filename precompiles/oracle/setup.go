@@ -12,6 +12,7 @@ import (
 	oraclev606 "github.com/sei-protocol/sei-chain/precompiles/oracle/legacy/v606"
 	oraclev610 "github.com/sei-protocol/sei-chain/precompiles/oracle/legacy/v610"
 	oraclev614 "github.com/sei-protocol/sei-chain/precompiles/oracle/legacy/v614"
+	oraclev620 "github.com/sei-protocol/sei-chain/precompiles/oracle/legacy/v620"
 	"github.com/sei-protocol/sei-chain/precompiles/utils"
 )
 
@@ -28,6 +29,7 @@ func GetVersioned(latestUpgrade string, keepers utils.Keepers) utils.VersionedPr
 		"v6.0.6":      check(oraclev606.NewPrecompile(keepers)),
 		"v6.1.0":      check(oraclev610.NewPrecompile(keepers)),
 		"v6.1.4":      check(oraclev614.NewPrecompile(keepers)),
+		"v6.2.0":      check(oraclev620.NewPrecompile(keepers)),
 	}
 }
 
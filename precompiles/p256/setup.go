@@ -4,6 +4,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 	p256v606 "github.com/sei-protocol/sei-chain/precompiles/p256/legacy/v606"
 	p256v614 "github.com/sei-protocol/sei-chain/precompiles/p256/legacy/v614"
+	p256v620 "github.com/sei-protocol/sei-chain/precompiles/p256/legacy/v620"
 	"github.com/sei-protocol/sei-chain/precompiles/utils"
 )
 
@@ -12,6 +13,7 @@ func GetVersioned(latestUpgrade string, keepers utils.Keepers) utils.VersionedPr
 		latestUpgrade: check(NewPrecompile(keepers)),
 		"v6.0.6":      check(p256v606.NewPrecompile(keepers)),
 		"v6.1.4":      check(p256v614.NewPrecompile(keepers)),
+		"v6.2.0":      check(p256v620.NewPrecompile(keepers)),
 	}
 }
 
@@ -12,6 +12,7 @@ import (
 	pointerv606 "github.com/sei-protocol/sei-chain/precompiles/pointer/legacy/v606"
 	pointerv610 "github.com/sei-protocol/sei-chain/precompiles/pointer/legacy/v610"
 	pointerv614 "github.com/sei-protocol/sei-chain/precompiles/pointer/legacy/v614"
+	pointerv620 "github.com/sei-protocol/sei-chain/precompiles/pointer/legacy/v620"
 	"github.com/sei-protocol/sei-chain/precompiles/utils"
 )
 
@@ -28,6 +29,7 @@ func GetVersioned(latestUpgrade string, keepers utils.Keepers) utils.VersionedPr
 		"v6.0.6":      check(pointerv606.NewPrecompile(keepers)),
 		"v6.1.0":      check(pointerv610.NewPrecompile(keepers)),
 		"v6.1.4":      check(pointerv614.NewPrecompile(keepers)),
+		"v6.2.0":      check(pointerv620.NewPrecompile(keepers)),
 	}
 }
 
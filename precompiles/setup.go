@@ -7,8 +7,11 @@ import (
 	ecommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/sei-protocol/sei-chain/precompiles/addr"
+	"github.com/sei-protocol/sei-chain/precompiles/aexburn"
+	"github.com/sei-protocol/sei-chain/precompiles/authz"
 	"github.com/sei-protocol/sei-chain/precompiles/bank"
 	"github.com/sei-protocol/sei-chain/precompiles/distribution"
+	"github.com/sei-protocol/sei-chain/precompiles/feegrant"
 	"github.com/sei-protocol/sei-chain/precompiles/gov"
 	"github.com/sei-protocol/sei-chain/precompiles/ibc"
 	"github.com/sei-protocol/sei-chain/precompiles/json"
@@ -18,6 +21,7 @@ import (
 	"github.com/sei-protocol/sei-chain/precompiles/pointerview"
 	"github.com/sei-protocol/sei-chain/precompiles/solo"
 	"github.com/sei-protocol/sei-chain/precompiles/staking"
+	"github.com/sei-protocol/sei-chain/precompiles/tokenfactory"
 	"github.com/sei-protocol/sei-chain/precompiles/utils"
 	"github.com/sei-protocol/sei-chain/precompiles/wasmd"
 )
@@ -45,19 +49,23 @@ func GetCustomPrecompiles(
 	keepers utils.Keepers,
 ) map[ecommon.Address]utils.VersionedPrecompiles {
 	return map[ecommon.Address]utils.VersionedPrecompiles{
-		ecommon.HexToAddress(bank.BankAddress):               bank.GetVersioned(latestUpgrade, keepers),
-		ecommon.HexToAddress(wasmd.WasmdAddress):             wasmd.GetVersioned(latestUpgrade, keepers),
-		ecommon.HexToAddress(json.JSONAddress):               json.GetVersioned(latestUpgrade, keepers),
-		ecommon.HexToAddress(addr.AddrAddress):               addr.GetVersioned(latestUpgrade, keepers),
-		ecommon.HexToAddress(staking.StakingAddress):         staking.GetVersioned(latestUpgrade, keepers),
-		ecommon.HexToAddress(gov.GovAddress):                 gov.GetVersioned(latestUpgrade, keepers),
-		ecommon.HexToAddress(distribution.DistrAddress):      distribution.GetVersioned(latestUpgrade, keepers),
-		ecommon.HexToAddress(oracle.OracleAddress):           oracle.GetVersioned(latestUpgrade, keepers),
-		ecommon.HexToAddress(ibc.IBCAddress):                 ibc.GetVersioned(latestUpgrade, keepers),
-		ecommon.HexToAddress(pointer.PointerAddress):         pointer.GetVersioned(latestUpgrade, keepers),
-		ecommon.HexToAddress(pointerview.PointerViewAddress): pointerview.GetVersioned(latestUpgrade, keepers),
-		ecommon.HexToAddress(p256.P256VerifyAddress):         p256.GetVersioned(latestUpgrade, keepers),
-		ecommon.HexToAddress(solo.SoloAddress):               solo.GetVersioned(latestUpgrade, keepers),
+		ecommon.HexToAddress(bank.BankAddress):                 bank.GetVersioned(latestUpgrade, keepers),
+		ecommon.HexToAddress(wasmd.WasmdAddress):               wasmd.GetVersioned(latestUpgrade, keepers),
+		ecommon.HexToAddress(json.JSONAddress):                 json.GetVersioned(latestUpgrade, keepers),
+		ecommon.HexToAddress(addr.AddrAddress):                 addr.GetVersioned(latestUpgrade, keepers),
+		ecommon.HexToAddress(staking.StakingAddress):           staking.GetVersioned(latestUpgrade, keepers),
+		ecommon.HexToAddress(gov.GovAddress):                   gov.GetVersioned(latestUpgrade, keepers),
+		ecommon.HexToAddress(distribution.DistrAddress):        distribution.GetVersioned(latestUpgrade, keepers),
+		ecommon.HexToAddress(oracle.OracleAddress):             oracle.GetVersioned(latestUpgrade, keepers),
+		ecommon.HexToAddress(ibc.IBCAddress):                   ibc.GetVersioned(latestUpgrade, keepers),
+		ecommon.HexToAddress(pointer.PointerAddress):           pointer.GetVersioned(latestUpgrade, keepers),
+		ecommon.HexToAddress(pointerview.PointerViewAddress):   pointerview.GetVersioned(latestUpgrade, keepers),
+		ecommon.HexToAddress(p256.P256VerifyAddress):           p256.GetVersioned(latestUpgrade, keepers),
+		ecommon.HexToAddress(solo.SoloAddress):                 solo.GetVersioned(latestUpgrade, keepers),
+		ecommon.HexToAddress(aexburn.AexburnAddress):           aexburn.GetVersioned(latestUpgrade, keepers),
+		ecommon.HexToAddress(authz.AuthzAddress):               authz.GetVersioned(latestUpgrade, keepers),
+		ecommon.HexToAddress(feegrant.FeegrantAddress):         feegrant.GetVersioned(latestUpgrade, keepers),
+		ecommon.HexToAddress(tokenfactory.TokenfactoryAddress): tokenfactory.GetVersioned(latestUpgrade, keepers),
 	}
 }
 
@@ -119,6 +127,22 @@ func InitializePrecompiles(
 	if err != nil {
 		return err
 	}
+	aexburnp, err := aexburn.NewPrecompile(keepers)
+	if err != nil {
+		return err
+	}
+	authzp, err := authz.NewPrecompile(keepers)
+	if err != nil {
+		return err
+	}
+	feegrantp, err := feegrant.NewPrecompile(keepers)
+	if err != nil {
+		return err
+	}
+	tokenfactoryp, err := tokenfactory.NewPrecompile(keepers)
+	if err != nil {
+		return err
+	}
 
 	PrecompileNamesToInfo[bankp.GetName()] = PrecompileInfo{ABI: bankp.GetABI(), Address: bankp.Address()}
 	PrecompileNamesToInfo[wasmdp.GetName()] = PrecompileInfo{ABI: wasmdp.GetABI(), Address: wasmdp.Address()}
@@ -132,6 +156,10 @@ func InitializePrecompiles(
 	PrecompileNamesToInfo[pointerp.GetName()] = PrecompileInfo{ABI: pointerp.GetABI(), Address: pointerp.Address()}
 	PrecompileNamesToInfo[pointerviewp.GetName()] = PrecompileInfo{ABI: pointerviewp.GetABI(), Address: pointerviewp.Address()}
 	PrecompileNamesToInfo[p256p.GetName()] = PrecompileInfo{ABI: p256p.GetABI(), Address: p256p.Address()}
+	PrecompileNamesToInfo[aexburnp.GetName()] = PrecompileInfo{ABI: aexburnp.GetABI(), Address: aexburnp.Address()}
+	PrecompileNamesToInfo[authzp.GetName()] = PrecompileInfo{ABI: authzp.GetABI(), Address: authzp.Address()}
+	PrecompileNamesToInfo[feegrantp.GetName()] = PrecompileInfo{ABI: feegrantp.GetABI(), Address: feegrantp.Address()}
+	PrecompileNamesToInfo[tokenfactoryp.GetName()] = PrecompileInfo{ABI: tokenfactoryp.GetABI(), Address: tokenfactoryp.Address()}
 
 	if !dryRun {
 		addPrecompileToVM(bankp)
@@ -146,6 +174,10 @@ func InitializePrecompiles(
 		addPrecompileToVM(pointerp)
 		addPrecompileToVM(pointerviewp)
 		addPrecompileToVM(p256p)
+		addPrecompileToVM(aexburnp)
+		addPrecompileToVM(authzp)
+		addPrecompileToVM(feegrantp)
+		addPrecompileToVM(tokenfactoryp)
 		Initialized = true
 	}
 	return nil
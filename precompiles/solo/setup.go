@@ -3,6 +3,7 @@ package solo
 import (
 	"github.com/ethereum/go-ethereum/core/vm"
 	solov614 "github.com/sei-protocol/sei-chain/precompiles/solo/legacy/v614"
+	solov620 "github.com/sei-protocol/sei-chain/precompiles/solo/legacy/v620"
 	"github.com/sei-protocol/sei-chain/precompiles/utils"
 )
 
@@ -10,6 +11,7 @@ func GetVersioned(latestUpgrade string, keepers utils.Keepers) utils.VersionedPr
 	return utils.VersionedPrecompiles{
 		latestUpgrade: check(NewPrecompile(keepers)),
 		"v6.1.4":      check(solov614.NewPrecompile(keepers)),
+		"v6.2.0":      check(solov620.NewPrecompile(keepers)),
 	}
 }
 
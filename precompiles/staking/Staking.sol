@@ -93,6 +93,68 @@ interface IStaking {
         string memory valAddress
     ) external view returns (Delegation delegation);
 
+    /**
+     * @notice Get a validator
+     * @param valAddress The validator address
+     * @return validator Validator details including status and commission
+     */
+    function validator(
+        string memory valAddress
+    ) external view returns (Validator memory validator);
+
+    /**
+     * @notice List validators, one page at a time
+     * @param status Only list validators with this status (e.g. "BOND_STATUS_BONDED"),
+     *               pass empty string "" to list all validators
+     * @param pageKey Key returned by the previous page, empty for the first page
+     * @param limit Maximum number of validators to return, capped at 100.
+     *              Pass 0 for the default of 50
+     * @return validators The validators of the page
+     * @return nextKey Key of the next page, empty if this is the last one
+     */
+    function validators(
+        string memory status,
+        bytes memory pageKey,
+        uint64 limit
+    ) external view returns (Validator[] memory validators, bytes memory nextKey);
+
+    /**
+     * @notice List the unbonding delegations of a delegator, one page at a time
+     * @param delegator The delegator's address
+     * @param pageKey Key returned by the previous page, empty for the first page
+     * @param limit Maximum number of unbonding delegations to return, capped at 100.
+     *              Pass 0 for the default of 50
+     * @return unbondingDelegations Entries still unbonding from each validator
+     * @return nextKey Key of the next page, empty if this is the last one
+     */
+    function unbondingDelegations(
+        address delegator,
+        bytes memory pageKey,
+        uint64 limit
+    ) external view returns (UnbondingDelegation[] memory unbondingDelegations, bytes memory nextKey);
+
+    /**
+     * @notice List the redelegations of a delegator, one page at a time
+     * @param delegator The delegator's address
+     * @param pageKey Key returned by the previous page, empty for the first page
+     * @param limit Maximum number of redelegations to return, capped at 100.
+     *              Pass 0 for the default of 50
+     * @return redelegations Entries still maturing for each pair of validators
+     * @return nextKey Key of the next page, empty if this is the last one
+     */
+    function redelegations(
+        address delegator,
+        bytes memory pageKey,
+        uint64 limit
+    ) external view returns (Redelegation[] memory redelegations, bytes memory nextKey);
+
+    /**
+     * @notice Get the total amount of bonded and not bonded tokens
+     * @return bondedTokens Tokens delegated to bonded validators in base units
+     * @return notBondedTokens Tokens delegated to other validators or unbonding in base units
+     */
+    function pool() external view returns (uint256 bondedTokens, uint256 notBondedTokens);
+
     struct Delegation {
         Balance balance;
         DelegationDetails delegation;
@@ -109,4 +171,53 @@ interface IStaking {
         uint256 decimals;
         string validator_address;
     }
+
+    struct Validator {
+        string operatorAddress;
+        bool jailed;
+        // 1 for unbonded, 2 for unbonding and 3 for bonded
+        int32 status;
+        uint256 tokens;
+        // delegatorShares and the commission rates have 18 decimals
+        uint256 delegatorShares;
+        string moniker;
+        int64 unbondingHeight;
+        // unix time in seconds
+        int64 unbondingTime;
+        uint256 commissionRate;
+        uint256 commissionMaxRate;
+        uint256 commissionMaxChangeRate;
+        // unix time in seconds
+        int64 commissionUpdateTime;
+        uint256 minSelfDelegation;
+    }
+
+    struct UnbondingDelegation {
+        string validatorAddress;
+        UnbondingDelegationEntry[] entries;
+    }
+
+    struct UnbondingDelegationEntry {
+        int64 creationHeight;
+        // unix time in seconds
+        int64 completionTime;
+        uint256 initialBalance;
+        uint256 balance;
+    }
+
+    struct Redelegation {
+        string srcValidatorAddress;
+        string dstValidatorAddress;
+        RedelegationEntry[] entries;
+    }
+
+    struct RedelegationEntry {
+        int64 creationHeight;
+        // unix time in seconds
+        int64 completionTime;
+        uint256 initialBalance;
+        // 18 decimals
+        uint256 sharesDst;
+        uint256 balance;
+    }
 }
\ No newline at end of file
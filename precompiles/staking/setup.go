@@ -10,6 +10,7 @@ import (
 	stakingv606 "github.com/sei-protocol/sei-chain/precompiles/staking/legacy/v606"
 	stakingv610 "github.com/sei-protocol/sei-chain/precompiles/staking/legacy/v610"
 	stakingv614 "github.com/sei-protocol/sei-chain/precompiles/staking/legacy/v614"
+	stakingv620 "github.com/sei-protocol/sei-chain/precompiles/staking/legacy/v620"
 	"github.com/sei-protocol/sei-chain/precompiles/utils"
 )
 
@@ -24,6 +25,7 @@ func GetVersioned(latestUpgrade string, keepers utils.Keepers) utils.VersionedPr
 		"v6.0.6":      check(stakingv606.NewPrecompile(keepers)),
 		"v6.1.0":      check(stakingv610.NewPrecompile(keepers)),
 		"v6.1.4":      check(stakingv614.NewPrecompile(keepers)),
+		"v6.2.0":      check(stakingv620.NewPrecompile(keepers)),
 	}
 }
 
@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
@@ -20,12 +21,26 @@ import (
 )
 
 const (
-	DelegateMethod        = "delegate"
-	RedelegateMethod      = "redelegate"
-	UndelegateMethod      = "undelegate"
-	DelegationMethod      = "delegation"
-	CreateValidatorMethod = "createValidator"
-	EditValidatorMethod   = "editValidator"
+	DelegateMethod             = "delegate"
+	RedelegateMethod           = "redelegate"
+	UndelegateMethod           = "undelegate"
+	DelegationMethod           = "delegation"
+	CreateValidatorMethod      = "createValidator"
+	EditValidatorMethod        = "editValidator"
+	ValidatorMethod            = "validator"
+	ValidatorsMethod           = "validators"
+	UnbondingDelegationsMethod = "unbondingDelegations"
+	RedelegationsMethod        = "redelegations"
+	PoolMethod                 = "pool"
+)
+
+const (
+	// DefaultPageLimit is the number of entries returned by paginated queries called with a zero limit
+	DefaultPageLimit uint64 = 50
+	// MaxPageLimit is the most entries paginated queries return in a single call
+	MaxPageLimit uint64 = 100
+	// PageEntryGas is charged for every entry a paginated query may return on top of the base gas
+	PageEntryGas uint64 = 2000
 )
 
 const (
@@ -44,12 +59,17 @@ type PrecompileExecutor struct {
 	bankKeeper     utils.BankKeeper
 	address        common.Address
 
-	DelegateID        []byte
-	RedelegateID      []byte
-	UndelegateID      []byte
-	DelegationID      []byte
-	CreateValidatorID []byte
-	EditValidatorID   []byte
+	DelegateID             []byte
+	RedelegateID           []byte
+	UndelegateID           []byte
+	DelegationID           []byte
+	CreateValidatorID      []byte
+	EditValidatorID        []byte
+	ValidatorID            []byte
+	ValidatorsID           []byte
+	UnbondingDelegationsID []byte
+	RedelegationsID        []byte
+	PoolID                 []byte
 }
 
 func NewPrecompile(keepers utils.Keepers) (*pcommon.Precompile, error) {
@@ -77,6 +97,16 @@ func NewPrecompile(keepers utils.Keepers) (*pcommon.Precompile, error) {
 			p.CreateValidatorID = m.ID
 		case EditValidatorMethod:
 			p.EditValidatorID = m.ID
+		case ValidatorMethod:
+			p.ValidatorID = m.ID
+		case ValidatorsMethod:
+			p.ValidatorsID = m.ID
+		case UnbondingDelegationsMethod:
+			p.UnbondingDelegationsID = m.ID
+		case RedelegationsMethod:
+			p.RedelegationsID = m.ID
+		case PoolMethod:
+			p.PoolID = m.ID
 		}
 	}
 
@@ -95,6 +125,17 @@ func (p PrecompileExecutor) RequiredGas(input []byte, method *abi.Method) uint64
 		return 100000
 	} else if bytes.Equal(method.ID, p.EditValidatorID) {
 		return 100000
+	} else if bytes.Equal(method.ID, p.ValidatorsID) || bytes.Equal(method.ID, p.UnbondingDelegationsID) || bytes.Equal(method.ID, p.RedelegationsID) {
+		// paginated queries are charged for the most entries they may return
+		args, err := method.Inputs.Unpack(input)
+		if err != nil || len(args) == 0 {
+			return pcommon.UnknownMethodCallGas
+		}
+		limit, ok := args[len(args)-1].(uint64)
+		if !ok {
+			return pcommon.UnknownMethodCallGas
+		}
+		return pcommon.UnknownMethodCallGas + PageEntryGas*pageLimit(limit)
 	}
 
 	// This should never happen since this is going to fail during Run
@@ -133,6 +174,16 @@ func (p PrecompileExecutor) Execute(ctx sdk.Context, method *abi.Method, caller
 		return p.editValidator(ctx, method, caller, args, value, hooks, evm)
 	case DelegationMethod:
 		return p.delegation(ctx, method, args, value)
+	case ValidatorMethod:
+		return p.validator(ctx, method, args, value)
+	case ValidatorsMethod:
+		return p.validators(ctx, method, args, value)
+	case UnbondingDelegationsMethod:
+		return p.unbondingDelegations(ctx, method, args, value)
+	case RedelegationsMethod:
+		return p.redelegations(ctx, method, args, value)
+	case PoolMethod:
+		return p.pool(ctx, method, args, value)
 	}
 	return
 }
@@ -424,3 +475,214 @@ func (p PrecompileExecutor) editValidator(ctx sdk.Context, method *abi.Method, c
 
 	return method.Outputs.Pack(true)
 }
+
+type Validator struct {
+	OperatorAddress string
+	Jailed          bool
+	Status          int32
+	Tokens          *big.Int
+	// DelegatorShares and the commission rates have 18 decimals
+	DelegatorShares         *big.Int
+	Moniker                 string
+	UnbondingHeight         int64
+	UnbondingTime           int64
+	CommissionRate          *big.Int
+	CommissionMaxRate       *big.Int
+	CommissionMaxChangeRate *big.Int
+	CommissionUpdateTime    int64
+	MinSelfDelegation       *big.Int
+}
+
+type UnbondingDelegation struct {
+	ValidatorAddress string
+	Entries          []UnbondingDelegationEntry
+}
+
+type UnbondingDelegationEntry struct {
+	CreationHeight int64
+	CompletionTime int64
+	InitialBalance *big.Int
+	Balance        *big.Int
+}
+
+type Redelegation struct {
+	SrcValidatorAddress string
+	DstValidatorAddress string
+	Entries             []RedelegationEntry
+}
+
+type RedelegationEntry struct {
+	CreationHeight int64
+	CompletionTime int64
+	InitialBalance *big.Int
+	SharesDst      *big.Int
+	Balance        *big.Int
+}
+
+func (p PrecompileExecutor) validator(ctx sdk.Context, method *abi.Method, args []interface{}, value *big.Int) ([]byte, error) {
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		return nil, err
+	}
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		return nil, err
+	}
+	res, err := p.stakingQuerier.Validator(sdk.WrapSDKContext(ctx), &stakingtypes.QueryValidatorRequest{
+		ValidatorAddr: args[0].(string),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(toValidator(res.Validator))
+}
+
+func (p PrecompileExecutor) validators(ctx sdk.Context, method *abi.Method, args []interface{}, value *big.Int) ([]byte, error) {
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		return nil, err
+	}
+	if err := pcommon.ValidateArgsLength(args, 3); err != nil {
+		return nil, err
+	}
+	res, err := p.stakingQuerier.Validators(sdk.WrapSDKContext(ctx), &stakingtypes.QueryValidatorsRequest{
+		Status:     args[0].(string),
+		Pagination: pageRequest(args[1].([]byte), args[2].(uint64)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	validators := make([]Validator, 0, len(res.Validators))
+	for _, val := range res.Validators {
+		validators = append(validators, toValidator(val))
+	}
+	return method.Outputs.Pack(validators, nextKey(res.Pagination))
+}
+
+func (p PrecompileExecutor) unbondingDelegations(ctx sdk.Context, method *abi.Method, args []interface{}, value *big.Int) ([]byte, error) {
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		return nil, err
+	}
+	if err := pcommon.ValidateArgsLength(args, 3); err != nil {
+		return nil, err
+	}
+	seiDelegatorAddress, err := pcommon.GetSeiAddressFromArg(ctx, args[0], p.evmKeeper)
+	if err != nil {
+		return nil, err
+	}
+	res, err := p.stakingQuerier.DelegatorUnbondingDelegations(sdk.WrapSDKContext(ctx), &stakingtypes.QueryDelegatorUnbondingDelegationsRequest{
+		DelegatorAddr: seiDelegatorAddress.String(),
+		Pagination:    pageRequest(args[1].([]byte), args[2].(uint64)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	ubds := make([]UnbondingDelegation, 0, len(res.UnbondingResponses))
+	for _, ubd := range res.UnbondingResponses {
+		entries := make([]UnbondingDelegationEntry, 0, len(ubd.Entries))
+		for _, entry := range ubd.Entries {
+			entries = append(entries, UnbondingDelegationEntry{
+				CreationHeight: entry.CreationHeight,
+				CompletionTime: entry.CompletionTime.Unix(),
+				InitialBalance: entry.InitialBalance.BigInt(),
+				Balance:        entry.Balance.BigInt(),
+			})
+		}
+		ubds = append(ubds, UnbondingDelegation{
+			ValidatorAddress: ubd.ValidatorAddress,
+			Entries:          entries,
+		})
+	}
+	return method.Outputs.Pack(ubds, nextKey(res.Pagination))
+}
+
+func (p PrecompileExecutor) redelegations(ctx sdk.Context, method *abi.Method, args []interface{}, value *big.Int) ([]byte, error) {
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		return nil, err
+	}
+	if err := pcommon.ValidateArgsLength(args, 3); err != nil {
+		return nil, err
+	}
+	seiDelegatorAddress, err := pcommon.GetSeiAddressFromArg(ctx, args[0], p.evmKeeper)
+	if err != nil {
+		return nil, err
+	}
+	res, err := p.stakingQuerier.Redelegations(sdk.WrapSDKContext(ctx), &stakingtypes.QueryRedelegationsRequest{
+		DelegatorAddr: seiDelegatorAddress.String(),
+		Pagination:    pageRequest(args[1].([]byte), args[2].(uint64)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	reds := make([]Redelegation, 0, len(res.RedelegationResponses))
+	for _, red := range res.RedelegationResponses {
+		entries := make([]RedelegationEntry, 0, len(red.Entries))
+		for _, entry := range red.Entries {
+			entries = append(entries, RedelegationEntry{
+				CreationHeight: entry.RedelegationEntry.CreationHeight,
+				CompletionTime: entry.RedelegationEntry.CompletionTime.Unix(),
+				InitialBalance: entry.RedelegationEntry.InitialBalance.BigInt(),
+				SharesDst:      entry.RedelegationEntry.SharesDst.BigInt(),
+				Balance:        entry.Balance.BigInt(),
+			})
+		}
+		reds = append(reds, Redelegation{
+			SrcValidatorAddress: red.Redelegation.ValidatorSrcAddress,
+			DstValidatorAddress: red.Redelegation.ValidatorDstAddress,
+			Entries:             entries,
+		})
+	}
+	return method.Outputs.Pack(reds, nextKey(res.Pagination))
+}
+
+func (p PrecompileExecutor) pool(ctx sdk.Context, method *abi.Method, args []interface{}, value *big.Int) ([]byte, error) {
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		return nil, err
+	}
+	if err := pcommon.ValidateArgsLength(args, 0); err != nil {
+		return nil, err
+	}
+	res, err := p.stakingQuerier.Pool(sdk.WrapSDKContext(ctx), &stakingtypes.QueryPoolRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(res.Pool.BondedTokens.BigInt(), res.Pool.NotBondedTokens.BigInt())
+}
+
+func toValidator(val stakingtypes.Validator) Validator {
+	return Validator{
+		OperatorAddress:         val.OperatorAddress,
+		Jailed:                  val.Jailed,
+		Status:                  int32(val.Status),
+		Tokens:                  val.Tokens.BigInt(),
+		DelegatorShares:         val.DelegatorShares.BigInt(),
+		Moniker:                 val.Description.Moniker,
+		UnbondingHeight:         val.UnbondingHeight,
+		UnbondingTime:           val.UnbondingTime.Unix(),
+		CommissionRate:          val.Commission.Rate.BigInt(),
+		CommissionMaxRate:       val.Commission.MaxRate.BigInt(),
+		CommissionMaxChangeRate: val.Commission.MaxChangeRate.BigInt(),
+		CommissionUpdateTime:    val.Commission.UpdateTime.Unix(),
+		MinSelfDelegation:       val.MinSelfDelegation.BigInt(),
+	}
+}
+
+// pageLimit returns the number of entries a paginated query called with the given limit returns at most
+func pageLimit(limit uint64) uint64 {
+	if limit == 0 {
+		return DefaultPageLimit
+	}
+	if limit > MaxPageLimit {
+		return MaxPageLimit
+	}
+	return limit
+}
+
+func pageRequest(key []byte, limit uint64) *query.PageRequest {
+	return &query.PageRequest{Key: key, Limit: pageLimit(limit)}
+}
+
+// nextKey returns the key to pass to get the next page, which is empty once there are no more entries
+func nextKey(res *query.PageResponse) []byte {
+	if res == nil || res.NextKey == nil {
+		return []byte{}
+	}
+	return res.NextKey
+}
@@ -262,6 +262,7 @@ func setupValidator(t *testing.T, ctx sdk.Context, a *app.App, bondStatus stakin
 }
 
 type TestStakingQuerier struct {
+	utils.StakingQuerier
 	Response *stakingtypes.QueryDelegationResponse
 	Err      error
 }
@@ -926,3 +927,140 @@ func TestEditValidator(t *testing.T) {
 	require.Equal(t, validator.OperatorAddress, updatedValidator.OperatorAddress, "Operator address should remain the same")
 	require.Equal(t, validator.ConsensusPubkey, updatedValidator.ConsensusPubkey, "Consensus pubkey should remain the same")
 }
+
+type redelegationsQuerier struct {
+	utils.StakingQuerier
+	response *stakingtypes.QueryRedelegationsResponse
+}
+
+func (q *redelegationsQuerier) Redelegations(c context.Context, _ *stakingtypes.QueryRedelegationsRequest) (*stakingtypes.QueryRedelegationsResponse, error) {
+	return q.response, nil
+}
+
+func TestStakingQueries(t *testing.T) {
+	testApp := testkeeper.EVMTestApp
+	ctx := testApp.NewContext(false, tmtypes.Header{}).WithBlockHeight(2)
+	k := &testApp.EvmKeeper
+	val := setupValidator(t, ctx, testApp, stakingtypes.Unbonded, secp256k1.GenPrivKey().PubKey())
+	val2 := setupValidator(t, ctx, testApp, stakingtypes.Unbonded, secp256k1.GenPrivKey().PubKey())
+	seiAddr, evmAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, seiAddr, evmAddr)
+	bondDenom := testApp.StakingKeeper.GetParams(ctx).BondDenom
+	amt := sdk.NewCoins(sdk.NewCoin(bondDenom, sdk.NewInt(100)))
+	require.Nil(t, testApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, amt))
+	require.Nil(t, testApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, seiAddr, amt))
+	validator, found := testApp.StakingKeeper.GetValidator(ctx, val)
+	require.True(t, found)
+	_, err := testApp.StakingKeeper.Delegate(ctx, seiAddr, sdk.NewInt(100), stakingtypes.Unbonded, validator, true)
+	require.Nil(t, err)
+	_, err = testApp.StakingKeeper.Undelegate(ctx, seiAddr, val, sdk.NewDec(30))
+	require.Nil(t, err)
+
+	p, err := staking.NewPrecompile(app.NewPrecompileKeepers(testApp))
+	require.Nil(t, err)
+	evm := vm.EVM{StateDB: state.NewDBImpl(ctx, k, true), TxContext: vm.TxContext{Origin: evmAddr}}
+	call := func(method string, args ...interface{}) []interface{} {
+		input, err := p.ABI.Pack(method, args...)
+		require.Nil(t, err)
+		ret, err := p.Run(&evm, evmAddr, evmAddr, input, nil, true, false, nil)
+		require.Nil(t, err, string(ret))
+		out, err := p.ABI.Unpack(method, ret)
+		require.Nil(t, err)
+		return out
+	}
+
+	// validator
+	out := call(staking.ValidatorMethod, val.String())
+	got := *abi.ConvertType(out[0], new(staking.Validator)).(*staking.Validator)
+	require.Equal(t, val.String(), got.OperatorAddress)
+	require.Equal(t, int32(stakingtypes.Unbonded), got.Status)
+	require.False(t, got.Jailed)
+	require.Equal(t, big.NewInt(170), got.Tokens)
+	require.Equal(t, validator.Commission.Rate.BigInt(), got.CommissionRate)
+	require.Equal(t, validator.Description.Moniker, got.Moniker)
+
+	// validators, one page at a time
+	var operators []string
+	pageKey := []byte{}
+	for {
+		out = call(staking.ValidatorsMethod, stakingtypes.Unbonded.String(), pageKey, uint64(1))
+		page := *abi.ConvertType(out[0], new([]staking.Validator)).(*[]staking.Validator)
+		require.Len(t, page, 1)
+		operators = append(operators, page[0].OperatorAddress)
+		pageKey = out[1].([]byte)
+		if len(pageKey) == 0 {
+			break
+		}
+	}
+	require.Contains(t, operators, val.String())
+	require.Contains(t, operators, val2.String())
+
+	// unbonding delegations
+	out = call(staking.UnbondingDelegationsMethod, evmAddr, []byte{}, uint64(0))
+	ubds := *abi.ConvertType(out[0], new([]staking.UnbondingDelegation)).(*[]staking.UnbondingDelegation)
+	require.Len(t, ubds, 1)
+	require.Equal(t, val.String(), ubds[0].ValidatorAddress)
+	require.Len(t, ubds[0].Entries, 1)
+	require.Equal(t, big.NewInt(30), ubds[0].Entries[0].Balance)
+	require.Equal(t, ctx.BlockTime().Add(testApp.StakingKeeper.UnbondingTime(ctx)).Unix(), ubds[0].Entries[0].CompletionTime)
+	require.Empty(t, out[1].([]byte))
+
+	// pool
+	out = call(staking.PoolMethod)
+	require.Zero(t, testApp.StakingKeeper.TotalBondedTokens(ctx).BigInt().Cmp(out[0].(*big.Int)))
+	notBonded := testApp.BankKeeper.GetBalance(ctx, testApp.StakingKeeper.GetNotBondedPool(ctx).GetAddress(), bondDenom)
+	require.Zero(t, notBonded.Amount.BigInt().Cmp(out[1].(*big.Int)))
+	require.Positive(t, notBonded.Amount.Int64())
+
+	// redelegations
+	completion := time.Unix(1700000000, 0)
+	p, err = staking.NewPrecompile(&app.PrecompileKeepers{
+		StakingQuerier: &redelegationsQuerier{response: &stakingtypes.QueryRedelegationsResponse{
+			RedelegationResponses: []stakingtypes.RedelegationResponse{{
+				Redelegation: stakingtypes.Redelegation{ValidatorSrcAddress: val.String(), ValidatorDstAddress: val2.String()},
+				Entries: []stakingtypes.RedelegationEntryResponse{{
+					RedelegationEntry: stakingtypes.RedelegationEntry{CreationHeight: 2, CompletionTime: completion, InitialBalance: sdk.NewInt(10), SharesDst: sdk.NewDec(10)},
+					Balance:           sdk.NewInt(10),
+				}},
+			}},
+		}},
+		EVMKeeper: k,
+	})
+	require.Nil(t, err)
+	out = call(staking.RedelegationsMethod, evmAddr, []byte{}, uint64(0))
+	reds := *abi.ConvertType(out[0], new([]staking.Redelegation)).(*[]staking.Redelegation)
+	require.Equal(t, []staking.Redelegation{{
+		SrcValidatorAddress: val.String(),
+		DstValidatorAddress: val2.String(),
+		Entries: []staking.RedelegationEntry{{
+			CreationHeight: 2,
+			CompletionTime: completion.Unix(),
+			InitialBalance: big.NewInt(10),
+			SharesDst:      sdk.NewDec(10).BigInt(),
+			Balance:        big.NewInt(10),
+		}},
+	}}, reds)
+
+	// queries can't be paid for
+	input, err := p.ABI.Pack(staking.PoolMethod)
+	require.Nil(t, err)
+	_, err = p.Run(&evm, evmAddr, evmAddr, input, big.NewInt(1), false, false, nil)
+	require.Equal(t, vm.ErrExecutionReverted, err)
+}
+
+func TestPaginatedQueriesGas(t *testing.T) {
+	p, err := staking.NewPrecompile(&utils.EmptyKeepers{})
+	require.Nil(t, err)
+	for _, tc := range []struct {
+		limit uint64
+		gas   uint64
+	}{
+		{0, pcommon.UnknownMethodCallGas + staking.DefaultPageLimit*staking.PageEntryGas},
+		{10, pcommon.UnknownMethodCallGas + 10*staking.PageEntryGas},
+		{1000, pcommon.UnknownMethodCallGas + staking.MaxPageLimit*staking.PageEntryGas},
+	} {
+		input, err := p.ABI.Pack(staking.ValidatorsMethod, "", []byte{}, tc.limit)
+		require.Nil(t, err)
+		require.Equal(t, tc.gas, p.RequiredGas(input))
+	}
+}
@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+address constant TOKENFACTORY_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000001010;
+
+ITokenfactory constant TOKENFACTORY_CONTRACT = ITokenfactory(
+    TOKENFACTORY_PRECOMPILE_ADDRESS
+);
+
+struct DenomUnit {
+    string denom;
+    uint32 exponent;
+    string[] aliases;
+}
+
+struct Metadata {
+    string description;
+    DenomUnit[] denomUnits;
+    string base;     // Must be the tokenfactory denom
+    string display;
+    string name;
+    string symbol;
+}
+
+/**
+ * @dev Interface for creating and administering x/tokenfactory denoms ("factory/{creator}/{subdenom}").
+ * The caller is the sender of every transaction and must be associated with a Sei address.
+ * Transactions revert when called through staticcall; nothing can be called through delegatecall.
+ * Allow-list addresses that are not associated are stored as their casted Sei address,
+ * the same address native tokens sent to them end up in.
+ */
+interface ITokenfactory {
+    // Transactions
+    /**
+     * @dev Creates "factory/{caller}/{subdenom}" with the caller as admin.
+     * @param allowList Addresses allowed to hold and transfer the denom, empty for no restriction.
+     * @return denom The full denom.
+     */
+    function createDenom(
+        string memory subdenom,
+        address[] memory allowList
+    ) external returns (string memory denom);
+
+    /**
+     * @dev Replaces the allow-list of a denom administered by the caller, empty to lift the restriction.
+     */
+    function updateDenom(
+        string memory denom,
+        address[] memory allowList
+    ) external returns (bool success);
+
+    /**
+     * @dev Mints `amount` of a denom administered by the caller to the caller.
+     */
+    function mint(
+        string memory denom,
+        uint256 amount
+    ) external returns (bool success);
+
+    /**
+     * @dev Burns `amount` of a denom administered by the caller from the caller's balance.
+     */
+    function burn(
+        string memory denom,
+        uint256 amount
+    ) external returns (bool success);
+
+    /**
+     * @dev Hands the admin role of a denom over to `newAdmin`, which must be associated.
+     */
+    function changeAdmin(
+        string memory denom,
+        address newAdmin
+    ) external returns (bool success);
+
+    /**
+     * @dev Sets the bank metadata of a denom administered by the caller.
+     * @param registerPointer Also deploy the ERC20 pointer of the denom, or redeploy it if it
+     * exists, with the name, symbol and decimals derived from the metadata the same way
+     * addNativePointer on the pointer precompile derives them.
+     * @return pointer The ERC20 pointer, or the zero address if none was registered.
+     */
+    function setDenomMetadata(
+        Metadata memory metadata,
+        bool registerPointer
+    ) external returns (address pointer);
+
+    // Queries
+    /**
+     * @dev Denoms created by `creator`, which must be associated.
+     */
+    function denomsFromCreator(
+        address creator
+    ) external view returns (string[] memory denoms);
+
+    /**
+     * @dev Admin of a tokenfactory denom.
+     * @return admin EVM address of the admin, casted if it is not associated.
+     * @return seiAdmin Sei address of the admin.
+     */
+    function authorityMetadata(
+        string memory denom
+    ) external view returns (address admin, string memory seiAdmin);
+
+    /**
+     * @dev Addresses allowed to hold and transfer the denom, empty if unrestricted.
+     */
+    function denomAllowList(
+        string memory denom
+    ) external view returns (address[] memory allowList);
+}
@@ -0,0 +1,19 @@
+package tokenfactory
+
+import (
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/sei-protocol/sei-chain/precompiles/utils"
+)
+
+func GetVersioned(latestUpgrade string, keepers utils.Keepers) utils.VersionedPrecompiles {
+	return utils.VersionedPrecompiles{
+		latestUpgrade: check(NewPrecompile(keepers)),
+	}
+}
+
+func check(p vm.PrecompiledContract, err error) vm.PrecompiledContract {
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
@@ -0,0 +1,419 @@
+package tokenfactory
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/vm"
+	pcommon "github.com/sei-protocol/sei-chain/precompiles/common"
+	putils "github.com/sei-protocol/sei-chain/precompiles/utils"
+	"github.com/sei-protocol/sei-chain/utils"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+	tokenfactorytypes "github.com/sei-protocol/sei-chain/x/tokenfactory/types"
+)
+
+const (
+	CreateDenomMethod       = "createDenom"
+	UpdateDenomMethod       = "updateDenom"
+	MintMethod              = "mint"
+	BurnMethod              = "burn"
+	ChangeAdminMethod       = "changeAdmin"
+	SetDenomMetadataMethod  = "setDenomMetadata"
+	DenomsFromCreatorMethod = "denomsFromCreator"
+	AuthorityMetadataMethod = "authorityMetadata"
+	DenomAllowListMethod    = "denomAllowList"
+)
+
+const (
+	TokenfactoryAddress = "0x0000000000000000000000000000000000001010"
+)
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+type PrecompileExecutor struct {
+	evmKeeper             putils.EVMKeeper
+	tokenfactoryMsgServer putils.TokenfactoryMsgServer
+	tokenfactoryQuerier   putils.TokenfactoryQuerier
+
+	CreateDenomID       []byte
+	UpdateDenomID       []byte
+	MintID              []byte
+	BurnID              []byte
+	ChangeAdminID       []byte
+	SetDenomMetadataID  []byte
+	DenomsFromCreatorID []byte
+	AuthorityMetadataID []byte
+	DenomAllowListID    []byte
+}
+
+// DenomUnit and Metadata mirror banktypes.DenomUnit and banktypes.Metadata
+type DenomUnit struct {
+	Denom    string   `json:"denom"`
+	Exponent uint32   `json:"exponent"`
+	Aliases  []string `json:"aliases"`
+}
+
+type Metadata struct {
+	Description string      `json:"description"`
+	DenomUnits  []DenomUnit `json:"denomUnits"`
+	Base        string      `json:"base"`
+	Display     string      `json:"display"`
+	Name        string      `json:"name"`
+	Symbol      string      `json:"symbol"`
+}
+
+func NewPrecompile(keepers putils.Keepers) (*pcommon.DynamicGasPrecompile, error) {
+	newAbi := pcommon.MustGetABI(f, "abi.json")
+
+	p := &PrecompileExecutor{
+		evmKeeper:             keepers.EVMK(),
+		tokenfactoryMsgServer: keepers.TokenfactoryMS(),
+		tokenfactoryQuerier:   keepers.TokenfactoryQ(),
+	}
+
+	for name, m := range newAbi.Methods {
+		switch name {
+		case CreateDenomMethod:
+			p.CreateDenomID = m.ID
+		case UpdateDenomMethod:
+			p.UpdateDenomID = m.ID
+		case MintMethod:
+			p.MintID = m.ID
+		case BurnMethod:
+			p.BurnID = m.ID
+		case ChangeAdminMethod:
+			p.ChangeAdminID = m.ID
+		case SetDenomMetadataMethod:
+			p.SetDenomMetadataID = m.ID
+		case DenomsFromCreatorMethod:
+			p.DenomsFromCreatorID = m.ID
+		case AuthorityMetadataMethod:
+			p.AuthorityMetadataID = m.ID
+		case DenomAllowListMethod:
+			p.DenomAllowListID = m.ID
+		}
+	}
+
+	return pcommon.NewDynamicGasPrecompile(newAbi, p, common.HexToAddress(TokenfactoryAddress), "tokenfactory"), nil
+}
+
+// RequiredGas returns the required bare minimum gas to execute the precompile.
+func (p PrecompileExecutor) RequiredGas(input []byte, method *abi.Method) uint64 {
+	return pcommon.DefaultGasCost(input, p.IsTransaction(method.Name))
+}
+
+func (p PrecompileExecutor) Execute(ctx sdk.Context, method *abi.Method, caller common.Address, callingContract common.Address, args []interface{}, value *big.Int, readOnly bool, evm *vm.EVM, suppliedGas uint64, hooks *tracing.Hooks) (bz []byte, remainingGas uint64, err error) {
+	// Needed to catch gas meter panics
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("execution reverted: %v", r)
+		}
+	}()
+	// denoms are always administered by the immediate caller
+	if ctx.EVMPrecompileCalledFromDelegateCall() {
+		return nil, 0, errors.New("cannot delegatecall tokenfactory")
+	}
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		return nil, 0, err
+	}
+	if readOnly && p.IsTransaction(method.Name) {
+		return nil, 0, errors.New("cannot call tokenfactory precompile from staticcall")
+	}
+
+	switch method.Name {
+	case CreateDenomMethod:
+		return p.createDenom(ctx, method, caller, args)
+	case UpdateDenomMethod:
+		return p.updateDenom(ctx, method, caller, args)
+	case MintMethod:
+		return p.mint(ctx, method, caller, args)
+	case BurnMethod:
+		return p.burn(ctx, method, caller, args)
+	case ChangeAdminMethod:
+		return p.changeAdmin(ctx, method, caller, args)
+	case SetDenomMetadataMethod:
+		return p.setDenomMetadata(ctx, method, caller, args, evm)
+	case DenomsFromCreatorMethod:
+		return p.denomsFromCreator(ctx, method, args)
+	case AuthorityMetadataMethod:
+		return p.authorityMetadata(ctx, method, args)
+	case DenomAllowListMethod:
+		return p.denomAllowList(ctx, method, args)
+	}
+	return
+}
+
+func (p PrecompileExecutor) createDenom(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}) ([]byte, uint64, error) {
+	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+		return nil, 0, err
+	}
+	sender, err := p.sender(ctx, caller)
+	if err != nil {
+		return nil, 0, err
+	}
+	msg := tokenfactorytypes.NewMsgCreateDenom(sender.String(), args[0].(string))
+	// an empty allow list leaves the denom unrestricted
+	if allowList := args[1].([]common.Address); len(allowList) > 0 {
+		msg.AllowList = p.allowListFromArg(ctx, allowList)
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, 0, err
+	}
+	res, err := p.tokenfactoryMsgServer.CreateDenom(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, 0, err
+	}
+	bz, err := method.Outputs.Pack(res.NewTokenDenom)
+	return bz, pcommon.GetRemainingGas(ctx, p.evmKeeper), err
+}
+
+func (p PrecompileExecutor) updateDenom(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}) ([]byte, uint64, error) {
+	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+		return nil, 0, err
+	}
+	sender, err := p.sender(ctx, caller)
+	if err != nil {
+		return nil, 0, err
+	}
+	msg := tokenfactorytypes.NewMsgUpdateDenom(sender.String(), args[0].(string), p.allowListFromArg(ctx, args[1].([]common.Address)))
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, 0, err
+	}
+	if _, err := p.tokenfactoryMsgServer.UpdateDenom(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, 0, err
+	}
+	bz, err := method.Outputs.Pack(true)
+	return bz, pcommon.GetRemainingGas(ctx, p.evmKeeper), err
+}
+
+func (p PrecompileExecutor) mint(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}) ([]byte, uint64, error) {
+	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+		return nil, 0, err
+	}
+	sender, err := p.sender(ctx, caller)
+	if err != nil {
+		return nil, 0, err
+	}
+	amount, err := coinFromArgs(args[0], args[1])
+	if err != nil {
+		return nil, 0, err
+	}
+	msg := tokenfactorytypes.NewMsgMint(sender.String(), amount)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, 0, err
+	}
+	if _, err := p.tokenfactoryMsgServer.Mint(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, 0, err
+	}
+	bz, err := method.Outputs.Pack(true)
+	return bz, pcommon.GetRemainingGas(ctx, p.evmKeeper), err
+}
+
+func (p PrecompileExecutor) burn(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}) ([]byte, uint64, error) {
+	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+		return nil, 0, err
+	}
+	sender, err := p.sender(ctx, caller)
+	if err != nil {
+		return nil, 0, err
+	}
+	amount, err := coinFromArgs(args[0], args[1])
+	if err != nil {
+		return nil, 0, err
+	}
+	msg := tokenfactorytypes.NewMsgBurn(sender.String(), amount)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, 0, err
+	}
+	if _, err := p.tokenfactoryMsgServer.Burn(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, 0, err
+	}
+	bz, err := method.Outputs.Pack(true)
+	return bz, pcommon.GetRemainingGas(ctx, p.evmKeeper), err
+}
+
+func (p PrecompileExecutor) changeAdmin(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}) ([]byte, uint64, error) {
+	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+		return nil, 0, err
+	}
+	sender, err := p.sender(ctx, caller)
+	if err != nil {
+		return nil, 0, err
+	}
+	// the new admin must be associated so that it can call back into this precompile
+	newAdmin, err := pcommon.GetSeiAddressFromArg(ctx, args[1], p.evmKeeper)
+	if err != nil {
+		return nil, 0, err
+	}
+	msg := tokenfactorytypes.NewMsgChangeAdmin(sender.String(), args[0].(string), newAdmin.String())
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, 0, err
+	}
+	if _, err := p.tokenfactoryMsgServer.ChangeAdmin(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, 0, err
+	}
+	bz, err := method.Outputs.Pack(true)
+	return bz, pcommon.GetRemainingGas(ctx, p.evmKeeper), err
+}
+
+func (p PrecompileExecutor) setDenomMetadata(ctx sdk.Context, method *abi.Method, caller common.Address, args []interface{}, evm *vm.EVM) ([]byte, uint64, error) {
+	if err := pcommon.ValidateArgsLength(args, 2); err != nil {
+		return nil, 0, err
+	}
+	sender, err := p.sender(ctx, caller)
+	if err != nil {
+		return nil, 0, err
+	}
+	metadata := abi.ConvertType(args[0], new(Metadata)).(*Metadata)
+	denomUnits := make([]*banktypes.DenomUnit, 0, len(metadata.DenomUnits))
+	for _, unit := range metadata.DenomUnits {
+		denomUnits = append(denomUnits, &banktypes.DenomUnit{
+			Denom:    unit.Denom,
+			Exponent: unit.Exponent,
+			Aliases:  unit.Aliases,
+		})
+	}
+	msg := tokenfactorytypes.NewMsgSetDenomMetadata(sender.String(), banktypes.Metadata{
+		Description: metadata.Description,
+		DenomUnits:  denomUnits,
+		Base:        metadata.Base,
+		Display:     metadata.Display,
+		Name:        metadata.Name,
+		Symbol:      metadata.Symbol,
+	})
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, 0, err
+	}
+	if _, err := p.tokenfactoryMsgServer.SetDenomMetadata(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, 0, err
+	}
+	var pointer common.Address
+	if args[1].(bool) {
+		// the pointer's name, symbol and decimals are fixed when it is deployed, so it is
+		// (re)deployed together with the metadata they are derived from
+		pointer, err = p.evmKeeper.UpsertERCNativePointer(ctx, evm, msg.Metadata.Base, utils.ERCMetadataFromDenomMetadata(msg.Metadata))
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+	bz, err := method.Outputs.Pack(pointer)
+	return bz, pcommon.GetRemainingGas(ctx, p.evmKeeper), err
+}
+
+func (p PrecompileExecutor) denomsFromCreator(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, uint64, error) {
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		return nil, 0, err
+	}
+	creator, err := pcommon.GetSeiAddressFromArg(ctx, args[0], p.evmKeeper)
+	if err != nil {
+		return nil, 0, err
+	}
+	res, err := p.tokenfactoryQuerier.DenomsFromCreator(sdk.WrapSDKContext(ctx), &tokenfactorytypes.QueryDenomsFromCreatorRequest{Creator: creator.String()})
+	if err != nil {
+		return nil, 0, err
+	}
+	denoms := res.Denoms
+	if denoms == nil {
+		denoms = []string{}
+	}
+	bz, err := method.Outputs.Pack(denoms)
+	return bz, pcommon.GetRemainingGas(ctx, p.evmKeeper), err
+}
+
+func (p PrecompileExecutor) authorityMetadata(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, uint64, error) {
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		return nil, 0, err
+	}
+	res, err := p.tokenfactoryQuerier.DenomAuthorityMetadata(sdk.WrapSDKContext(ctx), &tokenfactorytypes.QueryDenomAuthorityMetadataRequest{Denom: args[0].(string)})
+	if err != nil {
+		return nil, 0, err
+	}
+	var adminEVMAddr common.Address
+	if admin := res.AuthorityMetadata.Admin; admin != "" {
+		adminSeiAddr, err := sdk.AccAddressFromBech32(admin)
+		if err != nil {
+			return nil, 0, err
+		}
+		adminEVMAddr = p.evmKeeper.GetEVMAddressOrDefault(ctx, adminSeiAddr)
+	}
+	bz, err := method.Outputs.Pack(adminEVMAddr, res.AuthorityMetadata.Admin)
+	return bz, pcommon.GetRemainingGas(ctx, p.evmKeeper), err
+}
+
+func (p PrecompileExecutor) denomAllowList(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, uint64, error) {
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		return nil, 0, err
+	}
+	res, err := p.tokenfactoryQuerier.DenomAllowList(sdk.WrapSDKContext(ctx), &tokenfactorytypes.QueryDenomAllowListRequest{Denom: args[0].(string)})
+	if err != nil {
+		return nil, 0, err
+	}
+	addresses := make([]common.Address, 0, len(res.AllowList.Addresses))
+	for _, addr := range res.AllowList.Addresses {
+		seiAddr, err := sdk.AccAddressFromBech32(addr)
+		if err != nil {
+			return nil, 0, err
+		}
+		addresses = append(addresses, p.evmKeeper.GetEVMAddressOrDefault(ctx, seiAddr))
+	}
+	bz, err := method.Outputs.Pack(addresses)
+	return bz, pcommon.GetRemainingGas(ctx, p.evmKeeper), err
+}
+
+func (p PrecompileExecutor) EVMKeeper() putils.EVMKeeper {
+	return p.evmKeeper
+}
+
+func (PrecompileExecutor) IsTransaction(method string) bool {
+	switch method {
+	case DenomsFromCreatorMethod, AuthorityMetadataMethod, DenomAllowListMethod:
+		return false
+	default:
+		return true
+	}
+}
+
+func (p PrecompileExecutor) sender(ctx sdk.Context, caller common.Address) (sdk.AccAddress, error) {
+	sender, found := p.evmKeeper.GetSeiAddress(ctx, caller)
+	if !found {
+		return nil, types.NewAssociationMissingErr(caller.Hex())
+	}
+	return sender, nil
+}
+
+// allowListFromArg resolves EVM addresses the same way the bank precompile does:
+// associated addresses map to their Sei address, others to the casted address
+// that receives their native tokens.
+func (p PrecompileExecutor) allowListFromArg(ctx sdk.Context, addrs []common.Address) *banktypes.AllowList {
+	allowList := &banktypes.AllowList{Addresses: make([]string, 0, len(addrs))}
+	for _, addr := range addrs {
+		seiAddr, found := p.evmKeeper.GetSeiAddress(ctx, addr)
+		if !found {
+			seiAddr = sdk.AccAddress(addr[:])
+		}
+		allowList.Addresses = append(allowList.Addresses, seiAddr.String())
+	}
+	return allowList
+}
+
+func coinFromArgs(denomArg interface{}, amountArg interface{}) (sdk.Coin, error) {
+	denom := denomArg.(string)
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return sdk.Coin{}, err
+	}
+	amount := amountArg.(*big.Int)
+	if amount.Sign() <= 0 {
+		return sdk.Coin{}, errors.New("amount must be positive")
+	}
+	return sdk.NewCoin(denom, sdk.NewIntFromBigInt(amount)), nil
+}
@@ -0,0 +1,179 @@
+package tokenfactory_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/sei-protocol/sei-chain/precompiles/tokenfactory"
+	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/utils"
+	"github.com/sei-protocol/sei-chain/x/evm/artifacts/native"
+	"github.com/sei-protocol/sei-chain/x/evm/state"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenfactory(t *testing.T) {
+	testApp := testkeeper.EVMTestApp
+	k := &testApp.EvmKeeper
+	ctx := testApp.GetContextForDeliverTx([]byte{}).WithBlockTime(time.Now())
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeterWithMultiplier(ctx))
+
+	creatorSeiAddr, creatorEVMAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, creatorSeiAddr, creatorEVMAddr)
+	adminSeiAddr, adminEVMAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, adminSeiAddr, adminEVMAddr)
+	_, unassociatedEVMAddr := testkeeper.MockAddressPair()
+
+	p, err := tokenfactory.NewPrecompile(testApp.GetPrecompileKeepers())
+	require.Nil(t, err)
+	suppliedGas := uint64(10000000)
+	cfg := types.DefaultChainConfig().EthereumConfig(k.ChainID(ctx))
+	blockCtx, err := k.GetVMBlockContext(ctx, core.GasPool(suppliedGas))
+	require.Nil(t, err)
+	statedb := state.NewDBImpl(ctx, k, false)
+	evm := vm.NewEVM(*blockCtx, statedb, cfg, vm.Config{}, k.CustomPrecompiles(ctx))
+	run := func(caller common.Address, method string, readOnly bool, isFromDelegateCall bool, args ...interface{}) ([]interface{}, error) {
+		input, err := p.ABI.Pack(method, args...)
+		require.Nil(t, err)
+		ret, _, err := p.RunAndCalculateGas(evm, caller, caller, input, suppliedGas, nil, nil, readOnly, isFromDelegateCall)
+		if err != nil {
+			return nil, err
+		}
+		outputs, err := p.ABI.Methods[method].Outputs.Unpack(ret)
+		require.Nil(t, err)
+		return outputs, nil
+	}
+	nativeABI, err := native.NativeMetaData.GetAbi()
+	require.Nil(t, err)
+	pointerMetadata := func(pointer common.Address) utils.ERCMetadata {
+		call := func(method string) interface{} {
+			input, err := nativeABI.Pack(method)
+			require.Nil(t, err)
+			ret, _, err := evm.StaticCall(creatorEVMAddr, pointer, input, suppliedGas)
+			require.Nil(t, err)
+			outputs, err := nativeABI.Unpack(method, ret)
+			require.Nil(t, err)
+			return outputs[0]
+		}
+		return utils.ERCMetadata{Name: call("name").(string), Symbol: call("symbol").(string), Decimals: call("decimals").(uint8)}
+	}
+	balance := func(denom string) int64 {
+		return testApp.BankKeeper.GetBalance(statedb.Ctx(), creatorSeiAddr, denom).Amount.Int64()
+	}
+
+	// create
+	outputs, err := run(creatorEVMAddr, tokenfactory.CreateDenomMethod, false, false, "test", []common.Address{})
+	require.Nil(t, err)
+	denom := outputs[0].(string)
+	require.Equal(t, fmt.Sprintf("factory/%s/test", creatorSeiAddr.String()), denom)
+	_, _, exists := k.GetERC20NativePointer(statedb.Ctx(), denom)
+	require.False(t, exists)
+	require.Nil(t, testApp.BankKeeper.GetDenomAllowList(statedb.Ctx(), denom).Addresses)
+
+	// create with an allow list
+	outputs, err = run(creatorEVMAddr, tokenfactory.CreateDenomMethod, false, false, "nopointer", []common.Address{creatorEVMAddr})
+	require.Nil(t, err)
+	require.Equal(t, []string{creatorSeiAddr.String()}, testApp.BankKeeper.GetDenomAllowList(statedb.Ctx(), outputs[0].(string)).Addresses)
+
+	// queries work from staticcall
+	outputs, err = run(creatorEVMAddr, tokenfactory.DenomsFromCreatorMethod, true, false, creatorEVMAddr)
+	require.Nil(t, err)
+	require.ElementsMatch(t, []string{denom, fmt.Sprintf("factory/%s/nopointer", creatorSeiAddr.String())}, outputs[0].([]string))
+	outputs, err = run(creatorEVMAddr, tokenfactory.AuthorityMetadataMethod, true, false, denom)
+	require.Nil(t, err)
+	require.Equal(t, creatorEVMAddr, outputs[0].(common.Address))
+	require.Equal(t, creatorSeiAddr.String(), outputs[1].(string))
+
+	// mint and burn
+	_, err = run(creatorEVMAddr, tokenfactory.MintMethod, false, false, denom, big.NewInt(1000))
+	require.Nil(t, err)
+	require.Equal(t, int64(1000), balance(denom))
+	_, err = run(creatorEVMAddr, tokenfactory.BurnMethod, false, false, denom, big.NewInt(400))
+	require.Nil(t, err)
+	require.Equal(t, int64(600), balance(denom))
+
+	// metadata
+	metadata := tokenfactory.Metadata{
+		Description: "test token",
+		DenomUnits: []tokenfactory.DenomUnit{
+			{Denom: denom, Exponent: 0, Aliases: []string{}},
+			{Denom: "TEST", Exponent: 6, Aliases: []string{"test"}},
+		},
+		Base:    denom,
+		Display: "TEST",
+		Name:    "Test",
+		Symbol:  "TST",
+	}
+	outputs, err = run(creatorEVMAddr, tokenfactory.SetDenomMetadataMethod, false, false, metadata, false)
+	require.Nil(t, err)
+	require.Equal(t, common.Address{}, outputs[0].(common.Address))
+	bankMetadata, found := testApp.BankKeeper.GetDenomMetaData(statedb.Ctx(), denom)
+	require.True(t, found)
+	require.Equal(t, "TST", bankMetadata.Symbol)
+	require.Equal(t, uint32(6), bankMetadata.DenomUnits[1].Exponent)
+	require.Equal(t, []string{"test"}, bankMetadata.DenomUnits[1].Aliases)
+	_, _, exists = k.GetERC20NativePointer(statedb.Ctx(), denom)
+	require.False(t, exists)
+
+	// the pointer is named after the metadata and redeployed when the metadata changes
+	outputs, err = run(creatorEVMAddr, tokenfactory.SetDenomMetadataMethod, false, false, metadata, true)
+	require.Nil(t, err)
+	pointerAddr, _, exists := k.GetERC20NativePointer(statedb.Ctx(), denom)
+	require.True(t, exists)
+	require.Equal(t, pointerAddr, outputs[0].(common.Address))
+	require.Equal(t, utils.ERCMetadata{Name: "test", Symbol: "TEST", Decimals: 6}, pointerMetadata(pointerAddr))
+	metadata.DenomUnits[1] = tokenfactory.DenomUnit{Denom: "RENAMED", Exponent: 8, Aliases: []string{}}
+	metadata.Display = "RENAMED"
+	outputs, err = run(creatorEVMAddr, tokenfactory.SetDenomMetadataMethod, false, false, metadata, true)
+	require.Nil(t, err)
+	require.Equal(t, pointerAddr, outputs[0].(common.Address))
+	require.Equal(t, utils.ERCMetadata{Name: "RENAMED", Symbol: "RENAMED", Decimals: 8}, pointerMetadata(pointerAddr))
+
+	// allow list
+	_, err = run(creatorEVMAddr, tokenfactory.UpdateDenomMethod, false, false, denom, []common.Address{creatorEVMAddr, unassociatedEVMAddr})
+	require.Nil(t, err)
+	outputs, err = run(creatorEVMAddr, tokenfactory.DenomAllowListMethod, true, false, denom)
+	require.Nil(t, err)
+	require.Equal(t, []common.Address{creatorEVMAddr, unassociatedEVMAddr}, outputs[0].([]common.Address))
+	_, err = run(creatorEVMAddr, tokenfactory.UpdateDenomMethod, false, false, denom, []common.Address{})
+	require.Nil(t, err)
+	outputs, err = run(creatorEVMAddr, tokenfactory.DenomAllowListMethod, true, false, denom)
+	require.Nil(t, err)
+	require.Empty(t, outputs[0].([]common.Address))
+
+	// admin change
+	_, err = run(creatorEVMAddr, tokenfactory.ChangeAdminMethod, false, false, denom, unassociatedEVMAddr)
+	require.NotNil(t, err)
+	_, err = run(creatorEVMAddr, tokenfactory.ChangeAdminMethod, false, false, denom, adminEVMAddr)
+	require.Nil(t, err)
+	outputs, err = run(creatorEVMAddr, tokenfactory.AuthorityMetadataMethod, true, false, denom)
+	require.Nil(t, err)
+	require.Equal(t, adminEVMAddr, outputs[0].(common.Address))
+	_, err = run(creatorEVMAddr, tokenfactory.MintMethod, false, false, denom, big.NewInt(1))
+	require.NotNil(t, err)
+	_, err = run(adminEVMAddr, tokenfactory.MintMethod, false, false, denom, big.NewInt(1))
+	require.Nil(t, err)
+
+	// failures
+	_, err = run(creatorEVMAddr, tokenfactory.CreateDenomMethod, false, false, "test", []common.Address{})
+	require.NotNil(t, err)
+	_, err = run(unassociatedEVMAddr, tokenfactory.CreateDenomMethod, false, false, "unassociated", []common.Address{})
+	require.NotNil(t, err)
+	_, err = run(adminEVMAddr, tokenfactory.CreateDenomMethod, true, false, "static", []common.Address{})
+	require.NotNil(t, err)
+	_, err = run(adminEVMAddr, tokenfactory.CreateDenomMethod, false, true, "delegate", []common.Address{})
+	require.NotNil(t, err)
+	_, err = run(adminEVMAddr, tokenfactory.MintMethod, false, false, denom, big.NewInt(0))
+	require.NotNil(t, err)
+	_, err = run(adminEVMAddr, tokenfactory.MintMethod, false, false, "uaex", big.NewInt(1))
+	require.NotNil(t, err)
+	_, err = run(creatorEVMAddr, tokenfactory.DenomsFromCreatorMethod, true, false, unassociatedEVMAddr)
+	require.NotNil(t, err)
+}
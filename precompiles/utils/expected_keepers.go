@@ -11,8 +11,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	ibctypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
@@ -20,7 +22,9 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/sei-protocol/sei-chain/utils"
+	aexburntypes "github.com/sei-protocol/sei-chain/x/aexburn/types"
 	oracletypes "github.com/sei-protocol/sei-chain/x/oracle/types"
+	tokenfactorytypes "github.com/sei-protocol/sei-chain/x/tokenfactory/types"
 )
 
 type Keepers interface {
@@ -35,33 +39,45 @@ type Keepers interface {
 	StakingQ() StakingQuerier
 	GovK() GovKeeper
 	GovMS() GovMsgServer
+	GovQ() GovQuerier
 	DistributionK() DistributionKeeper
 	TransferK() TransferKeeper
 	ClientK() ClientKeeper
 	ConnectionK() ConnectionKeeper
 	ChannelK() ChannelKeeper
+	AexburnK() AexburnKeeper
+	AuthzMS() AuthzMsgServer
+	FeegrantMS() FeegrantMsgServer
+	TokenfactoryMS() TokenfactoryMsgServer
+	TokenfactoryQ() TokenfactoryQuerier
 	TxConfig() client.TxConfig
 }
 
 type EmptyKeepers struct{}
 
-func (ek *EmptyKeepers) BankK() BankKeeper                 { return nil }
-func (ek *EmptyKeepers) BankMS() BankMsgServer             { return nil }
-func (ek *EmptyKeepers) EVMK() EVMKeeper                   { return nil }
-func (ek *EmptyKeepers) AccountK() AccountKeeper           { return nil }
-func (ek *EmptyKeepers) OracleK() OracleKeeper             { return nil }
-func (ek *EmptyKeepers) WasmdK() WasmdKeeper               { return nil }
-func (ek *EmptyKeepers) WasmdVK() WasmdViewKeeper          { return nil }
-func (ek *EmptyKeepers) StakingK() StakingKeeper           { return nil }
-func (ek *EmptyKeepers) StakingQ() StakingQuerier          { return nil }
-func (ek *EmptyKeepers) GovK() GovKeeper                   { return nil }
-func (ek *EmptyKeepers) GovMS() GovMsgServer               { return nil }
-func (ek *EmptyKeepers) DistributionK() DistributionKeeper { return nil }
-func (ek *EmptyKeepers) TransferK() TransferKeeper         { return nil }
-func (ek *EmptyKeepers) ClientK() ClientKeeper             { return nil }
-func (ek *EmptyKeepers) ConnectionK() ConnectionKeeper     { return nil }
-func (ek *EmptyKeepers) ChannelK() ChannelKeeper           { return nil }
-func (ek *EmptyKeepers) TxConfig() client.TxConfig         { return nil }
+func (ek *EmptyKeepers) BankK() BankKeeper                     { return nil }
+func (ek *EmptyKeepers) BankMS() BankMsgServer                 { return nil }
+func (ek *EmptyKeepers) EVMK() EVMKeeper                       { return nil }
+func (ek *EmptyKeepers) AccountK() AccountKeeper               { return nil }
+func (ek *EmptyKeepers) OracleK() OracleKeeper                 { return nil }
+func (ek *EmptyKeepers) WasmdK() WasmdKeeper                   { return nil }
+func (ek *EmptyKeepers) WasmdVK() WasmdViewKeeper              { return nil }
+func (ek *EmptyKeepers) StakingK() StakingKeeper               { return nil }
+func (ek *EmptyKeepers) StakingQ() StakingQuerier              { return nil }
+func (ek *EmptyKeepers) GovK() GovKeeper                       { return nil }
+func (ek *EmptyKeepers) GovMS() GovMsgServer                   { return nil }
+func (ek *EmptyKeepers) GovQ() GovQuerier                      { return nil }
+func (ek *EmptyKeepers) DistributionK() DistributionKeeper     { return nil }
+func (ek *EmptyKeepers) TransferK() TransferKeeper             { return nil }
+func (ek *EmptyKeepers) ClientK() ClientKeeper                 { return nil }
+func (ek *EmptyKeepers) ConnectionK() ConnectionKeeper         { return nil }
+func (ek *EmptyKeepers) ChannelK() ChannelKeeper               { return nil }
+func (ek *EmptyKeepers) AexburnK() AexburnKeeper               { return nil }
+func (ek *EmptyKeepers) AuthzMS() AuthzMsgServer               { return nil }
+func (ek *EmptyKeepers) FeegrantMS() FeegrantMsgServer         { return nil }
+func (ek *EmptyKeepers) TokenfactoryMS() TokenfactoryMsgServer { return nil }
+func (ek *EmptyKeepers) TokenfactoryQ() TokenfactoryQuerier    { return nil }
+func (ek *EmptyKeepers) TxConfig() client.TxConfig             { return nil }
 
 type BankKeeper interface {
 	SendCoins(sdk.Context, sdk.AccAddress, sdk.AccAddress, sdk.Coins) error
@@ -149,11 +165,17 @@ type StakingKeeper interface {
 
 type StakingQuerier interface {
 	Delegation(c context.Context, req *stakingtypes.QueryDelegationRequest) (*stakingtypes.QueryDelegationResponse, error)
+	Validator(c context.Context, req *stakingtypes.QueryValidatorRequest) (*stakingtypes.QueryValidatorResponse, error)
+	Validators(c context.Context, req *stakingtypes.QueryValidatorsRequest) (*stakingtypes.QueryValidatorsResponse, error)
+	DelegatorUnbondingDelegations(c context.Context, req *stakingtypes.QueryDelegatorUnbondingDelegationsRequest) (*stakingtypes.QueryDelegatorUnbondingDelegationsResponse, error)
+	Redelegations(c context.Context, req *stakingtypes.QueryRedelegationsRequest) (*stakingtypes.QueryRedelegationsResponse, error)
+	Pool(c context.Context, req *stakingtypes.QueryPoolRequest) (*stakingtypes.QueryPoolResponse, error)
 }
 
 type GovKeeper interface {
 	AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options govtypes.WeightedVoteOptions) error
 	AddDeposit(ctx sdk.Context, proposalID uint64, depositorAddr sdk.AccAddress, depositAmount sdk.Coins) (bool, error)
+	GetVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) (govtypes.Vote, bool)
 }
 
 type GovMsgServer interface {
@@ -163,11 +185,23 @@ type GovMsgServer interface {
 	SubmitProposal(goCtx context.Context, msg *govtypes.MsgSubmitProposal) (*govtypes.MsgSubmitProposalResponse, error)
 }
 
+type GovQuerier interface {
+	Proposal(c context.Context, req *govtypes.QueryProposalRequest) (*govtypes.QueryProposalResponse, error)
+	Proposals(c context.Context, req *govtypes.QueryProposalsRequest) (*govtypes.QueryProposalsResponse, error)
+	TallyResult(c context.Context, req *govtypes.QueryTallyResultRequest) (*govtypes.QueryTallyResultResponse, error)
+	Params(c context.Context, req *govtypes.QueryParamsRequest) (*govtypes.QueryParamsResponse, error)
+}
+
 type DistributionKeeper interface {
 	SetWithdrawAddr(ctx sdk.Context, delegatorAddr sdk.AccAddress, withdrawAddr sdk.AccAddress) error
 	WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, error)
 	WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddress) (sdk.Coins, error)
 	DelegationTotalRewards(c context.Context, req *distrtypes.QueryDelegationTotalRewardsRequest) (*distrtypes.QueryDelegationTotalRewardsResponse, error)
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+	GetFeePoolCommunityCoins(ctx sdk.Context) sdk.DecCoins
+	GetValidatorOutstandingRewardsCoins(ctx sdk.Context, val sdk.ValAddress) sdk.DecCoins
+	GetValidatorAccumulatedCommission(ctx sdk.Context, val sdk.ValAddress) distrtypes.ValidatorAccumulatedCommission
+	GetDelegatorWithdrawAddr(ctx sdk.Context, delAddr sdk.AccAddress) sdk.AccAddress
 }
 
 type TransferKeeper interface {
@@ -196,3 +230,38 @@ type ConnectionKeeper interface {
 type ChannelKeeper interface {
 	GetChannel(ctx sdk.Context, portID, channelID string) (types.Channel, bool)
 }
+
+type AexburnKeeper interface {
+	GetParams(ctx sdk.Context) aexburntypes.Params
+	CalculateDynamicBurnRate(ctx sdk.Context, moduleParams aexburntypes.Params) sdk.Dec
+	GetBurnStats(ctx sdk.Context) aexburntypes.BurnStats
+	GetInflationStats(ctx sdk.Context) aexburntypes.InflationStats
+	Get12MonthNetSupply(ctx sdk.Context) sdk.Int
+	GetReverseBrakeState(ctx sdk.Context) aexburntypes.ReverseBrakeState
+	GetIncomeBuffer(ctx sdk.Context) aexburntypes.IncomeBuffer
+}
+
+type AuthzMsgServer interface {
+	Grant(goCtx context.Context, msg *authz.MsgGrant) (*authz.MsgGrantResponse, error)
+	Revoke(goCtx context.Context, msg *authz.MsgRevoke) (*authz.MsgRevokeResponse, error)
+}
+
+type FeegrantMsgServer interface {
+	GrantAllowance(goCtx context.Context, msg *feegrant.MsgGrantAllowance) (*feegrant.MsgGrantAllowanceResponse, error)
+	RevokeAllowance(goCtx context.Context, msg *feegrant.MsgRevokeAllowance) (*feegrant.MsgRevokeAllowanceResponse, error)
+}
+
+type TokenfactoryMsgServer interface {
+	CreateDenom(goCtx context.Context, msg *tokenfactorytypes.MsgCreateDenom) (*tokenfactorytypes.MsgCreateDenomResponse, error)
+	UpdateDenom(goCtx context.Context, msg *tokenfactorytypes.MsgUpdateDenom) (*tokenfactorytypes.MsgUpdateDenomResponse, error)
+	Mint(goCtx context.Context, msg *tokenfactorytypes.MsgMint) (*tokenfactorytypes.MsgMintResponse, error)
+	Burn(goCtx context.Context, msg *tokenfactorytypes.MsgBurn) (*tokenfactorytypes.MsgBurnResponse, error)
+	ChangeAdmin(goCtx context.Context, msg *tokenfactorytypes.MsgChangeAdmin) (*tokenfactorytypes.MsgChangeAdminResponse, error)
+	SetDenomMetadata(goCtx context.Context, msg *tokenfactorytypes.MsgSetDenomMetadata) (*tokenfactorytypes.MsgSetDenomMetadataResponse, error)
+}
+
+type TokenfactoryQuerier interface {
+	DenomAuthorityMetadata(ctx context.Context, req *tokenfactorytypes.QueryDenomAuthorityMetadataRequest) (*tokenfactorytypes.QueryDenomAuthorityMetadataResponse, error)
+	DenomsFromCreator(ctx context.Context, req *tokenfactorytypes.QueryDenomsFromCreatorRequest) (*tokenfactorytypes.QueryDenomsFromCreatorResponse, error)
+	DenomAllowList(ctx context.Context, req *tokenfactorytypes.QueryDenomAllowListRequest) (*tokenfactorytypes.QueryDenomAllowListResponse, error)
+}
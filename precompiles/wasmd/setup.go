@@ -14,6 +14,7 @@ import (
 	wasmdv606 "github.com/sei-protocol/sei-chain/precompiles/wasmd/legacy/v606"
 	wasmdv610 "github.com/sei-protocol/sei-chain/precompiles/wasmd/legacy/v610"
 	wasmdv614 "github.com/sei-protocol/sei-chain/precompiles/wasmd/legacy/v614"
+	wasmdv620 "github.com/sei-protocol/sei-chain/precompiles/wasmd/legacy/v620"
 	"github.com/sei-protocol/sei-chain/precompiles/utils"
 )
 
@@ -32,6 +33,7 @@ func GetVersioned(latestUpgrade string, keepers utils.Keepers) utils.VersionedPr
 		"v6.0.6":      check(wasmdv606.NewPrecompile(keepers)),
 		"v6.1.0":      check(wasmdv610.NewPrecompile(keepers)),
 		"v6.1.4":      check(wasmdv614.NewPrecompile(keepers)),
+		"v6.2.0":      check(wasmdv620.NewPrecompile(keepers)),
 	}
 }
 
@@ -263,12 +263,15 @@ func (app *BaseApp) DeliverTxBatch(ctx sdk.Context, req sdk.DeliverTxBatchReques
 	}
 
 	// avoid overhead for empty batches
-	scheduler := tasks.NewScheduler(app.concurrencyWorkers, app.TracingInfo, app.DeliverTx)
+	scheduler := tasks.NewScheduler(app.concurrencyWorkers, app.TracingInfo, app.occExecutionHistory != nil, app.DeliverTx)
 	txRes, err := scheduler.ProcessAll(ctx, req.TxEntries)
 	if err != nil {
 		ctx.Logger().Error("error while processing scheduler", "err", err)
 		panic(err)
 	}
+	if app.occExecutionHistory != nil {
+		app.occExecutionHistory.Add(scheduler.Execution())
+	}
 	for _, tx := range txRes {
 		responses = append(responses, &sdk.DeliverTxResult{Response: tx})
 	}
@@ -30,6 +30,7 @@ import (
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/tasks"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	acltypes "github.com/cosmos/cosmos-sdk/types/accesscontrol"
@@ -58,9 +59,10 @@ const (
 	FlagArchivalArweaveIndexDBFullPath = "archival-arweave-index-db-full-path"
 	FlagArchivalArweaveNodeURL         = "archival-arweave-node-url"
 
-	FlagChainID            = "chain-id"
-	FlagConcurrencyWorkers = "concurrency-workers"
-	FlagOccEnabled         = "occ-enabled"
+	FlagChainID             = "chain-id"
+	FlagConcurrencyWorkers  = "concurrency-workers"
+	FlagOccEnabled          = "occ-enabled"
+	FlagOccExecutionHistory = "occ-execution-history"
 )
 
 var (
@@ -176,6 +178,8 @@ type BaseApp struct { //nolint: maligned
 
 	concurrencyWorkers int
 	occEnabled         bool
+	// executions of the most recent blocks processed with OCC, nil if not kept
+	occExecutionHistory *tasks.ExecutionHistory
 
 	deliverTxHooks []DeliverTxHook
 }
@@ -316,6 +320,11 @@ func NewBaseApp(
 	if app.concurrencyWorkers == 0 {
 		app.concurrencyWorkers = config.DefaultConcurrencyWorkers
 	}
+	if app.occExecutionHistory == nil {
+		if size := cast.ToInt(appOpts.Get(FlagOccExecutionHistory)); size > 0 {
+			app.occExecutionHistory = tasks.NewExecutionHistory(size)
+		}
+	}
 
 	return app
 }
@@ -340,6 +349,15 @@ func (app *BaseApp) OccEnabled() bool {
 	return app.occEnabled
 }
 
+// OccExecution returns how the txs of the block at the given height were executed by the
+// OCC scheduler, if the block was processed with OCC by this node and is still kept.
+func (app *BaseApp) OccExecution(height int64) (tasks.BlockExecution, bool) {
+	if app.occExecutionHistory == nil {
+		return tasks.BlockExecution{}, false
+	}
+	return app.occExecutionHistory.Get(height)
+}
+
 // Version returns the application's version string.
 func (app *BaseApp) Version() string {
 	return app.version
@@ -98,7 +98,7 @@ func TestDeliverTxBatch(t *testing.T) {
 		bapp.Router().AddRoute(r)
 	}
 
-	app := setupBaseApp(t, anteOpt, routerOpt)
+	app := setupBaseApp(t, anteOpt, routerOpt, SetOccExecutionHistory(2))
 	app.InitChain(context.Background(), &abci.RequestInitChain{})
 
 	// Create same codec used in txDecoder
@@ -143,6 +143,16 @@ func TestDeliverTxBatch(t *testing.T) {
 		app.SetDeliverStateToCommit()
 		app.Commit(context.Background())
 	}
+
+	// only the executions of the last blocks are kept
+	_, ok := app.OccExecution(1)
+	require.False(t, ok)
+	for height := int64(2); height <= int64(nBlocks); height++ {
+		execution, ok := app.OccExecution(height)
+		require.True(t, ok)
+		require.Equal(t, height, execution.Height)
+		require.Len(t, execution.Txs, txPerHeight)
+	}
 }
 
 func TestDeliverTxBatchEmpty(t *testing.T) {
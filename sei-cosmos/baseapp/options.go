@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/tasks"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -90,6 +91,11 @@ func SetOccEnabled(occEnabled bool) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetOccEnabled(occEnabled) }
 }
 
+// SetOccExecutionHistory sets the number of recent blocks whose OCC executions are kept.
+func SetOccExecutionHistory(size int) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetOccExecutionHistory(size) }
+}
+
 // SetSnapshotKeepRecent sets the recent snapshots to keep.
 func SetSnapshotKeepRecent(keepRecent uint32) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetSnapshotKeepRecent(keepRecent) }
@@ -336,6 +342,15 @@ func (app *BaseApp) SetOccEnabled(occEnabled bool) {
 	app.occEnabled = occEnabled
 }
 
+func (app *BaseApp) SetOccExecutionHistory(size int) {
+	if app.sealed {
+		panic("SetOccExecutionHistory() on sealed BaseApp")
+	}
+	if size > 0 {
+		app.occExecutionHistory = tasks.NewExecutionHistory(size)
+	}
+}
+
 // SetSnapshotKeepRecent sets the number of recent snapshots to keep.
 func (app *BaseApp) SetSnapshotKeepRecent(snapshotKeepRecent uint32) {
 	if app.sealed {
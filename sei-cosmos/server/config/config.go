@@ -27,6 +27,9 @@ const (
 
 	// DefaultOccEanbled defines whether to use OCC for tx processing
 	DefaultOccEnabled = false
+
+	// DefaultOccExecutionHistory defines the default number of recent blocks whose OCC executions are kept
+	DefaultOccExecutionHistory = 100
 )
 
 // BaseConfig defines the server's basic configuration
@@ -100,6 +103,9 @@ type BaseConfig struct {
 	ConcurrencyWorkers int `mapstructure:"concurrency-workers"`
 	// Whether to enable optimistic concurrency control for tx execution, default is true
 	OccEnabled bool `mapstructure:"occ-enabled"`
+	// Number of recent blocks whose OCC executions (incarnations and aborts of each tx) are
+	// kept for tracing. A value of 0 keeps none.
+	OccExecutionHistory int `mapstructure:"occ-execution-history"`
 }
 
 // APIConfig defines the API listener configuration.
@@ -262,6 +268,7 @@ func DefaultConfig() *Config {
 			NoVersioning:        false,
 			ConcurrencyWorkers:  DefaultConcurrencyWorkers,
 			OccEnabled:          DefaultOccEnabled,
+			OccExecutionHistory: DefaultOccExecutionHistory,
 		},
 		Telemetry: telemetry.Config{
 			Enabled:      false,
@@ -344,6 +351,7 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			OrphanDirectory:              v.GetString("orphan-dir"),
 			ConcurrencyWorkers:           v.GetInt("concurrency-workers"),
 			OccEnabled:                   v.GetBool("occ-enabled"),
+			OccExecutionHistory:          v.GetInt("occ-execution-history"),
 		},
 		Telemetry: telemetry.Config{
 			ServiceName:             v.GetString("telemetry.service-name"),
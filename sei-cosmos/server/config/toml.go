@@ -110,6 +110,10 @@ orphan-dir = "{{ .BaseConfig.OrphanDirectory }}"
 # occ-enabled defines whether OCC is enabled or not for transaction execution
 occ-enabled = {{ .BaseConfig.OccEnabled }}
 
+# occ-execution-history defines how many recent blocks processed with OCC have the executions
+# of their transactions (incarnations, aborts and conflicting keys) kept for tracing. 0 keeps none.
+occ-execution-history = {{ .BaseConfig.OccExecutionHistory }}
+
 ###############################################################################
 ###                         Telemetry Configuration                         ###
 ###############################################################################
@@ -113,7 +113,7 @@ func (vi *validationIterator) Value() []byte {
 
 	// if we have an estimate, write to abort channel
 	if val.IsEstimate() {
-		vi.abortChannel <- occtypes.NewEstimateAbort(val.Index())
+		vi.abortChannel <- occtypes.NewEstimateAbort(val.Index(), key)
 	}
 
 	// if we have a deleted value, return nil
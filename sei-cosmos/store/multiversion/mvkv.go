@@ -161,7 +161,7 @@ func (store *VersionIndexedStore) Get(key []byte) []byte {
 	mvsValue := store.multiVersionStore.GetLatestBeforeIndex(store.transactionIndex, key)
 	if mvsValue != nil {
 		if mvsValue.IsEstimate() {
-			abort := scheduler.NewEstimateAbort(mvsValue.Index())
+			abort := scheduler.NewEstimateAbort(mvsValue.Index(), key)
 			store.WriteAbort(abort)
 			panic(abort)
 		} else {
@@ -212,7 +212,7 @@ func (store *VersionIndexedStore) ValidateReadset() bool {
 		if mvsValue != nil {
 			if mvsValue.IsEstimate() {
 				// if we see an estimate, that means that we need to abort and rerun
-				store.WriteAbort(scheduler.NewEstimateAbort(mvsValue.Index()))
+				store.WriteAbort(scheduler.NewEstimateAbort(mvsValue.Index(), key))
 				return false
 			} else {
 				if mvsValue.IsDeleted() {
@@ -29,6 +29,7 @@ type MultiVersionStore interface {
 	GetIterateset(index int) Iterateset
 	ClearIterateset(index int)
 	ValidateTransactionState(index int) (bool, []int)
+	ConflictingKeys(index int) []string
 }
 
 type WriteSet map[string][]byte
@@ -333,18 +334,27 @@ func (s *Store) checkIteratorAtIndex(index int) bool {
 }
 
 func (s *Store) checkReadsetAtIndex(index int) (bool, []int) {
+	valid, conflictIndices, _ := s.validateReadsetAtIndex(index)
+	return valid, conflictIndices
+}
+
+// validateReadsetAtIndex validates the readset of a transaction, returning the indices of
+// the transactions it conflicts with and the keys whose values it read are outdated
+func (s *Store) validateReadsetAtIndex(index int) (bool, []int, []string) {
 	conflictSet := make(map[int]struct{})
+	var conflictKeys []string
 	valid := true
 
 	readSetAny, found := s.txReadSets.Load(index)
 	if !found {
-		return true, []int{}
+		return true, []int{}, nil
 	}
 	readset := readSetAny.(ReadSet)
 	// iterate over readset and check if the value is the same as the latest value relateive to txIndex in the multiversion store
 	for key, valueArr := range readset {
 		if len(valueArr) != 1 {
 			valid = false
+			conflictKeys = append(conflictKeys, key)
 			continue
 		}
 		value := valueArr[0]
@@ -355,20 +365,24 @@ func (s *Store) checkReadsetAtIndex(index int) (bool, []int) {
 			parentVal := s.parentStore.Get([]byte(key))
 			if !bytes.Equal(parentVal, value) {
 				valid = false
+				conflictKeys = append(conflictKeys, key)
 			}
 		} else {
 			// if estimate, mark as conflict index - but don't invalidate
 			if latestValue.IsEstimate() {
 				conflictSet[latestValue.Index()] = struct{}{}
+				conflictKeys = append(conflictKeys, key)
 			} else if latestValue.IsDeleted() {
 				if value != nil {
 					// conflict
 					// TODO: would we want to return early?
 					conflictSet[latestValue.Index()] = struct{}{}
+					conflictKeys = append(conflictKeys, key)
 					valid = false
 				}
 			} else if !bytes.Equal(latestValue.Value(), value) {
 				conflictSet[latestValue.Index()] = struct{}{}
+				conflictKeys = append(conflictKeys, key)
 				valid = false
 			}
 		}
@@ -380,8 +394,17 @@ func (s *Store) checkReadsetAtIndex(index int) (bool, []int) {
 	}
 
 	sort.Ints(conflictIndices)
+	sort.Strings(conflictKeys)
 
-	return valid, conflictIndices
+	return valid, conflictIndices, conflictKeys
+}
+
+// ConflictingKeys returns the keys read by a transaction whose values have since been
+// changed or are being rewritten by earlier transactions, sorted. Conflicts found by
+// iterator validation are not attributed to a key.
+func (s *Store) ConflictingKeys(index int) []string {
+	_, _, conflictKeys := s.validateReadsetAtIndex(index)
+	return conflictKeys
 }
 
 // TODO: do we want to return bool + []int where bool indicates whether it was valid and then []int indicates only ones for which we need to wait due to estimates? - yes i think so?
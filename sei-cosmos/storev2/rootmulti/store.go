@@ -220,6 +220,11 @@ func (rs *Store) GetStateStore() sstypes.StateStore {
 	return rs.ssStore
 }
 
+// GetCommitter returns the scStore instance
+func (rs *Store) GetCommitter() sctypes.Committer {
+	return rs.scStore
+}
+
 // Implements interface CacheWrapper
 func (rs *Store) CacheWrap(_ types.StoreKey) types.CacheWrap {
 	return rs.CacheMultiStore().(types.CacheWrap)
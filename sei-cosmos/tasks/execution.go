@@ -0,0 +1,182 @@
+package tasks
+
+import (
+	"sort"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/occ"
+)
+
+// Reasons for which an execution of a tx is discarded
+const (
+	// AbortReasonEstimate means the tx read a key that an earlier tx was going to write
+	// again, so it stopped until that tx was done
+	AbortReasonEstimate = "estimate"
+	// AbortReasonValidation means that values read by the tx were changed by earlier txs
+	// by the time it was validated
+	AbortReasonValidation = "validation"
+)
+
+// ConflictingKey is a key whose read caused an execution to be discarded
+type ConflictingKey struct {
+	// Name of the store of the key, empty if it could not be told
+	StoreKey string
+	Key      []byte
+}
+
+// TxAbort is a discarded execution of a tx
+type TxAbort struct {
+	Incarnation int
+	Reason      string
+	// Indices of the txs the tx has to wait for before it is executed again
+	DependentTxs    []int
+	ConflictingKeys []ConflictingKey
+}
+
+// TxExecution is how the scheduler executed a tx
+type TxExecution struct {
+	AbsoluteIndex int
+	// Number of times the tx was executed
+	Incarnations int
+	Aborts       []TxAbort
+}
+
+// BlockExecution is how the scheduler executed the txs of a block
+type BlockExecution struct {
+	Height     int64
+	Iterations int
+	// Whether the scheduler fell back to executing txs one at a time because of conflicts
+	Synchronous bool
+	Txs         []TxExecution
+}
+
+// AppendAbort records a discarded execution of the task
+func (dt *deliverTxTask) AppendAbort(abort TxAbort) {
+	dt.mx.Lock()
+	defer dt.mx.Unlock()
+	dt.Aborts = append(dt.Aborts, abort)
+}
+
+// estimateAbort describes an execution aborted on reading an estimate. The abort does not
+// say which store the key belongs to, so it is only attributed to a store if exactly one
+// holds the estimate of the dependent tx for that key.
+func (s *scheduler) estimateAbort(task *deliverTxTask, abort occ.Abort) TxAbort {
+	storeKey := ""
+	for sk, mv := range s.multiVersionStores {
+		value := mv.GetLatestBeforeIndex(task.AbsoluteIndex, abort.Key)
+		if value == nil || !value.IsEstimate() || value.Index() != abort.DependentTxIdx {
+			continue
+		}
+		if storeKey != "" {
+			storeKey = ""
+			break
+		}
+		storeKey = sk.Name()
+	}
+	return TxAbort{
+		Incarnation:     task.Incarnation,
+		Reason:          AbortReasonEstimate,
+		DependentTxs:    []int{abort.DependentTxIdx},
+		ConflictingKeys: []ConflictingKey{{StoreKey: storeKey, Key: abort.Key}},
+	}
+}
+
+// validationAbort describes an execution that failed validation against the given txs
+func (s *scheduler) validationAbort(task *deliverTxTask, conflicts []int) TxAbort {
+	storeKeys := make([]sdk.StoreKey, 0, len(s.multiVersionStores))
+	for sk := range s.multiVersionStores {
+		storeKeys = append(storeKeys, sk)
+	}
+	sort.Slice(storeKeys, func(i, j int) bool { return storeKeys[i].Name() < storeKeys[j].Name() })
+	var keys []ConflictingKey
+	for _, sk := range storeKeys {
+		for _, key := range s.multiVersionStores[sk].ConflictingKeys(task.AbsoluteIndex) {
+			keys = append(keys, ConflictingKey{StoreKey: sk.Name(), Key: []byte(key)})
+		}
+	}
+	return TxAbort{
+		Incarnation:     task.Incarnation,
+		Reason:          AbortReasonValidation,
+		DependentTxs:    conflicts,
+		ConflictingKeys: keys,
+	}
+}
+
+func (s *scheduler) collectExecution(height int64, iterations int, tasks []*deliverTxTask) BlockExecution {
+	txs := make([]TxExecution, 0, len(tasks))
+	for _, t := range tasks {
+		txs = append(txs, TxExecution{
+			AbsoluteIndex: t.AbsoluteIndex,
+			Incarnations:  t.Incarnation + 1,
+			Aborts:        t.Aborts,
+		})
+	}
+	return BlockExecution{Height: height, Iterations: iterations, Synchronous: s.synchronous, Txs: txs}
+}
+
+func (s *scheduler) Execution() BlockExecution {
+	return s.execution
+}
+
+// ExecutionHistory keeps the executions of the most recent blocks
+type ExecutionHistory struct {
+	mtx        sync.RWMutex
+	size       int
+	executions map[int64]BlockExecution
+}
+
+func NewExecutionHistory(size int) *ExecutionHistory {
+	return &ExecutionHistory{size: size, executions: make(map[int64]BlockExecution, size)}
+}
+
+// Add records the execution of a batch of txs of a block, and drops the lowest heights
+// beyond the size of the history. Batches of the same height are merged, unless they
+// execute txs again, in which case the block was processed again and the earlier batches
+// are discarded.
+func (h *ExecutionHistory) Add(execution BlockExecution) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	if existing, ok := h.executions[execution.Height]; ok && !overlaps(existing, execution) {
+		execution = BlockExecution{
+			Height:      execution.Height,
+			Iterations:  existing.Iterations + execution.Iterations,
+			Synchronous: existing.Synchronous || execution.Synchronous,
+			Txs:         append(append([]TxExecution{}, existing.Txs...), execution.Txs...),
+		}
+		sort.Slice(execution.Txs, func(i, j int) bool { return execution.Txs[i].AbsoluteIndex < execution.Txs[j].AbsoluteIndex })
+	}
+	h.executions[execution.Height] = execution
+	if len(h.executions) <= h.size {
+		return
+	}
+	heights := make([]int64, 0, len(h.executions))
+	for height := range h.executions {
+		heights = append(heights, height)
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+	for _, height := range heights[:len(heights)-h.size] {
+		delete(h.executions, height)
+	}
+}
+
+func overlaps(a BlockExecution, b BlockExecution) bool {
+	indices := make(map[int]struct{}, len(a.Txs))
+	for _, tx := range a.Txs {
+		indices[tx.AbsoluteIndex] = struct{}{}
+	}
+	for _, tx := range b.Txs {
+		if _, ok := indices[tx.AbsoluteIndex]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Get returns the execution of the block at the given height, if it is still kept
+func (h *ExecutionHistory) Get(height int64) (BlockExecution, bool) {
+	h.mtx.RLock()
+	defer h.mtx.RUnlock()
+	execution, ok := h.executions[height]
+	return execution, ok
+}
@@ -0,0 +1,110 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/abci/types"
+	"go.opentelemetry.io/otel/trace"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/utils/tracing"
+)
+
+// conflictingDeliverTx makes all txs read and write the same key so that they conflict
+func conflictingDeliverTx(ctx sdk.Context, req types.RequestDeliverTx, tx sdk.Tx, checksum [32]byte) (res types.ResponseDeliverTx) {
+	defer abortRecoveryFunc(&res)
+	kv := ctx.MultiStore().GetKVStore(testStoreKey)
+	val := string(kv.Get(itemKey))
+	if string(req.Tx) == "0" {
+		// let later txs read the key before the first one writes it
+		time.Sleep(20 * time.Millisecond)
+	}
+	kv.Set(itemKey, req.Tx)
+	return types.ResponseDeliverTx{Info: val}
+}
+
+func TestProcessAllRecordsExecution(t *testing.T) {
+	tr := trace.NewNoopTracerProvider().Tracer("scheduler-test")
+	s := NewScheduler(5, &tracing.Info{Tracer: &tr}, true, conflictingDeliverTx)
+	ctx := initTestCtx(true).WithBlockHeight(7)
+	requests := requestList(20)
+	_, err := s.ProcessAll(ctx, requests)
+	require.NoError(t, err)
+
+	execution := s.Execution()
+	require.Equal(t, int64(7), execution.Height)
+	require.Positive(t, execution.Iterations)
+	require.Len(t, execution.Txs, len(requests))
+	aborts := 0
+	for i, tx := range execution.Txs {
+		require.Equal(t, i, tx.AbsoluteIndex)
+		require.Greater(t, tx.Incarnations, len(tx.Aborts))
+		for _, abort := range tx.Aborts {
+			aborts++
+			require.Less(t, abort.Incarnation, tx.Incarnations)
+			require.NotEmpty(t, abort.DependentTxs)
+			for _, dep := range abort.DependentTxs {
+				require.Less(t, dep, i)
+			}
+			require.Len(t, abort.ConflictingKeys, 1)
+			require.Equal(t, itemKey, abort.ConflictingKeys[0].Key)
+			switch abort.Reason {
+			case AbortReasonValidation:
+				require.Equal(t, testStoreKey.Name(), abort.ConflictingKeys[0].StoreKey)
+			case AbortReasonEstimate:
+				// the estimate may already be replaced by the time the abort is recorded
+				require.Contains(t, []string{testStoreKey.Name(), ""}, abort.ConflictingKeys[0].StoreKey)
+			default:
+				t.Fatalf("unexpected abort reason %s", abort.Reason)
+			}
+		}
+	}
+	// the first tx never conflicts
+	require.Equal(t, 1, execution.Txs[0].Incarnations)
+	require.Empty(t, execution.Txs[0].Aborts)
+	require.Positive(t, aborts)
+}
+
+func TestProcessAllWithoutRecordingExecution(t *testing.T) {
+	tr := trace.NewNoopTracerProvider().Tracer("scheduler-test")
+	s := NewScheduler(5, &tracing.Info{Tracer: &tr}, false, conflictingDeliverTx)
+	_, err := s.ProcessAll(initTestCtx(true).WithBlockHeight(7), requestList(20))
+	require.NoError(t, err)
+	require.Equal(t, BlockExecution{}, s.Execution())
+}
+
+func TestExecutionHistory(t *testing.T) {
+	history := NewExecutionHistory(2)
+	txs := func(indices ...int) []TxExecution {
+		res := make([]TxExecution, 0, len(indices))
+		for _, i := range indices {
+			res = append(res, TxExecution{AbsoluteIndex: i, Incarnations: 1})
+		}
+		return res
+	}
+
+	// batches of the same block are merged
+	history.Add(BlockExecution{Height: 1, Iterations: 1, Txs: txs(0, 2)})
+	history.Add(BlockExecution{Height: 1, Iterations: 2, Synchronous: true, Txs: txs(1)})
+	execution, ok := history.Get(1)
+	require.True(t, ok)
+	require.Equal(t, BlockExecution{Height: 1, Iterations: 3, Synchronous: true, Txs: txs(0, 1, 2)}, execution)
+
+	// executing the same txs again replaces the block
+	history.Add(BlockExecution{Height: 1, Iterations: 1, Txs: txs(0)})
+	execution, ok = history.Get(1)
+	require.True(t, ok)
+	require.Equal(t, BlockExecution{Height: 1, Iterations: 1, Txs: txs(0)}, execution)
+
+	// the lowest heights are dropped
+	history.Add(BlockExecution{Height: 3, Txs: txs(0)})
+	history.Add(BlockExecution{Height: 2, Txs: txs(0)})
+	_, ok = history.Get(1)
+	require.False(t, ok)
+	_, ok = history.Get(2)
+	require.True(t, ok)
+	_, ok = history.Get(3)
+	require.True(t, ok)
+}
@@ -56,6 +56,8 @@ type deliverTxTask struct {
 	Response      *types.ResponseDeliverTx
 	VersionStores map[sdk.StoreKey]*multiversion.VersionIndexedStore
 	TxTracer      sdk.TxTracer
+	// Aborts are the discarded executions of the task, kept for execution tracing
+	Aborts []TxAbort
 }
 
 // AppendDependencies appends the given indexes to the task's dependencies
@@ -98,6 +100,9 @@ func (dt *deliverTxTask) Increment() {
 // Scheduler processes tasks concurrently
 type Scheduler interface {
 	ProcessAll(ctx sdk.Context, reqs []*sdk.DeliverTxEntry) ([]types.ResponseDeliverTx, error)
+	// Execution returns how the txs of the last ProcessAll call were executed, which is only
+	// recorded by schedulers created with recordExecution set
+	Execution() BlockExecution
 }
 
 type scheduler struct {
@@ -112,15 +117,18 @@ type scheduler struct {
 	metrics            *schedulerMetrics
 	synchronous        bool // true if maxIncarnation exceeds threshold
 	maxIncarnation     int  // current highest incarnation
+	recordExecution    bool // whether aborts are described for Execution, which costs extra validation
+	execution          BlockExecution
 }
 
 // NewScheduler creates a new scheduler
-func NewScheduler(workers int, tracingInfo *tracing.Info, deliverTxFunc func(ctx sdk.Context, req types.RequestDeliverTx, tx sdk.Tx, checksum [32]byte) (res types.ResponseDeliverTx)) Scheduler {
+func NewScheduler(workers int, tracingInfo *tracing.Info, recordExecution bool, deliverTxFunc func(ctx sdk.Context, req types.RequestDeliverTx, tx sdk.Tx, checksum [32]byte) (res types.ResponseDeliverTx)) Scheduler {
 	return &scheduler{
-		workers:     workers,
-		deliverTx:   deliverTxFunc,
-		tracingInfo: tracingInfo,
-		metrics:     &schedulerMetrics{},
+		workers:         workers,
+		deliverTx:       deliverTxFunc,
+		tracingInfo:     tracingInfo,
+		metrics:         &schedulerMetrics{},
+		recordExecution: recordExecution,
 	}
 }
 
@@ -345,6 +353,9 @@ func (s *scheduler) ProcessAll(ctx sdk.Context, reqs []*sdk.DeliverTxEntry) ([]t
 		mv.WriteLatestToStore()
 	}
 	s.metrics.maxIncarnation = s.maxIncarnation
+	if s.recordExecution {
+		s.execution = s.collectExecution(ctx.BlockHeight(), iterations, tasks)
+	}
 
 	ctx.Logger().Info("occ scheduler", "height", ctx.BlockHeight(), "txs", len(tasks), "latency_ms", time.Since(startTime).Milliseconds(), "retries", s.metrics.retries, "maxIncarnation", s.maxIncarnation, "iterations", iterations, "sync", s.synchronous, "workers", s.workers)
 
@@ -363,6 +374,10 @@ func (s *scheduler) shouldRerun(task *deliverTxTask) bool {
 		// since we choose to fail fast and mark the subsequent tasks as invalid as well.
 		// TODO: in a future async scheduler that no longer exhaustively validates in order, we may need to carefully handle the `valid=true` with conflicts case
 		if valid, conflicts := s.findConflicts(task); !valid {
+			if s.recordExecution {
+				// conflicting keys have to be found before the readset is cleared
+				task.AppendAbort(s.validationAbort(task, conflicts))
+			}
 			s.invalidateTask(task)
 			task.AppendDependencies(conflicts)
 
@@ -561,6 +576,9 @@ func (s *scheduler) executeTask(task *deliverTxTask) {
 		task.SetStatus(statusAborted)
 		task.Abort = &abort
 		task.AppendDependencies([]int{abort.DependentTxIdx})
+		if s.recordExecution {
+			task.AppendAbort(s.estimateAbort(task, abort))
+		}
 		// write from version store to multiversion stores
 		for _, v := range task.VersionStores {
 			v.WriteEstimatesToMultiVersionStore()
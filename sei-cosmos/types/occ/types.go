@@ -11,12 +11,15 @@ var (
 // Abort contains the information for a transaction's conflict
 type Abort struct {
 	DependentTxIdx int
-	Err            error
+	// Key whose read caused the abort
+	Key []byte
+	Err error
 }
 
-func NewEstimateAbort(dependentTxIdx int) Abort {
+func NewEstimateAbort(dependentTxIdx int, key []byte) Abort {
 	return Abort{
 		DependentTxIdx: dependentTxIdx,
+		Key:            key,
 		Err:            ErrReadEstimate,
 	}
 }
@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/sei-protocol/sei-chain/tools/changelog-export/exporter"
+)
+
+func ExportChangelogCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-changelog",
+		Short: "A tool to export blocks, receipts, logs and state changes from the changelogs of a node into NDJSON files",
+		Run:   exportChangelog,
+	}
+	cmd.PersistentFlags().String("home-dir", "/root/.sei", "Sei home directory")
+	cmd.PersistentFlags().String("output-dir", "", "Directory to write the exported files to")
+	cmd.PersistentFlags().String("state-dir", "", "State file directory, the exporter will record the offsets to resume from, defaults to the output directory")
+	cmd.PersistentFlags().Int("batch-size", 1000, "Maximum number of blocks exported into a single file")
+	cmd.PersistentFlags().Int64("partition-size", 100_000, "Number of heights in each partition")
+	cmd.PersistentFlags().Duration("flush-interval", time.Minute, "How long to wait for a batch to fill up once the exporter has caught up")
+	cmd.PersistentFlags().Duration("poll-interval", time.Second, "How often to check for new changelog entries once the exporter has caught up")
+	cmd.PersistentFlags().String("stores", "evm,bank", "Comma separated list of stores to export state changes of, or empty for all stores")
+	return cmd
+}
+
+func exportChangelog(cmd *cobra.Command, _ []string) {
+	homeDir, _ := cmd.Flags().GetString("home-dir")
+	outputDir, _ := cmd.Flags().GetString("output-dir")
+	stateDir, _ := cmd.Flags().GetString("state-dir")
+	batchSize, _ := cmd.Flags().GetInt("batch-size")
+	partitionSize, _ := cmd.Flags().GetInt64("partition-size")
+	flushInterval, _ := cmd.Flags().GetDuration("flush-interval")
+	pollInterval, _ := cmd.Flags().GetDuration("poll-interval")
+	stores, _ := cmd.Flags().GetString("stores")
+	if outputDir == "" {
+		panic("--output-dir is required")
+	}
+	if stateDir == "" {
+		stateDir = outputDir
+	}
+	var storeList []string
+	for _, store := range strings.Split(stores, ",") {
+		if store = strings.TrimSpace(store); store != "" {
+			storeList = append(storeList, store)
+		}
+	}
+	e, err := exporter.NewExporter(exporter.Config{
+		HomeDir:       homeDir,
+		OutputDir:     outputDir,
+		StateDir:      stateDir,
+		BatchSize:     batchSize,
+		PartitionSize: partitionSize,
+		FlushInterval: flushInterval,
+		Stores:        storeList,
+	})
+	if err != nil {
+		panic(err)
+	}
+	current := e.State()
+	fmt.Printf("Starting the export from state changelog offset %d and receipt changelog offset %d\n", current.StateChangelogOffset, current.ReceiptChangelogOffset)
+	if err := e.Run(pollInterval); err != nil {
+		panic(err)
+	}
+}
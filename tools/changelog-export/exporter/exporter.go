@@ -0,0 +1,164 @@
+package exporter
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/sei-protocol/sei-chain/tools/changelog-export/reader"
+	"github.com/sei-protocol/sei-chain/tools/changelog-export/state"
+	"github.com/sei-protocol/sei-db/common/utils"
+	"github.com/sei-protocol/sei-db/proto"
+)
+
+type Config struct {
+	// Sei home directory of the node whose changelogs are exported
+	HomeDir   string
+	OutputDir string
+	// Directory of the state file recording the offsets to resume from
+	StateDir string
+	// Maximum number of changelog entries exported into a single file
+	BatchSize int
+	// Number of heights in each partition
+	PartitionSize int64
+	// How long entries are held back to fill a batch once the end of a changelog is reached
+	FlushInterval time.Duration
+	// Stores whose state changes are exported, all of them if empty
+	Stores []string
+}
+
+// Exporter tails the commitment store changelog, which has an entry for every block holding
+// the changes to each store, and the receipt store changelog, which has an entry for every
+// block with EVM txs holding their receipts.
+type Exporter struct {
+	writer  *Writer
+	config  Config
+	state   state.State
+	sources []*source
+}
+
+type source struct {
+	reader *reader.Reader
+	tables []string
+	decode func([]proto.ChangelogEntry) (Rows, error)
+	offset *uint64
+	// entries read but not yet exported, starting at index first
+	pending      []proto.ChangelogEntry
+	first        uint64
+	pendingSince time.Time
+}
+
+// NewExporter returns an exporter resuming from the offsets in the state file, removing any
+// file exported past them before the state file was last written
+func NewExporter(config Config) (*Exporter, error) {
+	if config.BatchSize <= 0 || config.PartitionSize <= 0 {
+		return nil, fmt.Errorf("batch size and partition size must be positive")
+	}
+	exportState, err := state.ReadState(config.StateDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read state: %w", err)
+	}
+	e := &Exporter{
+		writer: NewWriter(config.OutputDir, config.PartitionSize),
+		config: config,
+		state:  exportState,
+	}
+	stores := map[string]bool{}
+	for _, store := range config.Stores {
+		stores[store] = true
+	}
+	e.sources = []*source{
+		{
+			reader: reader.NewReader(utils.GetChangelogPath(utils.GetCommitStorePath(config.HomeDir)), e.state.StateChangelogOffset),
+			tables: []string{StateChangesTable},
+			decode: func(entries []proto.ChangelogEntry) (Rows, error) {
+				return DecodeStateChanges(entries, stores), nil
+			},
+			offset: &e.state.StateChangelogOffset,
+		},
+		{
+			reader: reader.NewReader(utils.GetChangelogPath(filepath.Join(config.HomeDir, "data", "receipt.db")), e.state.ReceiptChangelogOffset),
+			tables: []string{BlocksTable, TransactionsTable, ReceiptsTable, LogsTable},
+			decode: DecodeReceipts,
+			offset: &e.state.ReceiptChangelogOffset,
+		},
+	}
+	for _, src := range e.sources {
+		if err := e.writer.RemoveFrom(src.tables, *src.offset); err != nil {
+			return nil, fmt.Errorf("failed to remove files past the stored offset: %w", err)
+		}
+	}
+	return e, nil
+}
+
+// Run exports new changelog entries every poll interval until an error occurs
+func (e *Exporter) Run(pollInterval time.Duration) error {
+	for {
+		exported, err := e.Poll()
+		if err != nil {
+			return err
+		}
+		if exported == 0 {
+			time.Sleep(pollInterval)
+		}
+	}
+}
+
+// Poll reads the entries appended to each changelog since the last poll and exports those
+// that fill a batch, complete a partition or have been held back for the flush interval.
+// It returns the number of entries exported.
+func (e *Exporter) Poll() (int, error) {
+	exported := 0
+	for _, src := range e.sources {
+		first, entries, err := src.reader.Read(e.config.BatchSize - len(src.pending))
+		if err != nil {
+			return exported, err
+		}
+		if len(src.pending) == 0 {
+			src.first = first
+			src.pendingSince = time.Now()
+		}
+		for _, entry := range entries {
+			if len(src.pending) > 0 && e.writer.Partition(entry.Version) != e.writer.Partition(src.pending[0].Version) {
+				n, err := e.flush(src)
+				exported += n
+				if err != nil {
+					return exported, err
+				}
+			}
+			src.pending = append(src.pending, entry)
+		}
+		if len(src.pending) >= e.config.BatchSize || (len(src.pending) > 0 && time.Since(src.pendingSince) >= e.config.FlushInterval) {
+			n, err := e.flush(src)
+			exported += n
+			if err != nil {
+				return exported, err
+			}
+		}
+	}
+	return exported, nil
+}
+
+// flush exports the pending entries of a source and records the offset past them
+func (e *Exporter) flush(src *source) (int, error) {
+	rows, err := src.decode(src.pending)
+	if err != nil {
+		return 0, err
+	}
+	last := src.first + uint64(len(src.pending)) - 1
+	if err := e.writer.Write(e.writer.Partition(src.pending[0].Version), src.first, last, rows); err != nil {
+		return 0, err
+	}
+	*src.offset = last + 1
+	if err := state.WriteState(e.config.StateDir, e.state); err != nil {
+		return 0, fmt.Errorf("failed to write state: %w", err)
+	}
+	exported := len(src.pending)
+	src.pending, src.first, src.pendingSince = nil, last+1, time.Now()
+	return exported, nil
+}
+
+// State returns the offsets exported so far
+func (e *Exporter) State() state.State {
+	return e.state
+}
@@ -0,0 +1,144 @@
+package exporter
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/iavl"
+	"github.com/ethereum/go-ethereum/common"
+	evmtypes "github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/sei-protocol/sei-db/common/utils"
+	"github.com/sei-protocol/sei-db/proto"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/wal"
+)
+
+func writeChangelog(t *testing.T, dir string, entries ...proto.ChangelogEntry) {
+	log, err := wal.Open(dir, nil)
+	require.NoError(t, err)
+	defer log.Close()
+	for _, entry := range entries {
+		bz, err := entry.Marshal()
+		require.NoError(t, err)
+		index, err := log.LastIndex()
+		require.NoError(t, err)
+		require.NoError(t, log.Write(index+1, bz))
+	}
+}
+
+func changelogEntry(version int64, store string, pairs ...*iavl.KVPair) proto.ChangelogEntry {
+	return proto.ChangelogEntry{
+		Version:    version,
+		Changesets: []*proto.NamedChangeSet{{Name: store, Changeset: iavl.ChangeSet{Pairs: pairs}}},
+	}
+}
+
+func readRows[T any](t *testing.T, file string) []T {
+	f, err := os.Open(file)
+	require.NoError(t, err)
+	defer f.Close()
+	var rows []T
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var row T
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &row))
+		rows = append(rows, row)
+	}
+	require.NoError(t, scanner.Err())
+	return rows
+}
+
+func TestExport(t *testing.T) {
+	home := t.TempDir()
+	out := t.TempDir()
+	evmAddr := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	seiAddr := sdk.AccAddress(evmAddr[:])
+	slot := common.HexToHash("0x02")
+	nonce := make([]byte, 8)
+	binary.BigEndian.PutUint64(nonce, 7)
+	balance, err := (&sdk.Coin{Denom: "usei", Amount: sdk.NewInt(42)}).Marshal()
+	require.NoError(t, err)
+	writeChangelog(t, utils.GetChangelogPath(utils.GetCommitStorePath(home)),
+		changelogEntry(1, evmtypes.StoreKey,
+			&iavl.KVPair{Key: append(evmtypes.StateKey(evmAddr), slot[:]...), Value: []byte{1}},
+			&iavl.KVPair{Key: append(evmtypes.NonceKeyPrefix, evmAddr[:]...), Value: nonce},
+		),
+		changelogEntry(2, banktypes.StoreKey,
+			&iavl.KVPair{Key: append(banktypes.CreateAccountBalancesPrefix(seiAddr), "usei"...), Value: balance},
+		),
+		changelogEntry(3, "wasm", &iavl.KVPair{Key: []byte{1}, Value: []byte{2}}),
+		changelogEntry(4, evmtypes.StoreKey, &iavl.KVPair{Key: append(evmtypes.CodeKeyPrefix, evmAddr[:]...), Delete: true}),
+	)
+	txHash := common.HexToHash("0xabcd")
+	receipt, err := (&evmtypes.Receipt{
+		TxHashHex:        txHash.Hex(),
+		BlockNumber:      3,
+		TransactionIndex: 1,
+		Status:           1,
+		GasUsed:          21000,
+		From:             evmAddr.Hex(),
+		Logs:             []*evmtypes.Log{{Address: evmAddr.Hex(), Topics: []string{slot.Hex()}, Data: []byte{1}, Index: 0}},
+	}).Marshal()
+	require.NoError(t, err)
+	writeChangelog(t, utils.GetChangelogPath(filepath.Join(home, "data", "receipt.db")),
+		changelogEntry(3, evmtypes.ReceiptStoreKey,
+			&iavl.KVPair{Key: evmtypes.ReceiptKey(txHash), Value: receipt},
+			// log index entries are skipped
+			&iavl.KVPair{Key: append(evmtypes.LogIndexPrefix, address.MustLengthPrefix(evmAddr[:])...), Value: []byte{}},
+		),
+	)
+
+	config := Config{HomeDir: home, OutputDir: out, StateDir: out, BatchSize: 10, PartitionSize: 2, Stores: []string{evmtypes.StoreKey, banktypes.StoreKey}}
+	e, err := NewExporter(config)
+	require.NoError(t, err)
+	exported, err := e.Poll()
+	require.NoError(t, err)
+	require.Equal(t, 5, exported)
+	require.Equal(t, uint64(5), e.State().StateChangelogOffset)
+	require.Equal(t, uint64(2), e.State().ReceiptChangelogOffset)
+
+	// entries are split by partition
+	changes := readRows[StateChange](t, filepath.Join(out, StateChangesTable, "height=0", "1-1.ndjson"))
+	require.Equal(t, []StateChange{
+		{Height: 1, Store: "evm", Key: changes[0].Key, Value: "0x01", Kind: KindStorage, Address: evmAddr.Hex(), Slot: slot.Hex()},
+		{Height: 1, Store: "evm", Key: changes[1].Key, Value: "0x0000000000000007", Kind: KindNonce, Address: evmAddr.Hex(), Decoded: "7"},
+	}, changes)
+	changes = readRows[StateChange](t, filepath.Join(out, StateChangesTable, "height=2", "2-3.ndjson"))
+	require.Len(t, changes, 1, "changes of other stores are skipped")
+	require.Equal(t, KindBalance, changes[0].Kind)
+	require.Equal(t, seiAddr.String(), changes[0].Address)
+	require.Equal(t, "usei", changes[0].Denom)
+	require.Equal(t, "42", changes[0].Decoded)
+	changes = readRows[StateChange](t, filepath.Join(out, StateChangesTable, "height=4", "4-4.ndjson"))
+	require.Len(t, changes, 1)
+	require.True(t, changes[0].Deleted)
+	require.Equal(t, KindCode, changes[0].Kind)
+
+	blocks := readRows[Block](t, filepath.Join(out, BlocksTable, "height=2", "1-1.ndjson"))
+	require.Equal(t, []Block{{Height: 3, TxCount: 1, GasUsed: 21000, LogCount: 1}}, blocks)
+	txs := readRows[Transaction](t, filepath.Join(out, TransactionsTable, "height=2", "1-1.ndjson"))
+	require.Equal(t, []Transaction{{Height: 3, TxIndex: 1, Hash: txHash.Hex(), From: evmAddr.Hex()}}, txs)
+	receipts := readRows[Receipt](t, filepath.Join(out, ReceiptsTable, "height=2", "1-1.ndjson"))
+	require.Len(t, receipts, 1)
+	require.Equal(t, uint32(1), receipts[0].Status)
+	logs := readRows[Log](t, filepath.Join(out, LogsTable, "height=2", "1-1.ndjson"))
+	require.Equal(t, []Log{{Height: 3, TxIndex: 1, TxHash: txHash.Hex(), Address: evmAddr.Hex(), Topics: []string{slot.Hex()}, Data: "0x01"}}, logs)
+
+	// files exported past the stored offsets, e.g. before a crash, are removed on resume
+	stale := filepath.Join(out, StateChangesTable, "height=4", "5-5.ndjson")
+	require.NoError(t, os.WriteFile(stale, []byte("{}\n"), 0600))
+	e, err = NewExporter(config)
+	require.NoError(t, err)
+	require.NoFileExists(t, stale)
+	require.FileExists(t, filepath.Join(out, StateChangesTable, "height=4", "4-4.ndjson"))
+	exported, err = e.Poll()
+	require.NoError(t, err)
+	require.Zero(t, exported)
+}
@@ -0,0 +1,275 @@
+package exporter
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	evmtypes "github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/sei-protocol/sei-db/proto"
+)
+
+// Tables exported from each changelog
+const (
+	BlocksTable       = "blocks"
+	TransactionsTable = "transactions"
+	ReceiptsTable     = "receipts"
+	LogsTable         = "logs"
+	StateChangesTable = "state_changes"
+)
+
+// Kinds of decoded state changes
+const (
+	KindStorage            = "storage"
+	KindCode               = "code"
+	KindCodeHash           = "code_hash"
+	KindCodeSize           = "code_size"
+	KindNonce              = "nonce"
+	KindAddressAssociation = "address_association"
+	KindBalance            = "balance"
+	KindWeiBalance         = "wei_balance"
+)
+
+var evmAddressKinds = map[byte]string{
+	evmtypes.CodeKeyPrefix[0]:                   KindCode,
+	evmtypes.CodeHashKeyPrefix[0]:               KindCodeHash,
+	evmtypes.CodeSizeKeyPrefix[0]:               KindCodeSize,
+	evmtypes.NonceKeyPrefix[0]:                  KindNonce,
+	evmtypes.EVMAddressToSeiAddressKeyPrefix[0]: KindAddressAssociation,
+}
+
+// Block only covers what the receipt store knows about a block, which are its EVM txs
+type Block struct {
+	Height   int64  `json:"height"`
+	TxCount  int    `json:"tx_count"`
+	GasUsed  uint64 `json:"gas_used"`
+	LogCount int    `json:"log_count"`
+}
+
+type Transaction struct {
+	Height            int64  `json:"height"`
+	TxIndex           uint32 `json:"tx_index"`
+	Hash              string `json:"hash"`
+	Type              uint32 `json:"type"`
+	From              string `json:"from"`
+	To                string `json:"to,omitempty"`
+	EffectiveGasPrice uint64 `json:"effective_gas_price"`
+}
+
+type Receipt struct {
+	Height            int64  `json:"height"`
+	TxIndex           uint32 `json:"tx_index"`
+	TxHash            string `json:"tx_hash"`
+	Status            uint32 `json:"status"`
+	GasUsed           uint64 `json:"gas_used"`
+	CumulativeGasUsed uint64 `json:"cumulative_gas_used"`
+	ContractAddress   string `json:"contract_address,omitempty"`
+	VMError           string `json:"vm_error,omitempty"`
+	LogsBloom         string `json:"logs_bloom"`
+	LogCount          int    `json:"log_count"`
+}
+
+type Log struct {
+	Height    int64    `json:"height"`
+	TxIndex   uint32   `json:"tx_index"`
+	TxHash    string   `json:"tx_hash"`
+	LogIndex  uint32   `json:"log_index"`
+	Address   string   `json:"address"`
+	Topics    []string `json:"topics"`
+	Data      string   `json:"data"`
+	Synthetic bool     `json:"synthetic"`
+}
+
+// StateChange is a key written or deleted in a block. Keys of the evm and bank stores are
+// decoded into the account they belong to, and values that aren't raw bytes into Decoded.
+type StateChange struct {
+	Height  int64  `json:"height"`
+	Store   string `json:"store"`
+	Key     string `json:"key"`
+	Value   string `json:"value,omitempty"`
+	Deleted bool   `json:"deleted"`
+	Kind    string `json:"kind,omitempty"`
+	Address string `json:"address,omitempty"`
+	Slot    string `json:"slot,omitempty"`
+	Denom   string `json:"denom,omitempty"`
+	Decoded string `json:"decoded,omitempty"`
+}
+
+// Rows are the rows of each table decoded from a batch of changelog entries
+type Rows map[string][]interface{}
+
+// DecodeReceipts decodes entries of the receipt store changelog, skipping the log index
+// entries kept alongside the receipts
+func DecodeReceipts(entries []proto.ChangelogEntry) (Rows, error) {
+	rows := Rows{}
+	for _, entry := range entries {
+		var receipts []evmtypes.Receipt
+		for _, cs := range entry.Changesets {
+			if cs.Name != evmtypes.ReceiptStoreKey {
+				continue
+			}
+			for _, pair := range cs.Changeset.Pairs {
+				if pair.Delete || len(pair.Key) != len(evmtypes.ReceiptKeyPrefix)+common.HashLength || !bytes.HasPrefix(pair.Key, evmtypes.ReceiptKeyPrefix) {
+					continue
+				}
+				var receipt evmtypes.Receipt
+				if err := receipt.Unmarshal(pair.Value); err != nil {
+					return nil, fmt.Errorf("failed to decode receipt %X at height %d: %w", pair.Key, entry.Version, err)
+				}
+				receipts = append(receipts, receipt)
+			}
+		}
+		if len(receipts) == 0 {
+			continue
+		}
+		sort.SliceStable(receipts, func(i, j int) bool { return receipts[i].TransactionIndex < receipts[j].TransactionIndex })
+		block := Block{Height: entry.Version, TxCount: len(receipts)}
+		for _, receipt := range receipts {
+			block.GasUsed += receipt.GasUsed
+			block.LogCount += len(receipt.Logs)
+			rows[TransactionsTable] = append(rows[TransactionsTable], Transaction{
+				Height:            entry.Version,
+				TxIndex:           receipt.TransactionIndex,
+				Hash:              receipt.TxHashHex,
+				Type:              receipt.TxType,
+				From:              receipt.From,
+				To:                receipt.To,
+				EffectiveGasPrice: receipt.EffectiveGasPrice,
+			})
+			rows[ReceiptsTable] = append(rows[ReceiptsTable], Receipt{
+				Height:            entry.Version,
+				TxIndex:           receipt.TransactionIndex,
+				TxHash:            receipt.TxHashHex,
+				Status:            receipt.Status,
+				GasUsed:           receipt.GasUsed,
+				CumulativeGasUsed: receipt.CumulativeGasUsed,
+				ContractAddress:   receipt.ContractAddress,
+				VMError:           receipt.VmError,
+				LogsBloom:         hexutil.Encode(receipt.LogsBloom),
+				LogCount:          len(receipt.Logs),
+			})
+			for _, log := range receipt.Logs {
+				topics := log.Topics
+				if topics == nil {
+					topics = []string{}
+				}
+				rows[LogsTable] = append(rows[LogsTable], Log{
+					Height:    entry.Version,
+					TxIndex:   receipt.TransactionIndex,
+					TxHash:    receipt.TxHashHex,
+					LogIndex:  log.Index,
+					Address:   log.Address,
+					Topics:    topics,
+					Data:      hexutil.Encode(log.Data),
+					Synthetic: log.Synthetic,
+				})
+			}
+		}
+		rows[BlocksTable] = append(rows[BlocksTable], block)
+	}
+	return rows, nil
+}
+
+// DecodeStateChanges decodes entries of the commitment store changelog, only keeping the
+// changes of the given stores if any are given
+func DecodeStateChanges(entries []proto.ChangelogEntry, stores map[string]bool) Rows {
+	rows := Rows{}
+	for _, entry := range entries {
+		for _, cs := range entry.Changesets {
+			if len(stores) > 0 && !stores[cs.Name] {
+				continue
+			}
+			for _, pair := range cs.Changeset.Pairs {
+				change := StateChange{
+					Height:  entry.Version,
+					Store:   cs.Name,
+					Key:     hexutil.Encode(pair.Key),
+					Deleted: pair.Delete,
+				}
+				if !pair.Delete {
+					change.Value = hexutil.Encode(pair.Value)
+				}
+				switch cs.Name {
+				case evmtypes.StoreKey:
+					decodeEVMChange(&change, pair.Key, pair.Value)
+				case banktypes.StoreKey:
+					decodeBankChange(&change, pair.Key, pair.Value)
+				}
+				rows[StateChangesTable] = append(rows[StateChangesTable], change)
+			}
+		}
+	}
+	return rows
+}
+
+func decodeEVMChange(change *StateChange, key []byte, value []byte) {
+	if len(key) == 0 {
+		return
+	}
+	prefix, rest := key[:1], key[1:]
+	switch {
+	case bytes.Equal(prefix, evmtypes.StateKeyPrefix) && len(rest) == common.AddressLength+common.HashLength:
+		change.Kind = KindStorage
+		change.Address = common.BytesToAddress(rest[:common.AddressLength]).Hex()
+		change.Slot = common.BytesToHash(rest[common.AddressLength:]).Hex()
+	case bytes.Equal(prefix, evmtypes.SeiAddressToEVMAddressKeyPrefix):
+		change.Kind = KindAddressAssociation
+		change.Address = sdk.AccAddress(rest).String()
+		if len(value) == common.AddressLength {
+			change.Decoded = common.BytesToAddress(value).Hex()
+		}
+	case len(rest) == common.AddressLength:
+		// the remaining decoded keys are all keyed by an EVM address
+		kind, ok := evmAddressKinds[prefix[0]]
+		if !ok {
+			return
+		}
+		change.Kind = kind
+		change.Address = common.BytesToAddress(rest).Hex()
+		switch kind {
+		case KindCodeSize, KindNonce:
+			change.Decoded = decodeUint64(value)
+		case KindAddressAssociation:
+			if len(value) > 0 {
+				change.Decoded = sdk.AccAddress(value).String()
+			}
+		}
+	}
+}
+
+func decodeBankChange(change *StateChange, key []byte, value []byte) {
+	switch {
+	case bytes.HasPrefix(key, banktypes.BalancesPrefix):
+		addr, err := banktypes.AddressFromBalancesStore(key[len(banktypes.BalancesPrefix):])
+		if err != nil {
+			return
+		}
+		change.Kind = KindBalance
+		change.Address = addr.String()
+		change.Denom = string(key[len(banktypes.BalancesPrefix)+1+len(addr):])
+		var coin sdk.Coin
+		if len(value) > 0 && coin.Unmarshal(value) == nil && !coin.Amount.IsNil() {
+			change.Decoded = coin.Amount.String()
+		}
+	case bytes.HasPrefix(key, banktypes.WeiBalancesPrefix):
+		change.Kind = KindWeiBalance
+		change.Address = sdk.AccAddress(key[len(banktypes.WeiBalancesPrefix):]).String()
+		var amount sdk.Int
+		if len(value) > 0 && amount.Unmarshal(value) == nil {
+			change.Decoded = amount.String()
+		}
+	}
+}
+
+func decodeUint64(value []byte) string {
+	if len(value) != 8 {
+		return ""
+	}
+	return strconv.FormatUint(binary.BigEndian.Uint64(value), 10)
+}
@@ -0,0 +1,98 @@
+package exporter
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const fileExt = ".ndjson"
+
+// Writer writes each table as newline delimited JSON files partitioned by height:
+//
+//	<dir>/<table>/height=<first height of the partition>/<first index>-<last index>.ndjson
+//
+// where the indexes are those of the changelog entries the rows were decoded from, so that
+// files exported past a stored offset can be found and removed when resuming.
+type Writer struct {
+	dir           string
+	partitionSize int64
+}
+
+func NewWriter(dir string, partitionSize int64) *Writer {
+	return &Writer{dir: dir, partitionSize: partitionSize}
+}
+
+// Partition returns the first height of the partition the given height belongs to
+func (w *Writer) Partition(height int64) int64 {
+	return height - height%w.partitionSize
+}
+
+// Write writes the rows of each table decoded from changelog entries first to last, which
+// must all be in the same partition. Files are renamed into place once fully written.
+func (w *Writer) Write(partition int64, first uint64, last uint64, rows Rows) error {
+	for table, tableRows := range rows {
+		if len(tableRows) == 0 {
+			continue
+		}
+		dir := filepath.Join(w.dir, table, fmt.Sprintf("height=%d", partition))
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return err
+		}
+		filename := filepath.Join(dir, fmt.Sprintf("%d-%d%s", first, last, fileExt))
+		if err := writeRows(filename+".tmp", tableRows); err != nil {
+			return fmt.Errorf("failed to write %s: %w", filename, err)
+		}
+		if err := os.Rename(filename+".tmp", filename); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RemoveFrom removes the files of the given tables holding rows decoded from changelog
+// entries at or after the given index, along with any partially written file
+func (w *Writer) RemoveFrom(tables []string, index uint64) error {
+	for _, table := range tables {
+		files, err := filepath.Glob(filepath.Join(w.dir, table, "height=*", "*"))
+		if err != nil {
+			return err
+		}
+		for _, file := range files {
+			name := filepath.Base(file)
+			if !strings.HasSuffix(name, ".tmp") {
+				first, err := strconv.ParseUint(strings.SplitN(name, "-", 2)[0], 10, 64)
+				if err != nil || first < index {
+					continue
+				}
+			}
+			if err := os.Remove(file); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func writeRows(filename string, rows []interface{}) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	buf := bufio.NewWriter(file)
+	encoder := json.NewEncoder(buf)
+	for _, row := range rows {
+		if err := encoder.Encode(row); err != nil {
+			return err
+		}
+	}
+	if err := buf.Flush(); err != nil {
+		return err
+	}
+	return file.Sync()
+}
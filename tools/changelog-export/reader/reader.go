@@ -0,0 +1,149 @@
+package reader
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"github.com/sei-protocol/sei-db/proto"
+)
+
+// segmentNameLen is the length of a segment file name, which is its first index padded to 20 digits
+const segmentNameLen = 20
+
+// Reader tails a changelog written by a sei-db changelog.Stream. It only ever opens segment
+// files for reading: opening the log with changelog.NewStream or wal.Open would open the last
+// segment for writing and truncate a partially written tail, neither of which may happen to
+// the log of a running node.
+type Reader struct {
+	dir string
+	// index of the next entry to return
+	next uint64
+	// first index of the segment being read and the index and byte offset of the entry at pos
+	segment  uint64
+	posIndex uint64
+	pos      int64
+}
+
+// NewReader returns a reader of the changelog in dir starting at the given index. A zero
+// index starts at the first entry still in the log.
+func NewReader(dir string, next uint64) *Reader {
+	return &Reader{dir: dir, next: next}
+}
+
+// Next returns the index of the next entry to be read
+func (r *Reader) Next() uint64 {
+	return r.next
+}
+
+// Read returns up to limit entries starting at the next index along with the index of the first
+// one. It returns fewer entries once it reaches the end of the log, which is where the entry
+// currently being written is as well.
+func (r *Reader) Read(limit int) (uint64, []proto.ChangelogEntry, error) {
+	first := r.next
+	segments, err := listSegments(r.dir)
+	if err != nil || len(segments) == 0 {
+		return first, nil, err
+	}
+	if r.next == 0 {
+		r.next, first = segments[0], segments[0]
+	}
+	if r.next < segments[0] {
+		return first, nil, fmt.Errorf("changelog entry %d was pruned, the log in %s starts at %d", r.next, r.dir, segments[0])
+	}
+	var entries []proto.ChangelogEntry
+	for len(entries) < limit {
+		i := sort.Search(len(segments), func(i int) bool { return segments[i] > r.next }) - 1
+		if segments[i] != r.segment {
+			r.segment, r.posIndex, r.pos = segments[i], segments[i], 0
+		}
+		read, complete, err := r.readSegment(limit - len(entries))
+		if err != nil {
+			return first, entries, err
+		}
+		entries = append(entries, read...)
+		// the rest of the segment is still being written
+		if !complete || len(entries) == limit {
+			break
+		}
+		if i+1 == len(segments) {
+			break
+		}
+		if segments[i+1] != r.next {
+			return first, entries, fmt.Errorf("changelog in %s is missing entries %d to %d", r.dir, r.next, segments[i+1]-1)
+		}
+	}
+	return first, entries, nil
+}
+
+// readSegment reads up to limit entries from the current segment and returns whether it reached
+// the end of the segment without finding a partially written entry
+func (r *Reader) readSegment(limit int) ([]proto.ChangelogEntry, bool, error) {
+	file, err := os.Open(segmentPath(r.dir, r.segment))
+	if err != nil {
+		return nil, false, err
+	}
+	defer file.Close()
+	if _, err := file.Seek(r.pos, io.SeekStart); err != nil {
+		return nil, false, err
+	}
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, false, err
+	}
+	var entries []proto.ChangelogEntry
+	for len(entries) < limit {
+		if len(data) == 0 {
+			return entries, true, nil
+		}
+		size, n := binary.Uvarint(data)
+		if n <= 0 || uint64(len(data)-n) < size {
+			return entries, false, nil
+		}
+		if r.posIndex >= r.next {
+			var entry proto.ChangelogEntry
+			if err := entry.Unmarshal(data[n : n+int(size)]); err != nil {
+				return entries, false, fmt.Errorf("failed to decode changelog entry %d: %w", r.posIndex, err)
+			}
+			entries = append(entries, entry)
+			r.next++
+		}
+		data = data[n+int(size):]
+		r.pos += int64(n) + int64(size)
+		r.posIndex++
+	}
+	return entries, len(data) == 0, nil
+}
+
+// listSegments returns the first index of each segment of the log in ascending order, ignoring
+// the temporary files left while the log is being truncated
+func listSegments(dir string) ([]uint64, error) {
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var segments []uint64
+	for _, file := range files {
+		if file.IsDir() || len(file.Name()) != segmentNameLen {
+			continue
+		}
+		index, err := strconv.ParseUint(file.Name(), 10, 64)
+		if err != nil || index == 0 {
+			continue
+		}
+		segments = append(segments, index)
+	}
+	sort.Slice(segments, func(i, j int) bool { return segments[i] < segments[j] })
+	return segments, nil
+}
+
+func segmentPath(dir string, index uint64) string {
+	return filepath.Join(dir, fmt.Sprintf("%020d", index))
+}
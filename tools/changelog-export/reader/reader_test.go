@@ -0,0 +1,109 @@
+package reader
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sei-protocol/sei-db/proto"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/wal"
+)
+
+func writeEntries(t *testing.T, log *wal.Log, versions ...int64) {
+	for _, version := range versions {
+		entry := proto.ChangelogEntry{Version: version}
+		bz, err := entry.Marshal()
+		require.NoError(t, err)
+		// entries of the changelogs are indexed from 1 regardless of their version
+		index, err := log.LastIndex()
+		require.NoError(t, err)
+		require.NoError(t, log.Write(index+1, bz))
+	}
+}
+
+func versions(entries []proto.ChangelogEntry) []int64 {
+	res := []int64{}
+	for _, entry := range entries {
+		res = append(res, entry.Version)
+	}
+	return res
+}
+
+func TestReaderTailsLog(t *testing.T) {
+	dir := t.TempDir()
+	// tiny segments so that the log spans several of them
+	log, err := wal.Open(dir, &wal.Options{SegmentSize: 8})
+	require.NoError(t, err)
+	defer log.Close()
+	writeEntries(t, log, 10, 11, 12, 13, 14)
+
+	r := NewReader(dir, 0)
+	first, entries, err := r.Read(3)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), first)
+	require.Equal(t, []int64{10, 11, 12}, versions(entries))
+	first, entries, err = r.Read(10)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), first)
+	require.Equal(t, []int64{13, 14}, versions(entries))
+	_, entries, err = r.Read(10)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	writeEntries(t, log, 15, 16)
+	first, entries, err = r.Read(10)
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), first)
+	require.Equal(t, []int64{15, 16}, versions(entries))
+	require.Equal(t, uint64(8), r.Next())
+}
+
+func TestReaderPartialEntry(t *testing.T) {
+	dir := t.TempDir()
+	log, err := wal.Open(dir, nil)
+	require.NoError(t, err)
+	writeEntries(t, log, 1)
+	require.NoError(t, log.Close())
+	// an entry announcing 100 bytes of which only a few are written yet
+	segment, err := os.OpenFile(filepath.Join(dir, "00000000000000000001"), os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = segment.Write([]byte{100, 1, 2, 3})
+	require.NoError(t, err)
+	require.NoError(t, segment.Close())
+
+	r := NewReader(dir, 0)
+	_, entries, err := r.Read(10)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1}, versions(entries))
+	_, entries, err = r.Read(10)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+	require.Equal(t, uint64(2), r.Next())
+}
+
+func TestReaderResume(t *testing.T) {
+	dir := t.TempDir()
+	log, err := wal.Open(dir, &wal.Options{SegmentSize: 8})
+	require.NoError(t, err)
+	defer log.Close()
+	writeEntries(t, log, 1, 2, 3, 4, 5)
+
+	first, entries, err := NewReader(dir, 4).Read(10)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), first)
+	require.Equal(t, []int64{4, 5}, versions(entries))
+
+	require.NoError(t, log.TruncateFront(3))
+	_, _, err = NewReader(dir, 2).Read(10)
+	require.Error(t, err)
+	first, entries, err = NewReader(dir, 0).Read(10)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), first)
+	require.Equal(t, []int64{3, 4, 5}, versions(entries))
+
+	// a log that doesn't exist yet has no entries
+	_, entries, err = NewReader(filepath.Join(dir, "missing"), 0).Read(10)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
@@ -0,0 +1,47 @@
+package state
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+const stateFile = "changelog-export-state.json"
+
+// State records the next changelog entry to export from each log, which are WAL indexes
+// rather than heights since the receipt changelog has no entry for blocks without receipts
+type State struct {
+	StateChangelogOffset   uint64 `json:"state_changelog_offset"`
+	ReceiptChangelogOffset uint64 `json:"receipt_changelog_offset"`
+}
+
+// WriteState writes the state to a JSON file. The file is replaced atomically so that an
+// interrupted write never loses the offsets.
+func WriteState(dir string, s State) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	filename := filepath.Join(dir, stateFile)
+	if err := os.WriteFile(filename+".tmp", data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(filename+".tmp", filename)
+}
+
+// ReadState reads the state from a JSON file, returning an empty state if there is none yet
+func ReadState(dir string) (State, error) {
+	state := State{}
+	data, err := os.ReadFile(filepath.Join(dir, stateFile))
+	if os.IsNotExist(err) {
+		return state, nil
+	}
+	if err != nil {
+		return state, err
+	}
+	err = json.Unmarshal(data, &state)
+	return state, err
+}
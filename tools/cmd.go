@@ -3,6 +3,7 @@ package tools
 import (
 	"github.com/spf13/cobra"
 
+	export "github.com/sei-protocol/sei-chain/tools/changelog-export/cmd"
 	hasher "github.com/sei-protocol/sei-chain/tools/hash_verification/cmd"
 	migration "github.com/sei-protocol/sei-chain/tools/migration/cmd"
 	scanner "github.com/sei-protocol/sei-chain/tools/tx-scanner/cmd"
@@ -19,5 +20,6 @@ func ToolCmd() *cobra.Command {
 	toolsCmd.AddCommand(migration.GenerateStats())
 	toolsCmd.AddCommand(hasher.GenerateIavlHashCmd())
 	toolsCmd.AddCommand(hasher.GeneratePebbleHashCmd())
+	toolsCmd.AddCommand(export.ExportChangelogCmd())
 	return toolsCmd
 }
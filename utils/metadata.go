@@ -1,7 +1,32 @@
 package utils
 
+import (
+	"math"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
 type ERCMetadata struct {
 	Name     string
 	Symbol   string
 	Decimals uint8
 }
+
+// ERCMetadataFromDenomMetadata derives the ERC metadata of a native pointer from the bank
+// metadata of its denom. The denom unit with the largest exponent that fits in a uint8 sets
+// the decimals and, through its first alias or its denom, the name and symbol. Without such
+// a unit the metadata name and symbol are used with 0 decimals.
+func ERCMetadataFromDenomMetadata(metadata banktypes.Metadata) ERCMetadata {
+	res := ERCMetadata{Name: metadata.Name, Symbol: metadata.Symbol}
+	for _, denomUnit := range metadata.DenomUnits {
+		if denomUnit.Exponent > uint32(res.Decimals) && denomUnit.Exponent <= math.MaxUint8 {
+			res.Decimals = uint8(denomUnit.Exponent)
+			res.Name = denomUnit.Denom
+			res.Symbol = denomUnit.Denom
+			if len(denomUnit.Aliases) > 0 {
+				res.Name = denomUnit.Aliases[0]
+			}
+		}
+	}
+	return res
+}
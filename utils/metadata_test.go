@@ -3,6 +3,7 @@ package utils
 import (
 	"testing"
 
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -127,3 +128,26 @@ func TestERCMetadataComparison(t *testing.T) {
 	require.Equal(t, metadata1, metadata2)
 	require.NotEqual(t, metadata1, metadata3)
 }
+
+func TestERCMetadataFromDenomMetadata(t *testing.T) {
+	// only the base unit
+	require.Equal(t, ERCMetadata{Name: "Test", Symbol: "TST"}, ERCMetadataFromDenomMetadata(banktypes.Metadata{
+		Base:       "utest",
+		Name:       "Test",
+		Symbol:     "TST",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "utest", Exponent: 0}},
+	}))
+
+	// the largest exponent that fits in a uint8 wins and its first alias names the token
+	require.Equal(t, ERCMetadata{Name: "test", Symbol: "TEST", Decimals: 6}, ERCMetadataFromDenomMetadata(banktypes.Metadata{
+		Base:   "utest",
+		Name:   "Test",
+		Symbol: "TST",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "utest", Exponent: 0},
+			{Denom: "mtest", Exponent: 3},
+			{Denom: "TEST", Exponent: 6, Aliases: []string{"test", "Test"}},
+			{Denom: "HUGE", Exponent: 300},
+		},
+	}))
+}
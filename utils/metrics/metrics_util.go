@@ -310,6 +310,37 @@ func IncrementRpcRequestCounter(endpoint string, connectionType string, success
 	)
 }
 
+// Measures the number of RPC requests rejected by the per-client rate limiter
+// Metric Name:
+//
+//	sei_rpc_rate_limited_counter
+func IncrementRpcRateLimitedCounter(endpoint string, connectionType string, keyType string) {
+	SafeTelemetryIncrCounterWithLabels(
+		[]string{"sei", "rpc", "rate_limited", "counter"},
+		float32(1),
+		[]metrics.Label{
+			telemetry.NewLabel("endpoint", endpoint),
+			telemetry.NewLabel("connection", connectionType),
+			telemetry.NewLabel("key_type", keyType),
+		},
+	)
+}
+
+// Measures the lookups of the RPC response cache, labeled by whether they hit
+// Metric Name:
+//
+//	sei_rpc_response_cache_counter
+func IncrementRpcResponseCacheCounter(endpoint string, hit bool) {
+	SafeTelemetryIncrCounterWithLabels(
+		[]string{"sei", "rpc", "response_cache", "counter"},
+		float32(1),
+		[]metrics.Label{
+			telemetry.NewLabel("endpoint", endpoint),
+			telemetry.NewLabel("hit", strconv.FormatBool(hit)),
+		},
+	)
+}
+
 func IncrementErrorMetrics(scenario string, err error) {
 	if err == nil {
 		return
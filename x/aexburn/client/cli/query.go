@@ -0,0 +1,402 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/sei-protocol/sei-chain/x/aexburn/types"
+)
+
+const (
+	FlagStartHeight = "start-height"
+	FlagEndHeight   = "end-height"
+)
+
+// GetQueryCmd returns the cli query commands for the aexburn module.
+func GetQueryCmd() *cobra.Command {
+	aexburnQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the aexburn module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	aexburnQueryCmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQueryBurnStats(),
+		GetCmdQueryInflationStats(),
+		GetCmdQueryMonthlyBurnData(),
+		GetCmdQueryNetSupply(),
+		GetCmdQueryEpochGasUsage(),
+		GetCmdQueryCurrentEpochGasUsage(),
+		GetCmdQueryReverseBrakeState(),
+		GetCmdQueryIncomeBuffer(),
+		GetCmdQueryEmergencyState(),
+		GetCmdQueryMintRecords(),
+		GetCmdQueryBurnRecords(),
+	)
+
+	return aexburnQueryCmd
+}
+
+// GetCmdQueryParams implements a command to return the current aexburn parameters.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the current aexburn parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryBurnStats implements a command to return the cumulative burn statistics.
+func GetCmdQueryBurnStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn-stats",
+		Short: "Query the cumulative fee burn statistics",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BurnStats(cmd.Context(), &types.QueryBurnStatsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.BurnStats)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryInflationStats implements a command to return the cumulative inflation statistics.
+func GetCmdQueryInflationStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inflation-stats",
+		Short: "Query the cumulative inflation statistics",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.InflationStats(cmd.Context(), &types.QueryInflationStatsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.InflationStats)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryMonthlyBurnData implements a command to return the 12-month rolling window supply data.
+func GetCmdQueryMonthlyBurnData() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "monthly-burn-data",
+		Short: "Query the 12-month rolling window of burned and minted amounts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MonthlyBurnData(cmd.Context(), &types.QueryMonthlyBurnDataRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryNetSupply implements a command to return the 12-month net supply change.
+func GetCmdQueryNetSupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "net-supply",
+		Short: "Query the 12-month net supply change and remaining mint capacity",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.NetSupply(cmd.Context(), &types.QueryNetSupplyRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryEpochGasUsage implements a command to return the gas usage of a completed epoch.
+func GetCmdQueryEpochGasUsage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-gas-usage [epoch-number]",
+		Short: "Query the gas usage accumulated for a completed epoch",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			epochNumber, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EpochGasUsage(cmd.Context(), &types.QueryEpochGasUsageRequest{EpochNumber: epochNumber})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryCurrentEpochGasUsage implements a command to return the gas usage of the current epoch.
+func GetCmdQueryCurrentEpochGasUsage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "current-epoch-gas-usage",
+		Short: "Query the gas usage accumulated so far in the current epoch",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CurrentEpochGasUsage(cmd.Context(), &types.QueryCurrentEpochGasUsageRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryReverseBrakeState implements a command to return the reverse brake state.
+func GetCmdQueryReverseBrakeState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reverse-brake-state",
+		Short: "Query the reverse brake mechanism state",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ReverseBrakeState(cmd.Context(), &types.QueryReverseBrakeStateRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.ReverseBrakeState)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryIncomeBuffer implements a command to return the income buffer state.
+func GetCmdQueryIncomeBuffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "income-buffer",
+		Short: "Query the validator income smoothing buffer state",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IncomeBuffer(cmd.Context(), &types.QueryIncomeBufferRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.IncomeBuffer)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryEmergencyState implements a command to return the governance emergency controls.
+func GetCmdQueryEmergencyState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "emergency-state",
+		Short: "Query whether fee burning or inflation minting is paused by governance",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EmergencyState(cmd.Context(), &types.QueryEmergencyStateRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.EmergencyState)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryMintRecords implements a command to return the inflation mint history.
+func GetCmdQueryMintRecords() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-records",
+		Short: "Query the history of inflation mint records",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.MintRecords(cmd.Context(), &types.QueryMintRecordsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "mint-records")
+
+	return cmd
+}
+
+// GetCmdQueryBurnRecords implements a command to return the fee burn history.
+func GetCmdQueryBurnRecords() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn-records",
+		Short: "Query the history of per-block fee burn records",
+		Long: "Query the history of per-block fee burn records. Use --start-height and --end-height " +
+			"to limit the result to an inclusive height range (offset pagination is not supported with a range).",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			startHeight, err := cmd.Flags().GetInt64(FlagStartHeight)
+			if err != nil {
+				return err
+			}
+			endHeight, err := cmd.Flags().GetInt64(FlagEndHeight)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BurnRecords(cmd.Context(), &types.QueryBurnRecordsRequest{
+				Pagination:  pageReq,
+				StartHeight: startHeight,
+				EndHeight:   endHeight,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "burn-records")
+	cmd.Flags().Int64(FlagStartHeight, 0, "Lowest block height to return (inclusive, 0 = unbounded)")
+	cmd.Flags().Int64(FlagEndHeight, 0, "Highest block height to return (inclusive, 0 = unbounded)")
+
+	return cmd
+}
@@ -0,0 +1,161 @@
+package cli
+
+import (
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+
+	aexburnrest "github.com/sei-protocol/sei-chain/x/aexburn/client/rest"
+	"github.com/sei-protocol/sei-chain/x/aexburn/types"
+)
+
+var (
+	UpdateParamsProposalHandler      = govclient.NewProposalHandler(UpdateParamsProposalCmd, aexburnrest.UpdateParamsProposalRESTHandler)
+	SetEmergencyPauseProposalHandler = govclient.NewProposalHandler(SetEmergencyPauseProposalCmd, aexburnrest.SetEmergencyPauseProposalRESTHandler)
+	DrainIncomeBufferProposalHandler = govclient.NewProposalHandler(DrainIncomeBufferProposalCmd, aexburnrest.DrainIncomeBufferProposalRESTHandler)
+	ResetIncomeBufferProposalHandler = govclient.NewProposalHandler(ResetIncomeBufferProposalCmd, aexburnrest.ResetIncomeBufferProposalRESTHandler)
+)
+
+func UpdateParamsProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aexburn-update-params [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to replace the aexburn params",
+		Long: "Submit a proposal to replace the aexburn module parameters. \n" +
+			"E.g. $ seid tx gov submit-proposal aexburn-update-params [proposal-file]\n" +
+			"The proposal file should contain the following:\n" +
+			"{\n" +
+			"\t title: [title],\n" +
+			"\t description: [description],\n" +
+			"\t params: [new params object] \n" +
+			"}",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proposal := types.UpdateParamsProposal{}
+			return submitProposalFromFile(cmd, args[0], &proposal)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "The proposal deposit")
+
+	return cmd
+}
+
+func SetEmergencyPauseProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aexburn-emergency-pause [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to pause or resume aexburn burning and minting",
+		Long: "Submit a proposal to pause or resume fee burning and inflation minting. \n" +
+			"E.g. $ seid tx gov submit-proposal aexburn-emergency-pause [proposal-file]\n" +
+			"The proposal file should contain the following:\n" +
+			"{\n" +
+			"\t title: [title],\n" +
+			"\t description: [description],\n" +
+			"\t burn_paused: [true|false],\n" +
+			"\t mint_paused: [true|false] \n" +
+			"}",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proposal := types.SetEmergencyPauseProposal{}
+			return submitProposalFromFile(cmd, args[0], &proposal)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "The proposal deposit")
+
+	return cmd
+}
+
+func DrainIncomeBufferProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aexburn-drain-income-buffer [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to drain the aexburn income buffer",
+		Long: "Submit a proposal to move the whole income buffer balance to a recipient. \n" +
+			"E.g. $ seid tx gov submit-proposal aexburn-drain-income-buffer [proposal-file]\n" +
+			"The proposal file should contain the following:\n" +
+			"{\n" +
+			"\t title: [title],\n" +
+			"\t description: [description],\n" +
+			"\t recipient: [recipient address, empty for the fee collector] \n" +
+			"}",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proposal := types.DrainIncomeBufferProposal{}
+			return submitProposalFromFile(cmd, args[0], &proposal)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "The proposal deposit")
+
+	return cmd
+}
+
+func ResetIncomeBufferProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aexburn-reset-income-buffer [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to reset the aexburn income buffer accounting",
+		Long: "Submit a proposal to reset the income buffer accounting to the module account balance. \n" +
+			"E.g. $ seid tx gov submit-proposal aexburn-reset-income-buffer [proposal-file]\n" +
+			"The proposal file should contain the following:\n" +
+			"{\n" +
+			"\t title: [title],\n" +
+			"\t description: [description] \n" +
+			"}",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proposal := types.ResetIncomeBufferProposal{}
+			return submitProposalFromFile(cmd, args[0], &proposal)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "The proposal deposit")
+
+	return cmd
+}
+
+// proposalContent is a gov proposal content that can be decoded from JSON
+type proposalContent interface {
+	govtypes.Content
+	proto.Message
+}
+
+// submitProposalFromFile reads the JSON encoded proposal content from file and
+// submits it to the gov module together with the deposit flag
+func submitProposalFromFile(cmd *cobra.Command, file string, content proposalContent) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	contents, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	if err := clientCtx.Codec.UnmarshalJSON(contents, content); err != nil {
+		return err
+	}
+
+	depositInput, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+
+	deposit, err := sdk.ParseCoinsNormalized(depositInput)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
@@ -0,0 +1,128 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	typesrest "github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/sei-protocol/sei-chain/x/aexburn/types"
+)
+
+// UpdateParamsRequest defines a proposal to replace the aexburn params.
+type UpdateParamsRequest struct {
+	BaseReq     typesrest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title       string            `json:"title" yaml:"title"`
+	Description string            `json:"description" yaml:"description"`
+	Deposit     sdk.Coins         `json:"deposit" yaml:"deposit"`
+	Params      types.Params      `json:"params" yaml:"params"`
+}
+
+// SetEmergencyPauseRequest defines a proposal to pause or resume burning and minting.
+type SetEmergencyPauseRequest struct {
+	BaseReq     typesrest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title       string            `json:"title" yaml:"title"`
+	Description string            `json:"description" yaml:"description"`
+	Deposit     sdk.Coins         `json:"deposit" yaml:"deposit"`
+	BurnPaused  bool              `json:"burn_paused" yaml:"burn_paused"`
+	MintPaused  bool              `json:"mint_paused" yaml:"mint_paused"`
+}
+
+// DrainIncomeBufferRequest defines a proposal to drain the income buffer.
+type DrainIncomeBufferRequest struct {
+	BaseReq     typesrest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title       string            `json:"title" yaml:"title"`
+	Description string            `json:"description" yaml:"description"`
+	Deposit     sdk.Coins         `json:"deposit" yaml:"deposit"`
+	Recipient   string            `json:"recipient" yaml:"recipient"`
+}
+
+// ResetIncomeBufferRequest defines a proposal to reset the income buffer accounting.
+type ResetIncomeBufferRequest struct {
+	BaseReq     typesrest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title       string            `json:"title" yaml:"title"`
+	Description string            `json:"description" yaml:"description"`
+	Deposit     sdk.Coins         `json:"deposit" yaml:"deposit"`
+}
+
+func UpdateParamsProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "aexburn_update_params",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req UpdateParamsRequest
+			if !typesrest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+			content := types.NewUpdateParamsProposal(req.Title, req.Description, req.Params)
+			writeProposalTx(clientCtx, w, req.BaseReq, content, req.Deposit)
+		},
+	}
+}
+
+func SetEmergencyPauseProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "aexburn_emergency_pause",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req SetEmergencyPauseRequest
+			if !typesrest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+			content := types.NewSetEmergencyPauseProposal(req.Title, req.Description, req.BurnPaused, req.MintPaused)
+			writeProposalTx(clientCtx, w, req.BaseReq, content, req.Deposit)
+		},
+	}
+}
+
+func DrainIncomeBufferProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "aexburn_drain_income_buffer",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req DrainIncomeBufferRequest
+			if !typesrest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+			content := types.NewDrainIncomeBufferProposal(req.Title, req.Description, req.Recipient)
+			writeProposalTx(clientCtx, w, req.BaseReq, content, req.Deposit)
+		},
+	}
+}
+
+func ResetIncomeBufferProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "aexburn_reset_income_buffer",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req ResetIncomeBufferRequest
+			if !typesrest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+			content := types.NewResetIncomeBufferProposal(req.Title, req.Description)
+			writeProposalTx(clientCtx, w, req.BaseReq, content, req.Deposit)
+		},
+	}
+}
+
+func writeProposalTx(clientCtx client.Context, w http.ResponseWriter, baseReq typesrest.BaseReq, content govtypes.Content, deposit sdk.Coins) {
+	baseReq = baseReq.Sanitize()
+	if !baseReq.ValidateBasic(w) {
+		return
+	}
+
+	fromAddr, err := sdk.AccAddressFromBech32(baseReq.From)
+	if typesrest.CheckBadRequestError(w, err) {
+		return
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, fromAddr)
+	if typesrest.CheckBadRequestError(w, err) {
+		return
+	}
+	if typesrest.CheckBadRequestError(w, msg.ValidateBasic()) {
+		return
+	}
+
+	tx.WriteGeneratedTxResponse(clientCtx, w, baseReq, msg)
+}
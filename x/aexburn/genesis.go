@@ -28,16 +28,49 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 
 	// Set income buffer state
 	k.SetIncomeBuffer(ctx, genState.IncomeBuffer)
+
+	// Set block gas usage samples
+	for _, usage := range genState.BlockGasUsages {
+		k.AddBlockGasUsage(ctx, usage)
+	}
+
+	// Set epoch gas usage accumulators
+	k.SetCurrentEpochGasUsage(ctx, genState.CurrentEpochGasUsage)
+	for _, usage := range genState.EpochGasUsages {
+		k.SetEpochGasUsage(ctx, usage)
+	}
+
+	// Set emergency controls
+	k.SetEmergencyState(ctx, genState.EmergencyState)
+
+	// Set burn history
+	for _, record := range genState.BurnRecords {
+		k.SaveBurnRecord(ctx, record)
+	}
+
+	// Set mint history
+	for _, record := range genState.MintRecords {
+		k.SaveMintRecord(ctx, record)
+	}
+
+	// Anchor the supply reconciliation to the genesis supply, which bank has already set up
+	k.ResetSupplyBaseline(ctx)
 }
 
 // ExportGenesis returns the module's exported genesis state
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:            k.GetParams(ctx),
-		BurnStats:         k.GetBurnStats(ctx),
-		InflationStats:    k.GetInflationStats(ctx),
-		MonthlyBurnData:   k.GetAllMonthlyBurnData(ctx),
-		ReverseBrakeState: k.GetReverseBrakeState(ctx),
-		IncomeBuffer:      k.GetIncomeBuffer(ctx),
+		Params:               k.GetParams(ctx),
+		BurnStats:            k.GetBurnStats(ctx),
+		InflationStats:       k.GetInflationStats(ctx),
+		MonthlyBurnData:      k.GetAllMonthlyBurnData(ctx),
+		ReverseBrakeState:    k.GetReverseBrakeState(ctx),
+		IncomeBuffer:         k.GetIncomeBuffer(ctx),
+		BlockGasUsages:       k.GetAllBlockGasUsage(ctx),
+		CurrentEpochGasUsage: k.GetCurrentEpochGasUsage(ctx),
+		EpochGasUsages:       k.GetAllEpochGasUsage(ctx),
+		EmergencyState:       k.GetEmergencyState(ctx),
+		BurnRecords:          k.GetAllBurnRecords(ctx),
+		MintRecords:          k.GetAllMintRecords(ctx),
 	}
 }
@@ -0,0 +1,35 @@
+package aexburn
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/aexburn/keeper"
+	"github.com/sei-protocol/sei-chain/x/aexburn/types"
+)
+
+func HandleUpdateParamsProposal(ctx sdk.Context, k *keeper.Keeper, p *types.UpdateParamsProposal) error {
+	return k.UpdateParams(ctx, p.Params)
+}
+
+func HandleSetEmergencyPauseProposal(ctx sdk.Context, k *keeper.Keeper, p *types.SetEmergencyPauseProposal) error {
+	k.SetEmergencyPause(ctx, p.BurnPaused, p.MintPaused)
+	return nil
+}
+
+func HandleDrainIncomeBufferProposal(ctx sdk.Context, k *keeper.Keeper, p *types.DrainIncomeBufferProposal) error {
+	var recipient sdk.AccAddress
+	if p.Recipient != "" {
+		addr, err := sdk.AccAddressFromBech32(p.Recipient)
+		if err != nil {
+			return err
+		}
+		recipient = addr
+	}
+	_, err := k.DrainIncomeBuffer(ctx, recipient)
+	return err
+}
+
+func HandleResetIncomeBufferProposal(ctx sdk.Context, k *keeper.Keeper, _ *types.ResetIncomeBufferProposal) error {
+	k.ResetIncomeBuffer(ctx)
+	return nil
+}
@@ -17,8 +17,8 @@ func (k Keeper) BurnFees(ctx sdk.Context) (burned sdk.Coins, remaining sdk.Coins
 	// Get module parameters
 	moduleParams := k.GetParams(ctx)
 
-	// If burning is disabled, return all fees as remaining
-	if !moduleParams.BurnEnabled {
+	// If burning is disabled or paused by governance, return all fees as remaining
+	if !moduleParams.BurnEnabled || k.GetEmergencyState(ctx).BurnPaused {
 		feeCollector := k.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
 		remaining = k.bankKeeper.GetAllBalances(ctx, feeCollector)
 		return sdk.NewCoins(), remaining, nil
@@ -32,6 +32,8 @@ func (k Keeper) BurnFees(ctx sdk.Context) (burned sdk.Coins, remaining sdk.Coins
 		return sdk.NewCoins(), sdk.NewCoins(), nil
 	}
 
+	originalFees := feeBalance
+
 	// Step 1: Apply income smoothing (before burn calculation)
 	// This may reduce fees (high activity) or increase fees (low activity)
 	smoothedFees, smoothErr := k.SmoothIncome(ctx, feeBalance)
@@ -48,7 +50,8 @@ func (k Keeper) BurnFees(ctx sdk.Context) (burned sdk.Coins, remaining sdk.Coins
 	}
 
 	// Step 2: Calculate dynamic burn rate based on gas usage
-	burnRate := k.CalculateDynamicBurnRate(ctx, moduleParams)
+	gasUsageRate := k.GetGasUsageRate(ctx)
+	burnRate, brakeReduction := k.calculateBurnRate(ctx, moduleParams, gasUsageRate)
 
 	// Log smoothing effect
 	if !smoothedFees.IsEqual(feeBalance) {
@@ -58,44 +61,105 @@ func (k Keeper) BurnFees(ctx sdk.Context) (burned sdk.Coins, remaining sdk.Coins
 		)
 	}
 
-	// Calculate burn amount for each coin
+	// Calculate burn amount for each coin. AEX is always burned natively, other
+	// denoms follow their configured policy
 	burnCoins := sdk.NewCoins()
+	otherBurned := sdk.NewCoins()
+	aexEquivalent := sdk.ZeroInt()
+	var routes []feeRoute
 	for _, coin := range feeBalance {
-		// Only burn the native AEX token
-		if coin.Denom != appparams.BaseCoinUnit {
+		burnAmount := sdk.NewDecFromInt(coin.Amount).Mul(burnRate).TruncateInt()
+		if !burnAmount.IsPositive() {
 			continue
 		}
+		portion := sdk.NewCoin(coin.Denom, burnAmount)
 
-		burnAmount := sdk.NewDecFromInt(coin.Amount).Mul(burnRate).TruncateInt()
-		if burnAmount.IsPositive() {
-			burnCoins = burnCoins.Add(sdk.NewCoin(coin.Denom, burnAmount))
+		if coin.Denom == appparams.BaseCoinUnit {
+			burnCoins = burnCoins.Add(portion)
+			continue
+		}
+
+		burn, route, value := k.applyDenomBurnPolicy(ctx, moduleParams, portion)
+		burnCoins = burnCoins.Add(burn...)
+		otherBurned = otherBurned.Add(burn...)
+		aexEquivalent = aexEquivalent.Add(value)
+		if route != nil {
+			routes = append(routes, *route)
 		}
 	}
 
-	if burnCoins.IsZero() {
+	if burnCoins.IsZero() && len(routes) == 0 {
 		return sdk.NewCoins(), feeBalance, nil
 	}
 
-	// Burn the coins from fee collector
-	err = k.bankKeeper.BurnCoins(ctx, authtypes.FeeCollectorName, burnCoins)
-	if err != nil {
-		logger.Error("failed to burn fees", "error", err)
-		return sdk.NewCoins(), feeBalance, err
+	if !burnCoins.IsZero() {
+		// Burn the coins through the aexburn module account, as the fee collector
+		// has no burner permission
+		err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, burnCoins)
+		if err != nil {
+			logger.Error("failed to move fees to burn", "error", err)
+			return sdk.NewCoins(), feeBalance, err
+		}
+		err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnCoins)
+		if err != nil {
+			logger.Error("failed to burn fees", "error", err)
+			return sdk.NewCoins(), feeBalance, err
+		}
 	}
 
-	// Update burn statistics
-	stats := k.GetBurnStats(ctx)
-	for _, coin := range burnCoins {
-		if coin.Denom == appparams.BaseCoinUnit {
-			stats.TotalBurned = stats.TotalBurned.Add(coin.Amount)
+	// Route fees to treasuries or the community pool. Fees that cannot be routed
+	// are left to validators rather than blocking the burn
+	routed := sdk.NewCoins()
+	for _, route := range routes {
+		if routeErr := k.routeFees(ctx, route); routeErr != nil {
+			logger.Error("failed to route fees", "denom", route.coin.Denom, "recipient", route.recipient, "error", routeErr)
+			continue
 		}
+		routed = routed.Add(route.coin)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"aex_fees_routed",
+				sdk.NewAttribute("amount", route.coin.String()),
+				sdk.NewAttribute("recipient", route.recipient),
+				sdk.NewAttribute("block_height", sdk.NewInt(ctx.BlockHeight()).String()),
+			),
+		)
 	}
+
+	// Update burn statistics
+	stats := k.GetBurnStats(ctx)
+	stats.TotalBurned = stats.TotalBurned.Add(burnCoins.AmountOf(appparams.BaseCoinUnit))
+	stats.TotalBurnedOtherDenoms = stats.TotalBurnedOtherDenoms.Add(otherBurned...)
+	stats.TotalRouted = stats.TotalRouted.Add(routed...)
+	stats.TotalAexEquivalentBurned = zeroIfNil(stats.TotalAexEquivalentBurned).Add(aexEquivalent)
+	epochNumber := k.GetCurrentEpochGasUsage(ctx).EpochNumber
 	stats.LastBurnRate = burnRate
+	stats.LastEpochNumber = epochNumber
 	stats.LastBlockHeight = ctx.BlockHeight()
 	k.SetBurnStats(ctx, stats)
+	k.updateMonthlyBurnData(ctx, epochNumber, sdk.ZeroInt(), burnCoins.AmountOf(appparams.BaseCoinUnit))
+
+	// Keep a per-block history for reconciliation, pruned at the end of the block
+	if moduleParams.BurnRecordRetention > 0 {
+		originalAex := originalFees.AmountOf(appparams.BaseCoinUnit)
+		k.SaveBurnRecord(ctx, types.BurnRecord{
+			EpochNumber:         epochNumber,
+			BlockHeight:         ctx.BlockHeight(),
+			BurnedAmount:        burnCoins.AmountOf(appparams.BaseCoinUnit),
+			BurnRate:            burnRate,
+			GasUsageRate:        gasUsageRate,
+			TotalFees:           feeBalance.AmountOf(appparams.BaseCoinUnit),
+			SmoothingAdjustment: feeBalance.AmountOf(appparams.BaseCoinUnit).Sub(originalAex),
+			BrakeReduction:      brakeReduction,
+			BurnedOtherDenoms:   otherBurned,
+			Routed:              routed,
+			AexEquivalentBurned: aexEquivalent,
+		})
+	}
 
 	// Calculate remaining balance
-	remaining, _ = feeBalance.SafeSub(burnCoins)
+	remaining, _ = feeBalance.SafeSub(burnCoins.Add(routed...))
 
 	// Emit burn event
 	ctx.EventManager().EmitEvent(
@@ -103,6 +167,8 @@ func (k Keeper) BurnFees(ctx sdk.Context) (burned sdk.Coins, remaining sdk.Coins
 			"aex_burn",
 			sdk.NewAttribute("burned_amount", burnCoins.String()),
 			sdk.NewAttribute("burn_rate", burnRate.String()),
+			sdk.NewAttribute("routed_amount", routed.String()),
+			sdk.NewAttribute("aex_equivalent_burned", aexEquivalent.String()),
 			sdk.NewAttribute("remaining_fees", remaining.String()),
 			sdk.NewAttribute("block_height", sdk.NewInt(ctx.BlockHeight()).String()),
 		),
@@ -111,6 +177,7 @@ func (k Keeper) BurnFees(ctx sdk.Context) (burned sdk.Coins, remaining sdk.Coins
 	logger.Info("AEX fees burned",
 		"burned", burnCoins.String(),
 		"burn_rate", burnRate.String(),
+		"routed", routed.String(),
 		"remaining", remaining.String(),
 	)
 
@@ -123,10 +190,14 @@ func (k Keeper) BurnFees(ctx sdk.Context) (burned sdk.Coins, remaining sdk.Coins
 // - Gas usage > HighGasThreshold: burn rate increases toward MaxBurnRate
 // - If reverse brake is active, the burn rate is further reduced
 func (k Keeper) CalculateDynamicBurnRate(ctx sdk.Context, moduleParams types.Params) sdk.Dec {
-	// For now, use target burn rate as default
-	// TODO: Implement actual gas usage tracking from EVM module
-	gasUsageRate := sdk.NewDecWithPrec(50, 2) // Default 50% gas usage
+	// Average gas usage over the rolling block window
+	burnRate, _ := k.calculateBurnRate(ctx, moduleParams, k.GetGasUsageRate(ctx))
+	return burnRate
+}
 
+// calculateBurnRate calculates the burn rate for the given gas usage rate and
+// also returns how much the reverse brake reduced it by
+func (k Keeper) calculateBurnRate(ctx sdk.Context, moduleParams types.Params, gasUsageRate sdk.Dec) (sdk.Dec, sdk.Dec) {
 	var baseBurnRate sdk.Dec
 	if gasUsageRate.LT(moduleParams.LowGasThreshold) {
 		// Low gas usage: decrease burn rate
@@ -150,19 +221,20 @@ func (k Keeper) CalculateDynamicBurnRate(ctx sdk.Context, moduleParams types.Par
 	}
 
 	// Apply reverse brake reduction if active
+	burnRate := baseBurnRate
 	if moduleParams.ReverseBrakeEnabled {
 		brakeState := k.GetReverseBrakeState(ctx)
 		if brakeState.IsBrakeActive && brakeState.CurrentReduction.IsPositive() {
 			// Reduce burn rate by the current reduction amount
-			baseBurnRate = baseBurnRate.Sub(brakeState.CurrentReduction)
+			burnRate = burnRate.Sub(brakeState.CurrentReduction)
 			// Ensure burn rate doesn't go below minimum
-			if baseBurnRate.LT(moduleParams.MinBurnRate) {
-				baseBurnRate = moduleParams.MinBurnRate
+			if burnRate.LT(moduleParams.MinBurnRate) {
+				burnRate = moduleParams.MinBurnRate
 			}
 		}
 	}
 
-	return baseBurnRate
+	return burnRate, baseBurnRate.Sub(burnRate)
 }
 
 // UpdateReverseBrakeState checks net supply and updates the reverse brake state
@@ -238,4 +310,3 @@ func (k Keeper) UpdateReverseBrakeState(ctx sdk.Context, epochNumber uint64) {
 	brakeState.LastNetSupply = netSupply
 	k.SetReverseBrakeState(ctx, brakeState)
 }
-
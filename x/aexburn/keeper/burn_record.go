@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/aexburn/types"
+)
+
+// maxBurnRecordsPrunedPerBlock bounds the pruning work done in a single block, so that
+// lowering the retention through governance is spread over several blocks
+const maxBurnRecordsPrunedPerBlock = 100
+
+// SaveBurnRecord saves the burn record of a block
+func (k Keeper) SaveBurnRecord(ctx sdk.Context, record types.BurnRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&record)
+	store.Set(types.GetBurnRecordKey(record.BlockHeight), bz)
+}
+
+// GetBurnRecord returns the burn record of a specific block height
+func (k Keeper) GetBurnRecord(ctx sdk.Context, blockHeight int64) (types.BurnRecord, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBurnRecordKey(blockHeight))
+	if bz == nil {
+		return types.BurnRecord{}, false
+	}
+
+	var record types.BurnRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// GetAllBurnRecords returns all retained burn records, oldest first
+func (k Keeper) GetAllBurnRecords(ctx sdk.Context) []types.BurnRecord {
+	var records []types.BurnRecord
+	k.IterateBurnRecords(ctx, 0, 0, func(record types.BurnRecord) bool {
+		records = append(records, record)
+		return false
+	})
+	return records
+}
+
+// IterateBurnRecords iterates over the burn records between startHeight and endHeight
+// (both inclusive, 0 = unbounded) in ascending height order until cb returns true
+func (k Keeper) IterateBurnRecords(ctx sdk.Context, startHeight, endHeight int64, cb func(types.BurnRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	start := types.BurnRecordPrefix
+	if startHeight > 0 {
+		start = types.GetBurnRecordKey(startHeight)
+	}
+	end := sdk.PrefixEndBytes(types.BurnRecordPrefix)
+	if endHeight > 0 {
+		end = types.GetBurnRecordKey(endHeight + 1)
+	}
+
+	iterator := store.Iterator(start, end)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.BurnRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// PruneBurnRecords removes burn records that fell out of the retention window.
+// At most maxBurnRecordsPrunedPerBlock records are removed per call.
+// This should be called at the end of each block
+func (k Keeper) PruneBurnRecords(ctx sdk.Context) {
+	retention := k.GetParams(ctx).BurnRecordRetention
+	cutoff := ctx.BlockHeight() - int64(retention)
+	if retention == 0 {
+		// Recording is disabled, drop whatever history is left
+		cutoff = ctx.BlockHeight()
+	}
+	if cutoff <= 0 {
+		return
+	}
+
+	// Keys are big-endian heights, so iteration starts from the oldest record
+	var expiredHeights []int64
+	k.IterateBurnRecords(ctx, 0, cutoff, func(record types.BurnRecord) bool {
+		expiredHeights = append(expiredHeights, record.BlockHeight)
+		return len(expiredHeights) >= maxBurnRecordsPrunedPerBlock
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	for _, height := range expiredHeights {
+		store.Delete(types.GetBurnRecordKey(height))
+	}
+}
@@ -0,0 +1,197 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	appparams "github.com/sei-protocol/sei-chain/app/params"
+	"github.com/sei-protocol/sei-chain/x/aexburn/keeper"
+	"github.com/sei-protocol/sei-chain/x/aexburn/types"
+)
+
+// ========== Burn Record Tests ==========
+
+// fundFeeCollector mints amount of AEX into the fee collector
+func (suite *KeeperTestSuite) fundFeeCollector(amount sdk.Int) {
+	coins := sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, amount))
+	suite.Require().NoError(suite.App.BankKeeper.MintCoins(suite.Ctx, types.ModuleName, coins))
+	suite.Require().NoError(suite.App.BankKeeper.SendCoinsFromModuleToModule(suite.Ctx, types.ModuleName, authtypes.FeeCollectorName, coins))
+	// Fees are paid out of existing supply, so account for the minted AEX as genesis supply
+	suite.App.AexburnKeeper.AddSupplyBaseline(suite.Ctx, amount)
+}
+
+func (suite *KeeperTestSuite) saveBurnRecords(fromHeight, toHeight int64) {
+	for height := fromHeight; height <= toHeight; height++ {
+		suite.App.AexburnKeeper.SaveBurnRecord(suite.Ctx, types.BurnRecord{
+			BlockHeight:         height,
+			BurnedAmount:        sdk.NewInt(height),
+			BurnRate:            sdk.ZeroDec(),
+			GasUsageRate:        sdk.ZeroDec(),
+			TotalFees:           sdk.ZeroInt(),
+			SmoothingAdjustment: sdk.ZeroInt(),
+			BrakeReduction:      sdk.ZeroDec(),
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestBurnFeesSavesBurnRecord() {
+	suite.Ctx = suite.Ctx.WithBlockHeight(42)
+	suite.App.AexburnKeeper.FinalizeEpochGasUsage(suite.Ctx, 4)
+	suite.fundFeeCollector(sdk.NewInt(1000000))
+	supplyBefore := suite.App.BankKeeper.GetSupply(suite.Ctx, appparams.BaseCoinUnit).Amount
+
+	burned, remaining, err := suite.App.AexburnKeeper.BurnFees(suite.Ctx)
+	suite.Require().NoError(err)
+
+	// Default gas usage sits between the thresholds, so the target rate applies
+	expectedBurn := sdk.NewInt(500000)
+	suite.Require().Equal(expectedBurn, burned.AmountOf(appparams.BaseCoinUnit))
+	suite.Require().Equal(expectedBurn, remaining.AmountOf(appparams.BaseCoinUnit))
+	suite.Require().Equal(supplyBefore.Sub(expectedBurn), suite.App.BankKeeper.GetSupply(suite.Ctx, appparams.BaseCoinUnit).Amount)
+
+	record, found := suite.App.AexburnKeeper.GetBurnRecord(suite.Ctx, 42)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(5), record.EpochNumber)
+	suite.Require().Equal(expectedBurn, record.BurnedAmount)
+	suite.Require().Equal(types.DefaultTargetBurnRate, record.BurnRate)
+	suite.Require().Equal(types.DefaultGasUsageRate, record.GasUsageRate)
+	suite.Require().Equal(sdk.NewInt(1000000), record.TotalFees)
+	suite.Require().True(record.SmoothingAdjustment.IsZero())
+	suite.Require().True(record.BrakeReduction.IsZero())
+
+	stats := suite.App.AexburnKeeper.GetBurnStats(suite.Ctx)
+	suite.Require().Equal(uint64(5), stats.LastEpochNumber)
+
+	// The burn passes through the module account without touching the income buffer
+	res, broken := keeper.AllInvariants(suite.App.AexburnKeeper)(suite.Ctx)
+	suite.Require().False(broken, res)
+}
+
+func (suite *KeeperTestSuite) TestBurnRecordTracksAdjustments() {
+	params := types.DefaultParams()
+	params.IncomeSmootherEnabled = true
+	params.HighActivityThreshold = sdk.NewDecWithPrec(40, 2) // default 50% usage counts as high activity
+	params.LowActivityThreshold = sdk.NewDecWithPrec(20, 2)
+	suite.App.AexburnKeeper.SetParams(suite.Ctx, params)
+	suite.App.AexburnKeeper.SetReverseBrakeState(suite.Ctx, types.ReverseBrakeState{
+		ConsecutiveNegativePeriods: 3,
+		IsBrakeActive:              true,
+		CurrentReduction:           sdk.NewDecWithPrec(10, 2),
+		LastNetSupply:              sdk.NewInt(-1),
+	})
+
+	suite.fundFeeCollector(sdk.NewInt(1000000))
+	_, _, err := suite.App.AexburnKeeper.BurnFees(suite.Ctx)
+	suite.Require().NoError(err)
+
+	record, found := suite.App.AexburnKeeper.GetBurnRecord(suite.Ctx, suite.Ctx.BlockHeight())
+	suite.Require().True(found)
+	suite.Require().True(record.SmoothingAdjustment.IsNegative())
+	suite.Require().Equal(sdk.NewInt(1000000).Add(record.SmoothingAdjustment), record.TotalFees)
+	suite.Require().Equal(sdk.NewDecWithPrec(10, 2), record.BrakeReduction)
+	suite.Require().Equal(types.DefaultTargetBurnRate.Sub(record.BrakeReduction), record.BurnRate)
+}
+
+func (suite *KeeperTestSuite) TestBurnRecordDisabled() {
+	params := types.DefaultParams()
+	params.BurnRecordRetention = 0
+	suite.App.AexburnKeeper.SetParams(suite.Ctx, params)
+
+	suite.fundFeeCollector(sdk.NewInt(1000000))
+	burned, _, err := suite.App.AexburnKeeper.BurnFees(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().False(burned.IsZero())
+	suite.Require().Empty(suite.App.AexburnKeeper.GetAllBurnRecords(suite.Ctx))
+}
+
+func (suite *KeeperTestSuite) TestPruneBurnRecords() {
+	params := types.DefaultParams()
+	params.BurnRecordRetention = 10
+	suite.App.AexburnKeeper.SetParams(suite.Ctx, params)
+
+	suite.saveBurnRecords(1, 20)
+	suite.App.AexburnKeeper.PruneBurnRecords(suite.Ctx.WithBlockHeight(20))
+
+	records := suite.App.AexburnKeeper.GetAllBurnRecords(suite.Ctx)
+	suite.Require().Len(records, 10)
+	suite.Require().Equal(int64(11), records[0].BlockHeight)
+	suite.Require().Equal(int64(20), records[9].BlockHeight)
+}
+
+func (suite *KeeperTestSuite) TestPruneBurnRecordsIsBounded() {
+	params := types.DefaultParams()
+	params.BurnRecordRetention = 1
+	suite.App.AexburnKeeper.SetParams(suite.Ctx, params)
+
+	// Shrinking the retention leaves a large backlog that is pruned over several blocks
+	suite.saveBurnRecords(1, 150)
+	suite.App.AexburnKeeper.PruneBurnRecords(suite.Ctx.WithBlockHeight(150))
+	suite.Require().Len(suite.App.AexburnKeeper.GetAllBurnRecords(suite.Ctx), 50)
+
+	suite.App.AexburnKeeper.PruneBurnRecords(suite.Ctx.WithBlockHeight(151))
+	records := suite.App.AexburnKeeper.GetAllBurnRecords(suite.Ctx)
+	suite.Require().Len(records, 0)
+}
+
+func (suite *KeeperTestSuite) TestQueryBurnRecordsByHeightRange() {
+	querier := keeper.NewQuerier(suite.App.AexburnKeeper)
+	ctx := sdk.WrapSDKContext(suite.Ctx)
+	suite.saveBurnRecords(1, 10)
+
+	res, err := querier.BurnRecords(ctx, &types.QueryBurnRecordsRequest{
+		StartHeight: 3,
+		EndHeight:   7,
+		Pagination:  &query.PageRequest{Limit: 3},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.BurnRecords, 3)
+	suite.Require().Equal(int64(3), res.BurnRecords[0].BlockHeight)
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	res, err = querier.BurnRecords(ctx, &types.QueryBurnRecordsRequest{
+		StartHeight: 3,
+		EndHeight:   7,
+		Pagination:  &query.PageRequest{Key: res.Pagination.NextKey, Limit: 3},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.BurnRecords, 2)
+	suite.Require().Equal(int64(6), res.BurnRecords[0].BlockHeight)
+	suite.Require().Equal(int64(7), res.BurnRecords[1].BlockHeight)
+	suite.Require().Nil(res.Pagination.NextKey)
+
+	// Open ended ranges
+	res, err = querier.BurnRecords(ctx, &types.QueryBurnRecordsRequest{StartHeight: 9})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.BurnRecords, 2)
+	res, err = querier.BurnRecords(ctx, &types.QueryBurnRecordsRequest{EndHeight: 2})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.BurnRecords, 2)
+
+	_, err = querier.BurnRecords(ctx, &types.QueryBurnRecordsRequest{StartHeight: 7, EndHeight: 3})
+	suite.Require().Error(err)
+	_, err = querier.BurnRecords(ctx, &types.QueryBurnRecordsRequest{
+		StartHeight: 3,
+		Pagination:  &query.PageRequest{Offset: 1},
+	})
+	suite.Require().Error(err)
+	_, err = querier.BurnRecords(ctx, &types.QueryBurnRecordsRequest{
+		StartHeight: 3,
+		Pagination:  &query.PageRequest{Key: []byte{0x01}},
+	})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (suite *KeeperTestSuite) TestBurnRecordGenesisValidation() {
+	genState := types.DefaultGenesis()
+	genState.BurnRecords = []types.BurnRecord{
+		{BlockHeight: 1, BurnedAmount: sdk.ZeroInt(), BurnRate: sdk.ZeroDec(), GasUsageRate: sdk.ZeroDec(), TotalFees: sdk.ZeroInt(), SmoothingAdjustment: sdk.ZeroInt(), BrakeReduction: sdk.ZeroDec()},
+		{BlockHeight: 1, BurnedAmount: sdk.ZeroInt(), BurnRate: sdk.ZeroDec(), GasUsageRate: sdk.ZeroDec(), TotalFees: sdk.ZeroInt(), SmoothingAdjustment: sdk.ZeroInt(), BrakeReduction: sdk.ZeroDec()},
+	}
+	suite.Require().Error(genState.Validate())
+
+	genState.BurnRecords = genState.BurnRecords[:1]
+	suite.Require().NoError(genState.Validate())
+}
@@ -97,9 +97,10 @@ func (suite *BurnTestSuite) TestUpdateReverseBrakeState_NegativeNetSupply() {
 	suite.App.AexburnKeeper.SetParams(suite.Ctx, params)
 
 	// Set up negative net supply (more burned than minted)
+	currentMonth := types.CalendarMonthIndex(suite.Ctx.BlockTime())
 	for i := uint32(0); i < 12; i++ {
 		data := types.MonthlyBurnData{
-			MonthIndex:   i,
+			MonthIndex:   currentMonth - i,
 			BurnedAmount: sdk.NewInt(200000),
 			MintedAmount: sdk.NewInt(100000),
 		}
@@ -135,9 +136,10 @@ func (suite *BurnTestSuite) TestUpdateReverseBrakeState_PositiveNetSupply() {
 	suite.App.AexburnKeeper.SetReverseBrakeState(suite.Ctx, brakeState)
 
 	// Set up positive net supply (more minted than burned)
+	currentMonth := types.CalendarMonthIndex(suite.Ctx.BlockTime())
 	for i := uint32(0); i < 12; i++ {
 		data := types.MonthlyBurnData{
-			MonthIndex:   i,
+			MonthIndex:   currentMonth - i,
 			BurnedAmount: sdk.NewInt(100000),
 			MintedAmount: sdk.NewInt(200000),
 		}
@@ -153,4 +155,3 @@ func (suite *BurnTestSuite) TestUpdateReverseBrakeState_PositiveNetSupply() {
 	suite.Require().Equal(uint32(0), state.ConsecutiveNegativePeriods)
 	suite.Require().True(state.CurrentReduction.IsZero())
 }
-
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	appparams "github.com/sei-protocol/sei-chain/app/params"
+	"github.com/sei-protocol/sei-chain/x/aexburn/types"
+)
+
+// feeRoute is the burn portion of a non-AEX fee denom routed to a treasury or the community pool
+type feeRoute struct {
+	recipient string
+	coin      sdk.Coin
+}
+
+// GetAexEquivalent values coins of a non-AEX denom in AEX using the oracle exchange
+// rates of oracleDenom (defaults to the coin denom) and AEX
+func (k Keeper) GetAexEquivalent(ctx sdk.Context, coin sdk.Coin, oracleDenom string) (sdk.Int, error) {
+	if oracleDenom == "" {
+		oracleDenom = coin.Denom
+	}
+
+	denomRate, _, _, err := k.oracleKeeper.GetBaseExchangeRate(ctx, oracleDenom)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+	aexRate, _, _, err := k.oracleKeeper.GetBaseExchangeRate(ctx, appparams.BaseCoinUnit)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+	if !aexRate.IsPositive() {
+		return sdk.ZeroInt(), fmt.Errorf("invalid %s exchange rate: %s", appparams.BaseCoinUnit, aexRate)
+	}
+
+	return sdk.NewDecFromInt(coin.Amount).Mul(denomRate).Quo(aexRate).TruncateInt(), nil
+}
+
+// routeFees sends the burn portion of a non-AEX fee denom from the fee collector to
+// the route's recipient, or to the community pool if no recipient is set
+func (k Keeper) routeFees(ctx sdk.Context, route feeRoute) error {
+	coins := sdk.NewCoins(route.coin)
+	if route.recipient == "" {
+		feeCollector := k.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+		return k.distrKeeper.FundCommunityPool(ctx, coins, feeCollector)
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(route.recipient)
+	if err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, recipient, coins)
+}
+
+// applyDenomBurnPolicy splits the burn portion of a non-AEX fee denom according to
+// its policy. Denoms without a policy, or whose oracle price is unavailable, are left
+// to validators
+func (k Keeper) applyDenomBurnPolicy(
+	ctx sdk.Context, params types.Params, portion sdk.Coin,
+) (burn sdk.Coins, route *feeRoute, aexEquivalent sdk.Int) {
+	aexEquivalent = sdk.ZeroInt()
+
+	policy, found := params.GetDenomBurnPolicy(portion.Denom)
+	if !found {
+		return sdk.NewCoins(), nil, aexEquivalent
+	}
+
+	switch policy.Action {
+	case types.DenomBurnActionBurn:
+		return sdk.NewCoins(portion), nil, aexEquivalent
+	case types.DenomBurnActionRoute:
+		return sdk.NewCoins(), &feeRoute{recipient: policy.Recipient, coin: portion}, aexEquivalent
+	case types.DenomBurnActionOracle:
+		value, err := k.GetAexEquivalent(ctx, portion, policy.OracleDenom)
+		if err != nil {
+			k.Logger(ctx).Error("failed to value fees at oracle price, leaving them to validators",
+				"denom", portion.Denom, "error", err)
+			return sdk.NewCoins(), nil, aexEquivalent
+		}
+		return sdk.NewCoins(portion), nil, value
+	default:
+		return sdk.NewCoins(), nil, aexEquivalent
+	}
+}
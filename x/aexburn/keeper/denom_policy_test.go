@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	appparams "github.com/sei-protocol/sei-chain/app/params"
+	"github.com/sei-protocol/sei-chain/x/aexburn/types"
+)
+
+// ========== Multi-Denom Burn Policy Tests ==========
+
+const ibcAtomDenom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+// collectFees mints coins into the fee collector
+func (suite *KeeperTestSuite) collectFees(coins sdk.Coins) {
+	suite.Require().NoError(suite.App.BankKeeper.MintCoins(suite.Ctx, types.ModuleName, coins))
+	suite.Require().NoError(suite.App.BankKeeper.SendCoinsFromModuleToModule(suite.Ctx, types.ModuleName, authtypes.FeeCollectorName, coins))
+}
+
+func (suite *KeeperTestSuite) setDenomBurnPolicies(policies ...types.DenomBurnPolicy) {
+	params := suite.App.AexburnKeeper.GetParams(suite.Ctx)
+	params.DenomBurnPolicies = policies
+	suite.Require().NoError(params.Validate())
+	suite.App.AexburnKeeper.SetParams(suite.Ctx, params)
+}
+
+func (suite *KeeperTestSuite) TestBurnFeesLeavesUnlistedDenoms() {
+	suite.collectFees(sdk.NewCoins(
+		sdk.NewCoin(appparams.BaseCoinUnit, sdk.NewInt(1000000)),
+		sdk.NewCoin("uatom", sdk.NewInt(1000000)),
+	))
+
+	burned, remaining, err := suite.App.AexburnKeeper.BurnFees(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, sdk.NewInt(500000))), burned)
+	suite.Require().Equal(sdk.NewInt(1000000), remaining.AmountOf("uatom"))
+}
+
+func (suite *KeeperTestSuite) TestDenomBurnPolicyBurn() {
+	suite.setDenomBurnPolicies(types.DenomBurnPolicy{Denom: "uatom", Action: types.DenomBurnActionBurn})
+	suite.collectFees(sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(1000000))))
+
+	burned, remaining, err := suite.App.AexburnKeeper.BurnFees(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(500000), burned.AmountOf("uatom"))
+	suite.Require().Equal(sdk.NewInt(500000), remaining.AmountOf("uatom"))
+	suite.Require().Equal(sdk.NewInt(500000), suite.App.BankKeeper.GetSupply(suite.Ctx, "uatom").Amount)
+
+	stats := suite.App.AexburnKeeper.GetBurnStats(suite.Ctx)
+	suite.Require().True(stats.TotalBurned.IsZero())
+	suite.Require().Equal(sdk.NewInt(500000), stats.TotalBurnedOtherDenoms.AmountOf("uatom"))
+
+	record, found := suite.App.AexburnKeeper.GetBurnRecord(suite.Ctx, suite.Ctx.BlockHeight())
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(500000), record.BurnedOtherDenoms.AmountOf("uatom"))
+}
+
+func (suite *KeeperTestSuite) TestDenomBurnPolicyRouteToCommunityPool() {
+	suite.setDenomBurnPolicies(types.DenomBurnPolicy{Denom: "uatom", Action: types.DenomBurnActionRoute})
+	suite.collectFees(sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(1000000))))
+	poolBefore := suite.App.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx).AmountOf("uatom")
+
+	burned, remaining, err := suite.App.AexburnKeeper.BurnFees(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().True(burned.IsZero())
+	suite.Require().Equal(sdk.NewInt(500000), remaining.AmountOf("uatom"))
+
+	poolAfter := suite.App.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx).AmountOf("uatom")
+	suite.Require().Equal(sdk.NewDec(500000), poolAfter.Sub(poolBefore))
+	suite.Require().Equal(sdk.NewInt(500000), suite.App.AexburnKeeper.GetBurnStats(suite.Ctx).TotalRouted.AmountOf("uatom"))
+}
+
+func (suite *KeeperTestSuite) TestDenomBurnPolicyRouteToTreasury() {
+	treasury := suite.TestAccs[0]
+	suite.setDenomBurnPolicies(types.DenomBurnPolicy{Denom: "uatom", Action: types.DenomBurnActionRoute, Recipient: treasury.String()})
+	suite.collectFees(sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(1000000))))
+
+	_, remaining, err := suite.App.AexburnKeeper.BurnFees(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(500000), remaining.AmountOf("uatom"))
+	suite.Require().Equal(sdk.NewInt(500000), suite.App.BankKeeper.GetBalance(suite.Ctx, treasury, "uatom").Amount)
+
+	record, found := suite.App.AexburnKeeper.GetBurnRecord(suite.Ctx, suite.Ctx.BlockHeight())
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(500000), record.Routed.AmountOf("uatom"))
+}
+
+func (suite *KeeperTestSuite) TestDenomBurnPolicyOracle() {
+	suite.setDenomBurnPolicies(types.DenomBurnPolicy{Denom: ibcAtomDenom, Action: types.DenomBurnActionOracle, OracleDenom: "uatom"})
+	suite.collectFees(sdk.NewCoins(sdk.NewCoin(ibcAtomDenom, sdk.NewInt(1000000))))
+
+	// Without oracle prices the fees are left to validators
+	burned, remaining, err := suite.App.AexburnKeeper.BurnFees(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().True(burned.IsZero())
+	suite.Require().Equal(sdk.NewInt(1000000), remaining.AmountOf(ibcAtomDenom))
+
+	// 1 ATOM = 10 USD and 1 AEX = 2 USD, so the burned ATOM is worth 5x as much AEX
+	suite.App.OracleKeeper.SetBaseExchangeRate(suite.Ctx, "uatom", sdk.NewDec(10))
+	suite.App.OracleKeeper.SetBaseExchangeRate(suite.Ctx, appparams.BaseCoinUnit, sdk.NewDec(2))
+
+	burned, _, err = suite.App.AexburnKeeper.BurnFees(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(500000), burned.AmountOf(ibcAtomDenom))
+
+	stats := suite.App.AexburnKeeper.GetBurnStats(suite.Ctx)
+	suite.Require().Equal(sdk.NewInt(2500000), stats.TotalAexEquivalentBurned)
+	suite.Require().True(stats.TotalBurned.IsZero())
+
+	record, found := suite.App.AexburnKeeper.GetBurnRecord(suite.Ctx, suite.Ctx.BlockHeight())
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(2500000), record.AexEquivalentBurned)
+}
+
+func (suite *KeeperTestSuite) TestDenomBurnPolicyValidation() {
+	valid := types.DefaultParams()
+	valid.DenomBurnPolicies = []types.DenomBurnPolicy{
+		{Denom: "uatom", Action: types.DenomBurnActionBurn},
+		{Denom: "uosmo", Action: types.DenomBurnActionRoute, Recipient: suite.TestAccs[0].String()},
+		{Denom: ibcAtomDenom, Action: types.DenomBurnActionOracle, OracleDenom: "uatom"},
+	}
+	suite.Require().NoError(valid.Validate())
+
+	for name, policy := range map[string]types.DenomBurnPolicy{
+		"aex":                  {Denom: appparams.BaseCoinUnit, Action: types.DenomBurnActionBurn},
+		"invalid denom":        {Denom: "!", Action: types.DenomBurnActionBurn},
+		"unspecified action":   {Denom: "uatom"},
+		"recipient on burn":    {Denom: "uatom", Action: types.DenomBurnActionBurn, Recipient: suite.TestAccs[0].String()},
+		"invalid recipient":    {Denom: "uatom", Action: types.DenomBurnActionRoute, Recipient: "aex1invalid"},
+		"oracle denom on burn": {Denom: "uatom", Action: types.DenomBurnActionBurn, OracleDenom: "uatom"},
+	} {
+		params := types.DefaultParams()
+		params.DenomBurnPolicies = []types.DenomBurnPolicy{policy}
+		suite.Require().Error(params.Validate(), name)
+	}
+
+	duplicate := types.DefaultParams()
+	duplicate.DenomBurnPolicies = []types.DenomBurnPolicy{
+		{Denom: "uatom", Action: types.DenomBurnActionBurn},
+		{Denom: "uatom", Action: types.DenomBurnActionRoute},
+	}
+	suite.Require().Error(duplicate.Validate())
+}
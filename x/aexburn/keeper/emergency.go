@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	appparams "github.com/sei-protocol/sei-chain/app/params"
+	"github.com/sei-protocol/sei-chain/x/aexburn/types"
+)
+
+// SetEmergencyPause pauses or resumes fee burning and inflation minting.
+// The pause flags take precedence over BurnEnabled and InflationEnabled
+func (k Keeper) SetEmergencyPause(ctx sdk.Context, burnPaused, mintPaused bool) {
+	state := types.EmergencyState{
+		BurnPaused:        burnPaused,
+		MintPaused:        mintPaused,
+		LastUpdatedHeight: ctx.BlockHeight(),
+	}
+	k.SetEmergencyState(ctx, state)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"aex_emergency_pause",
+			sdk.NewAttribute("burn_paused", strconv.FormatBool(burnPaused)),
+			sdk.NewAttribute("mint_paused", strconv.FormatBool(mintPaused)),
+		),
+	)
+
+	k.Logger(ctx).Info("emergency pause updated",
+		"burn_paused", burnPaused,
+		"mint_paused", mintPaused,
+	)
+}
+
+// DrainIncomeBuffer moves the whole income buffer balance out of the module account.
+// Funds go to the fee collector when recipient is empty. Returns the drained amount
+func (k Keeper) DrainIncomeBuffer(ctx sdk.Context, recipient sdk.AccAddress) (sdk.Int, error) {
+	buffer := k.GetIncomeBuffer(ctx)
+	if buffer.Balance.IsNil() || !buffer.Balance.IsPositive() {
+		return sdk.ZeroInt(), types.ErrEmptyIncomeBuffer
+	}
+
+	amount := buffer.Balance
+	coins := sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, amount))
+	var err error
+	if recipient.Empty() {
+		recipient = k.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+		err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, coins)
+	} else {
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins)
+	}
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	buffer.Balance = sdk.ZeroInt()
+	buffer.TotalReleased = buffer.TotalReleased.Add(amount)
+	buffer.LastReleaseBlock = ctx.BlockHeight()
+	k.SetIncomeBuffer(ctx, buffer)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"aex_income_buffer_drained",
+			sdk.NewAttribute("amount", amount.String()),
+			sdk.NewAttribute("recipient", recipient.String()),
+		),
+	)
+
+	k.Logger(ctx).Info("income buffer drained",
+		"amount", amount,
+		"recipient", recipient.String(),
+	)
+
+	return amount, nil
+}
+
+// ResetIncomeBuffer clears the income buffer accounting and re-bases its balance
+// on the AEX actually held by the module account
+func (k Keeper) ResetIncomeBuffer(ctx sdk.Context) types.IncomeBuffer {
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	balance := k.bankKeeper.GetBalance(ctx, moduleAddr, appparams.BaseCoinUnit)
+
+	buffer := types.IncomeBuffer{
+		Balance:           balance.Amount,
+		TotalContributed:  sdk.ZeroInt(),
+		TotalReleased:     sdk.ZeroInt(),
+		LastActivityLevel: sdk.ZeroDec(),
+	}
+	k.SetIncomeBuffer(ctx, buffer)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"aex_income_buffer_reset",
+			sdk.NewAttribute("buffer_balance", buffer.Balance.String()),
+		),
+	)
+
+	k.Logger(ctx).Info("income buffer reset", "buffer_balance", buffer.Balance)
+
+	return buffer
+}
+
+// GetEmergencyState returns the governance emergency controls
+func (k Keeper) GetEmergencyState(ctx sdk.Context) types.EmergencyState {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.EmergencyStateKey)
+	if bz == nil {
+		return types.EmergencyState{}
+	}
+
+	var state types.EmergencyState
+	k.cdc.MustUnmarshal(bz, &state)
+	return state
+}
+
+// SetEmergencyState sets the governance emergency controls
+func (k Keeper) SetEmergencyState(ctx sdk.Context, state types.EmergencyState) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&state)
+	store.Set(types.EmergencyStateKey, bz)
+}
@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/aexburn/types"
+)
+
+// EpochGasUsageRetention is the number of completed epoch gas usage records that are kept.
+// Older records are deleted when a new epoch is finalized
+const EpochGasUsageRetention uint64 = 100
+
+// accumulateEpochGasUsage adds a block's gas usage to the current epoch accumulator
+func (k Keeper) accumulateEpochGasUsage(ctx sdk.Context, usage types.BlockGasUsage) {
+	current := k.GetCurrentEpochGasUsage(ctx)
+	if current.BlockCount == 0 {
+		current.StartHeight = usage.BlockHeight
+	}
+
+	current.TotalGasUsed += usage.GasUsed
+	current.TotalGasLimit += usage.GasLimit
+	current.BlockCount++
+	current.EndHeight = usage.BlockHeight
+
+	if blockRate := usage.UsageRate(); blockRate.GT(current.PeakUsageRate) {
+		current.PeakUsageRate = blockRate
+	}
+
+	k.SetCurrentEpochGasUsage(ctx, current)
+}
+
+// FinalizeEpochGasUsage closes the current epoch accumulator, stores it under the
+// given epoch number and starts a new accumulator for the next epoch.
+// This should be called when an epoch ends
+func (k Keeper) FinalizeEpochGasUsage(ctx sdk.Context, epochNumber uint64) types.EpochGasUsage {
+	usage := k.GetCurrentEpochGasUsage(ctx)
+	usage.EpochNumber = epochNumber
+	k.SetEpochGasUsage(ctx, usage)
+	k.pruneEpochGasUsage(ctx, epochNumber, EpochGasUsageRetention)
+
+	next := types.NewEpochGasUsage()
+	next.EpochNumber = epochNumber + 1
+	k.SetCurrentEpochGasUsage(ctx, next)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"aex_epoch_gas_usage",
+			sdk.NewAttribute("epoch", sdk.NewIntFromUint64(epochNumber).String()),
+			sdk.NewAttribute("average_usage_rate", usage.AverageUsageRate().String()),
+			sdk.NewAttribute("peak_usage_rate", usage.PeakUsageRate.String()),
+			sdk.NewAttribute("block_count", sdk.NewIntFromUint64(usage.BlockCount).String()),
+		),
+	)
+
+	return usage
+}
+
+// pruneEpochGasUsage removes completed epoch records so that only the latest retention epochs are kept
+func (k Keeper) pruneEpochGasUsage(ctx sdk.Context, latestEpoch uint64, retention uint64) {
+	if latestEpoch < retention {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	// Keys are big-endian epoch numbers, so everything before the cutoff is expired
+	iterator := store.Iterator(types.EpochGasUsagePrefix, types.GetEpochGasUsageKey(latestEpoch-retention+1))
+	defer iterator.Close()
+
+	var expiredKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		expiredKeys = append(expiredKeys, iterator.Key())
+	}
+	for _, key := range expiredKeys {
+		store.Delete(key)
+	}
+}
+
+// GetCurrentEpochGasUsage returns the gas usage accumulated so far in the current epoch
+func (k Keeper) GetCurrentEpochGasUsage(ctx sdk.Context) types.EpochGasUsage {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CurrentEpochGasUsageKey)
+	if bz == nil {
+		return types.NewEpochGasUsage()
+	}
+
+	var usage types.EpochGasUsage
+	k.cdc.MustUnmarshal(bz, &usage)
+	return usage
+}
+
+// SetCurrentEpochGasUsage sets the gas usage accumulated so far in the current epoch
+func (k Keeper) SetCurrentEpochGasUsage(ctx sdk.Context, usage types.EpochGasUsage) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&usage)
+	store.Set(types.CurrentEpochGasUsageKey, bz)
+}
+
+// GetEpochGasUsage returns the gas usage of a completed epoch
+func (k Keeper) GetEpochGasUsage(ctx sdk.Context, epochNumber uint64) (types.EpochGasUsage, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetEpochGasUsageKey(epochNumber))
+	if bz == nil {
+		return types.EpochGasUsage{}, false
+	}
+
+	var usage types.EpochGasUsage
+	k.cdc.MustUnmarshal(bz, &usage)
+	return usage, true
+}
+
+// SetEpochGasUsage sets the gas usage of a completed epoch
+func (k Keeper) SetEpochGasUsage(ctx sdk.Context, usage types.EpochGasUsage) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&usage)
+	store.Set(types.GetEpochGasUsageKey(usage.EpochNumber), bz)
+}
+
+// GetAllEpochGasUsage returns the gas usage of all completed epochs
+func (k Keeper) GetAllEpochGasUsage(ctx sdk.Context) []types.EpochGasUsage {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.EpochGasUsagePrefix)
+	defer iterator.Close()
+
+	var allUsage []types.EpochGasUsage
+	for ; iterator.Valid(); iterator.Next() {
+		var usage types.EpochGasUsage
+		k.cdc.MustUnmarshal(iterator.Value(), &usage)
+		allUsage = append(allUsage, usage)
+	}
+	return allUsage
+}
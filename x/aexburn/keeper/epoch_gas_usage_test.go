@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/aexburn/keeper"
+	"github.com/sei-protocol/sei-chain/x/aexburn/types"
+	epochtypes "github.com/sei-protocol/sei-chain/x/epoch/types"
+)
+
+// ========== Epoch Gas Usage Tests ==========
+
+func (suite *KeeperTestSuite) TestEpochGasUsageAccumulation() {
+	suite.withBlockGasLimit(1000)
+
+	for height, gasUsed := range map[int64]uint64{5: 200, 6: 800, 7: 500} {
+		suite.App.AexburnKeeper.RecordBlockGasUsage(suite.Ctx.WithBlockHeight(height), gasUsed)
+	}
+
+	current := suite.App.AexburnKeeper.GetCurrentEpochGasUsage(suite.Ctx)
+	suite.Require().Equal(uint64(1500), current.TotalGasUsed)
+	suite.Require().Equal(uint64(3000), current.TotalGasLimit)
+	suite.Require().Equal(uint64(3), current.BlockCount)
+	suite.Require().Equal(sdk.NewDecWithPrec(80, 2), current.PeakUsageRate)
+	suite.Require().Equal(sdk.NewDecWithPrec(50, 2), current.AverageUsageRate())
+}
+
+func (suite *KeeperTestSuite) TestFinalizeEpochGasUsage() {
+	suite.withBlockGasLimit(1000)
+	suite.App.AexburnKeeper.RecordBlockGasUsage(suite.Ctx.WithBlockHeight(10), 300)
+	suite.App.AexburnKeeper.RecordBlockGasUsage(suite.Ctx.WithBlockHeight(11), 900)
+
+	finalized := suite.App.AexburnKeeper.FinalizeEpochGasUsage(suite.Ctx, 4)
+	suite.Require().Equal(uint64(4), finalized.EpochNumber)
+	suite.Require().Equal(int64(10), finalized.StartHeight)
+	suite.Require().Equal(int64(11), finalized.EndHeight)
+	suite.Require().Equal(sdk.NewDecWithPrec(60, 2), finalized.AverageUsageRate())
+	suite.Require().Equal(sdk.NewDecWithPrec(90, 2), finalized.PeakUsageRate)
+
+	stored, found := suite.App.AexburnKeeper.GetEpochGasUsage(suite.Ctx, 4)
+	suite.Require().True(found)
+	suite.Require().Equal(finalized, stored)
+
+	// The next epoch starts from an empty accumulator
+	current := suite.App.AexburnKeeper.GetCurrentEpochGasUsage(suite.Ctx)
+	suite.Require().Equal(uint64(0), current.BlockCount)
+	suite.Require().True(current.PeakUsageRate.IsZero())
+}
+
+func (suite *KeeperTestSuite) TestEpochGasUsagePruning() {
+	params := suite.App.AexburnKeeper.GetParams(suite.Ctx)
+	params.GasUsageWindow = 3
+	suite.App.AexburnKeeper.SetParams(suite.Ctx, params)
+	suite.withBlockGasLimit(1000)
+
+	lastEpoch := keeper.EpochGasUsageRetention + 2
+	for epoch := uint64(1); epoch <= lastEpoch; epoch++ {
+		suite.App.AexburnKeeper.RecordBlockGasUsage(suite.Ctx.WithBlockHeight(int64(epoch)), 500)
+		suite.App.AexburnKeeper.FinalizeEpochGasUsage(suite.Ctx, epoch)
+	}
+
+	// Only the latest EpochGasUsageRetention epochs are kept, regardless of the block gas usage window
+	for epoch := uint64(1); epoch <= 2; epoch++ {
+		_, found := suite.App.AexburnKeeper.GetEpochGasUsage(suite.Ctx, epoch)
+		suite.Require().False(found)
+	}
+	for epoch := uint64(3); epoch <= lastEpoch; epoch++ {
+		_, found := suite.App.AexburnKeeper.GetEpochGasUsage(suite.Ctx, epoch)
+		suite.Require().True(found)
+	}
+	suite.Require().Len(suite.App.AexburnKeeper.GetAllEpochGasUsage(suite.Ctx), int(keeper.EpochGasUsageRetention))
+}
+
+func (suite *KeeperTestSuite) TestAfterEpochEndUsesEpochGasUsage() {
+	params := suite.App.AexburnKeeper.GetParams(suite.Ctx)
+	params.InflationEnabled = true
+	params.MinGasUsageForInflation = sdk.NewDecWithPrec(50, 2)
+	suite.App.AexburnKeeper.SetParams(suite.Ctx, params)
+	suite.withBlockGasLimit(1000)
+
+	// A busy epoch averaging 90% gas usage triggers inflation
+	suite.App.AexburnKeeper.RecordBlockGasUsage(suite.Ctx.WithBlockHeight(1), 900)
+	suite.App.AexburnKeeper.RecordBlockGasUsage(suite.Ctx.WithBlockHeight(2), 900)
+	suite.App.AexburnKeeper.Hooks().AfterEpochEnd(suite.Ctx, epochtypes.Epoch{CurrentEpoch: 1})
+
+	record, found := suite.App.AexburnKeeper.GetMintRecord(suite.Ctx, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDecWithPrec(90, 2), record.GasUsageRate)
+	suite.Require().True(record.MintedAmount.IsPositive())
+
+	// A quiet epoch averaging 10% gas usage does not
+	suite.App.AexburnKeeper.RecordBlockGasUsage(suite.Ctx.WithBlockHeight(3), 100)
+	suite.App.AexburnKeeper.Hooks().AfterEpochEnd(suite.Ctx, epochtypes.Epoch{CurrentEpoch: 2})
+
+	_, found = suite.App.AexburnKeeper.GetMintRecord(suite.Ctx, 2)
+	suite.Require().False(found)
+
+	usage, found := suite.App.AexburnKeeper.GetEpochGasUsage(suite.Ctx, 2)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDecWithPrec(10, 2), usage.AverageUsageRate())
+}
+
+func (suite *KeeperTestSuite) TestEpochGasUsageGenesisValidation() {
+	genState := types.DefaultGenesis()
+	genState.EpochGasUsages = []types.EpochGasUsage{
+		{EpochNumber: 1, PeakUsageRate: sdk.ZeroDec()},
+		{EpochNumber: 1, PeakUsageRate: sdk.ZeroDec()},
+	}
+	suite.Require().Error(genState.Validate())
+}
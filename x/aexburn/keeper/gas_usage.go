@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/aexburn/types"
+)
+
+// RecordBlockGasUsage records the gas used by the current block against the
+// consensus block gas limit, evicts samples that fall outside the rolling window
+// and adds the block to the current epoch's gas usage.
+// This should be called at the end of each block
+func (k Keeper) RecordBlockGasUsage(ctx sdk.Context, gasUsed uint64) {
+	gasLimit := k.getBlockGasLimit(ctx)
+	if gasLimit == 0 {
+		// Without a block gas limit there is no capacity to measure usage against
+		return
+	}
+
+	usage := types.BlockGasUsage{
+		BlockHeight: ctx.BlockHeight(),
+		GasUsed:     gasUsed,
+		GasLimit:    gasLimit,
+	}
+	k.AddBlockGasUsage(ctx, usage)
+	k.pruneGasUsageWindow(ctx, k.GetParams(ctx).GasUsageWindow)
+
+	// Accumulate into the current epoch for epoch level inflation decisions
+	k.accumulateEpochGasUsage(ctx, usage)
+}
+
+// AddBlockGasUsage stores a block gas usage sample and adds it to the window aggregate
+func (k Keeper) AddBlockGasUsage(ctx sdk.Context, usage types.BlockGasUsage) {
+	window := k.GetGasUsageWindow(ctx)
+	if existing, found := k.GetBlockGasUsage(ctx, usage.BlockHeight); found {
+		// Replace an existing sample for the same height instead of double counting it
+		window.TotalGasUsed -= existing.GasUsed
+		window.TotalGasLimit -= existing.GasLimit
+		window.BlockCount--
+	}
+
+	window.TotalGasUsed += usage.GasUsed
+	window.TotalGasLimit += usage.GasLimit
+	window.BlockCount++
+
+	k.SetBlockGasUsage(ctx, usage)
+	k.SetGasUsageWindow(ctx, window)
+}
+
+// pruneGasUsageWindow removes the oldest samples until at most windowSize blocks remain
+func (k Keeper) pruneGasUsageWindow(ctx sdk.Context, windowSize uint64) {
+	window := k.GetGasUsageWindow(ctx)
+	if window.BlockCount <= windowSize {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.BlockGasUsagePrefix)
+	defer iterator.Close()
+
+	// Keys are big-endian heights, so iteration starts from the oldest sample
+	var expiredKeys [][]byte
+	for ; iterator.Valid() && window.BlockCount > windowSize; iterator.Next() {
+		var usage types.BlockGasUsage
+		k.cdc.MustUnmarshal(iterator.Value(), &usage)
+
+		window.TotalGasUsed -= usage.GasUsed
+		window.TotalGasLimit -= usage.GasLimit
+		window.BlockCount--
+		expiredKeys = append(expiredKeys, iterator.Key())
+	}
+
+	for _, key := range expiredKeys {
+		store.Delete(key)
+	}
+	k.SetGasUsageWindow(ctx, window)
+}
+
+// GetGasUsageRate returns the average gas usage rate over the rolling window as a decimal (0-1).
+// DefaultGasUsageRate is returned until any block gas usage has been recorded
+func (k Keeper) GetGasUsageRate(ctx sdk.Context) sdk.Dec {
+	window := k.GetGasUsageWindow(ctx)
+	if window.TotalGasLimit == 0 {
+		return types.DefaultGasUsageRate
+	}
+	return window.UsageRate()
+}
+
+// getBlockGasLimit returns the consensus max gas per block, or 0 if unlimited or unavailable
+func (k Keeper) getBlockGasLimit(ctx sdk.Context) uint64 {
+	consensusParams := ctx.ConsensusParams()
+	if consensusParams == nil || consensusParams.Block == nil || consensusParams.Block.MaxGas <= 0 {
+		return 0
+	}
+	return uint64(consensusParams.Block.MaxGas)
+}
+
+// GetBlockGasUsage returns the gas usage sample for a specific block height
+func (k Keeper) GetBlockGasUsage(ctx sdk.Context, blockHeight int64) (types.BlockGasUsage, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBlockGasUsageKey(blockHeight))
+	if bz == nil {
+		return types.BlockGasUsage{}, false
+	}
+
+	var usage types.BlockGasUsage
+	k.cdc.MustUnmarshal(bz, &usage)
+	return usage, true
+}
+
+// SetBlockGasUsage sets the gas usage sample for a specific block height
+func (k Keeper) SetBlockGasUsage(ctx sdk.Context, usage types.BlockGasUsage) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&usage)
+	store.Set(types.GetBlockGasUsageKey(usage.BlockHeight), bz)
+}
+
+// GetAllBlockGasUsage returns all block gas usage samples in the rolling window, oldest first
+func (k Keeper) GetAllBlockGasUsage(ctx sdk.Context) []types.BlockGasUsage {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.BlockGasUsagePrefix)
+	defer iterator.Close()
+
+	var allUsage []types.BlockGasUsage
+	for ; iterator.Valid(); iterator.Next() {
+		var usage types.BlockGasUsage
+		k.cdc.MustUnmarshal(iterator.Value(), &usage)
+		allUsage = append(allUsage, usage)
+	}
+	return allUsage
+}
+
+// GetGasUsageWindow returns the rolling gas usage window aggregate
+func (k Keeper) GetGasUsageWindow(ctx sdk.Context) types.GasUsageWindow {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GasUsageWindowKey)
+	if bz == nil {
+		return types.GasUsageWindow{}
+	}
+
+	var window types.GasUsageWindow
+	k.cdc.MustUnmarshal(bz, &window)
+	return window
+}
+
+// SetGasUsageWindow sets the rolling gas usage window aggregate
+func (k Keeper) SetGasUsageWindow(ctx sdk.Context, window types.GasUsageWindow) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&window)
+	store.Set(types.GasUsageWindowKey, bz)
+}
@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/sei-protocol/sei-chain/x/aexburn/types"
+)
+
+// ========== Gas Usage Tracking Tests ==========
+
+func (suite *KeeperTestSuite) withBlockGasLimit(maxGas int64) {
+	suite.Ctx = suite.Ctx.WithConsensusParams(&tmproto.ConsensusParams{
+		Block: &tmproto.BlockParams{MaxGas: maxGas},
+	})
+}
+
+func (suite *KeeperTestSuite) TestGasUsageRateDefault() {
+	// No gas usage recorded yet, the default rate should be used
+	rate := suite.App.AexburnKeeper.GetGasUsageRate(suite.Ctx)
+	suite.Require().Equal(types.DefaultGasUsageRate, rate)
+}
+
+func (suite *KeeperTestSuite) TestRecordBlockGasUsage() {
+	suite.withBlockGasLimit(1000)
+
+	suite.Ctx = suite.Ctx.WithBlockHeight(10)
+	suite.App.AexburnKeeper.RecordBlockGasUsage(suite.Ctx, 200)
+	suite.Ctx = suite.Ctx.WithBlockHeight(11)
+	suite.App.AexburnKeeper.RecordBlockGasUsage(suite.Ctx, 600)
+
+	usage, found := suite.App.AexburnKeeper.GetBlockGasUsage(suite.Ctx, 10)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(200), usage.GasUsed)
+	suite.Require().Equal(uint64(1000), usage.GasLimit)
+
+	window := suite.App.AexburnKeeper.GetGasUsageWindow(suite.Ctx)
+	suite.Require().Equal(uint64(800), window.TotalGasUsed)
+	suite.Require().Equal(uint64(2000), window.TotalGasLimit)
+	suite.Require().Equal(uint64(2), window.BlockCount)
+
+	// (200 + 600) / (1000 + 1000) = 40%
+	rate := suite.App.AexburnKeeper.GetGasUsageRate(suite.Ctx)
+	suite.Require().Equal(sdk.NewDecWithPrec(40, 2), rate)
+}
+
+func (suite *KeeperTestSuite) TestRecordBlockGasUsageWithoutGasLimit() {
+	// Unlimited block gas means there is nothing to measure usage against
+	suite.withBlockGasLimit(-1)
+	suite.App.AexburnKeeper.RecordBlockGasUsage(suite.Ctx, 500)
+
+	suite.Require().Empty(suite.App.AexburnKeeper.GetAllBlockGasUsage(suite.Ctx))
+	suite.Require().Equal(types.DefaultGasUsageRate, suite.App.AexburnKeeper.GetGasUsageRate(suite.Ctx))
+}
+
+func (suite *KeeperTestSuite) TestGasUsageWindowPruning() {
+	params := suite.App.AexburnKeeper.GetParams(suite.Ctx)
+	params.GasUsageWindow = 3
+	suite.App.AexburnKeeper.SetParams(suite.Ctx, params)
+	suite.withBlockGasLimit(1000)
+
+	for height := int64(1); height <= 5; height++ {
+		suite.Ctx = suite.Ctx.WithBlockHeight(height)
+		suite.App.AexburnKeeper.RecordBlockGasUsage(suite.Ctx, uint64(height*100))
+	}
+
+	// Only the last 3 blocks remain in the window
+	usages := suite.App.AexburnKeeper.GetAllBlockGasUsage(suite.Ctx)
+	suite.Require().Len(usages, 3)
+	suite.Require().Equal(int64(3), usages[0].BlockHeight)
+	_, found := suite.App.AexburnKeeper.GetBlockGasUsage(suite.Ctx, 2)
+	suite.Require().False(found)
+
+	// (300 + 400 + 500) / 3000 = 40%
+	rate := suite.App.AexburnKeeper.GetGasUsageRate(suite.Ctx)
+	suite.Require().Equal(sdk.NewDecWithPrec(40, 2), rate)
+
+	// Shrinking the window evicts the excess samples on the next record
+	params.GasUsageWindow = 1
+	suite.App.AexburnKeeper.SetParams(suite.Ctx, params)
+	suite.Ctx = suite.Ctx.WithBlockHeight(6)
+	suite.App.AexburnKeeper.RecordBlockGasUsage(suite.Ctx, 900)
+
+	suite.Require().Len(suite.App.AexburnKeeper.GetAllBlockGasUsage(suite.Ctx), 1)
+	suite.Require().Equal(sdk.NewDecWithPrec(90, 2), suite.App.AexburnKeeper.GetGasUsageRate(suite.Ctx))
+}
+
+func (suite *KeeperTestSuite) TestGasUsageRateCapped() {
+	suite.withBlockGasLimit(1000)
+	suite.App.AexburnKeeper.RecordBlockGasUsage(suite.Ctx, 1500)
+
+	suite.Require().Equal(sdk.OneDec(), suite.App.AexburnKeeper.GetGasUsageRate(suite.Ctx))
+}
+
+func (suite *KeeperTestSuite) TestDynamicBurnRateFollowsGasUsage() {
+	params := suite.App.AexburnKeeper.GetParams(suite.Ctx)
+	params.ReverseBrakeEnabled = false
+	suite.App.AexburnKeeper.SetParams(suite.Ctx, params)
+	suite.withBlockGasLimit(1000)
+
+	// 100% gas usage: burn rate reaches the maximum
+	suite.App.AexburnKeeper.RecordBlockGasUsage(suite.Ctx, 1000)
+	burnRate := suite.App.AexburnKeeper.CalculateDynamicBurnRate(suite.Ctx, params)
+	suite.Require().Equal(params.MaxBurnRate, burnRate)
+
+	// The activity level used by the income smoother follows the same measurement
+	suite.Require().Equal(sdk.OneDec(), suite.App.AexburnKeeper.GetCurrentActivityLevel(suite.Ctx))
+}
+
+func (suite *KeeperTestSuite) TestDynamicBurnRateLowGasUsage() {
+	params := suite.App.AexburnKeeper.GetParams(suite.Ctx)
+	params.ReverseBrakeEnabled = false
+	suite.App.AexburnKeeper.SetParams(suite.Ctx, params)
+	suite.withBlockGasLimit(1000)
+
+	// 0% gas usage: burn rate drops to the minimum
+	suite.App.AexburnKeeper.RecordBlockGasUsage(suite.Ctx, 0)
+	burnRate := suite.App.AexburnKeeper.CalculateDynamicBurnRate(suite.Ctx, params)
+	suite.Require().Equal(params.MinBurnRate, burnRate)
+}
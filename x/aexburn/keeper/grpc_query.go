@@ -0,0 +1,230 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sei-protocol/sei-chain/x/aexburn/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier defines a wrapper around the x/aexburn keeper providing gRPC method
+// handlers.
+type Querier struct {
+	Keeper
+}
+
+func NewQuerier(k Keeper) Querier {
+	return Querier{Keeper: k}
+}
+
+// Params returns params of the aexburn module.
+func (q Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsResponse{Params: q.Keeper.GetParams(ctx)}, nil
+}
+
+// BurnStats returns the cumulative burn statistics.
+func (q Querier) BurnStats(c context.Context, _ *types.QueryBurnStatsRequest) (*types.QueryBurnStatsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryBurnStatsResponse{BurnStats: q.Keeper.GetBurnStats(ctx)}, nil
+}
+
+// InflationStats returns the cumulative inflation statistics.
+func (q Querier) InflationStats(c context.Context, _ *types.QueryInflationStatsRequest) (*types.QueryInflationStatsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryInflationStatsResponse{InflationStats: q.Keeper.GetInflationStats(ctx)}, nil
+}
+
+// MonthlyBurnData returns the 12-month rolling window supply data.
+func (q Querier) MonthlyBurnData(c context.Context, _ *types.QueryMonthlyBurnDataRequest) (*types.QueryMonthlyBurnDataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryMonthlyBurnDataResponse{MonthlyBurnData: q.Keeper.GetAllMonthlyBurnData(ctx)}, nil
+}
+
+// NetSupply returns the net supply change in the 12-month window together with
+// the remaining capacity under the annual inflation and net supply caps.
+func (q Querier) NetSupply(c context.Context, _ *types.QueryNetSupplyRequest) (*types.QueryNetSupplyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := q.Keeper.GetParams(ctx)
+
+	totalBurned := sdk.ZeroInt()
+	totalMinted := sdk.ZeroInt()
+	for _, data := range q.Keeper.GetNetSupplyWindow(ctx) {
+		totalBurned = totalBurned.Add(data.BurnedAmount)
+		totalMinted = totalMinted.Add(data.MintedAmount)
+	}
+	netSupply := totalMinted.Sub(totalBurned)
+
+	maxNetSupply := params.MaxNetSupplyRatePerYear.MulInt(params.InitialSupply).TruncateInt()
+	maxAnnualInflation := params.MaxAnnualInflationRate.MulInt(params.InitialSupply).TruncateInt()
+
+	// Remaining capacity is bounded by both the net supply cap and the annual inflation cap
+	remaining := sdk.MinInt(
+		maxNetSupply.Sub(netSupply),
+		maxAnnualInflation.Sub(q.Keeper.GetAnnualMinted(ctx)),
+	)
+	if remaining.IsNegative() {
+		remaining = sdk.ZeroInt()
+	}
+
+	return &types.QueryNetSupplyResponse{
+		TotalMinted_12M:       totalMinted,
+		TotalBurned_12M:       totalBurned,
+		NetSupplyChange:       netSupply,
+		NetSupplyRate:         sdk.NewDecFromInt(netSupply).QuoInt(params.InitialSupply),
+		MaxAllowedNetSupply:   maxNetSupply,
+		RemainingMintCapacity: remaining,
+	}, nil
+}
+
+// EpochGasUsage returns the gas usage accumulated for a completed epoch.
+func (q Querier) EpochGasUsage(c context.Context, req *types.QueryEpochGasUsageRequest) (*types.QueryEpochGasUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	usage, found := q.Keeper.GetEpochGasUsage(ctx, req.EpochNumber)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no gas usage recorded for epoch %d", req.EpochNumber)
+	}
+
+	return &types.QueryEpochGasUsageResponse{
+		EpochGasUsage:    usage,
+		AverageUsageRate: usage.AverageUsageRate(),
+	}, nil
+}
+
+// CurrentEpochGasUsage returns the gas usage accumulated so far in the current epoch.
+func (q Querier) CurrentEpochGasUsage(c context.Context, _ *types.QueryCurrentEpochGasUsageRequest) (*types.QueryCurrentEpochGasUsageResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	usage := q.Keeper.GetCurrentEpochGasUsage(ctx)
+
+	return &types.QueryCurrentEpochGasUsageResponse{
+		EpochGasUsage:    usage,
+		AverageUsageRate: usage.AverageUsageRate(),
+	}, nil
+}
+
+// ReverseBrakeState returns the reverse brake mechanism state.
+func (q Querier) ReverseBrakeState(c context.Context, _ *types.QueryReverseBrakeStateRequest) (*types.QueryReverseBrakeStateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryReverseBrakeStateResponse{ReverseBrakeState: q.Keeper.GetReverseBrakeState(ctx)}, nil
+}
+
+// IncomeBuffer returns the validator income smoothing buffer state.
+func (q Querier) IncomeBuffer(c context.Context, _ *types.QueryIncomeBufferRequest) (*types.QueryIncomeBufferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryIncomeBufferResponse{IncomeBuffer: q.Keeper.GetIncomeBuffer(ctx)}, nil
+}
+
+// EmergencyState returns the governance emergency controls.
+func (q Querier) EmergencyState(c context.Context, _ *types.QueryEmergencyStateRequest) (*types.QueryEmergencyStateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryEmergencyStateResponse{EmergencyState: q.Keeper.GetEmergencyState(ctx)}, nil
+}
+
+// MintRecords returns the history of inflation mint records.
+func (q Querier) MintRecords(c context.Context, req *types.QueryMintRecordsRequest) (*types.QueryMintRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var records []types.MintRecord
+	store := prefix.NewStore(ctx.KVStore(q.Keeper.storeKey), types.MintRecordPrefix)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var record types.MintRecord
+		if err := q.Keeper.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMintRecordsResponse{MintRecords: records, Pagination: pageRes}, nil
+}
+
+// BurnRecords returns the history of per-block fee burn records, optionally
+// limited to an inclusive height range.
+func (q Querier) BurnRecords(c context.Context, req *types.QueryBurnRecordsRequest) (*types.QueryBurnRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.StartHeight < 0 || req.EndHeight < 0 || (req.EndHeight > 0 && req.EndHeight < req.StartHeight) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height range [%d, %d]", req.StartHeight, req.EndHeight)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req.StartHeight > 0 || req.EndHeight > 0 {
+		return q.burnRecordsInRange(ctx, req)
+	}
+
+	var records []types.BurnRecord
+	store := prefix.NewStore(ctx.KVStore(q.Keeper.storeKey), types.BurnRecordPrefix)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var record types.BurnRecord
+		if err := q.Keeper.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBurnRecordsResponse{BurnRecords: records, Pagination: pageRes}, nil
+}
+
+// burnRecordsInRange seeks directly to the requested heights instead of paging
+// through the whole history. Only key based pagination is supported here
+func (q Querier) burnRecordsInRange(ctx sdk.Context, req *types.QueryBurnRecordsRequest) (*types.QueryBurnRecordsResponse, error) {
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset > 0 || pageReq.Reverse {
+		return nil, status.Error(codes.InvalidArgument, "offset and reverse pagination are not supported with a height range")
+	}
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	startHeight := req.StartHeight
+	if len(pageReq.Key) > 0 {
+		// Keys are big-endian heights, as in the unbounded query
+		if len(pageReq.Key) != 8 {
+			return nil, status.Error(codes.InvalidArgument, "pagination key must be an 8 byte big-endian height")
+		}
+		startHeight = int64(sdk.BigEndianToUint64(pageReq.Key))
+	}
+
+	var records []types.BurnRecord
+	var nextKey []byte
+	q.Keeper.IterateBurnRecords(ctx, startHeight, req.EndHeight, func(record types.BurnRecord) bool {
+		if uint64(len(records)) == limit {
+			nextKey = sdk.Uint64ToBigEndian(uint64(record.BlockHeight))
+			return true
+		}
+		records = append(records, record)
+		return false
+	})
+
+	return &types.QueryBurnRecordsResponse{
+		BurnRecords: records,
+		Pagination:  &query.PageResponse{NextKey: nextKey},
+	}, nil
+}
@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/sei-protocol/sei-chain/x/aexburn/keeper"
+	"github.com/sei-protocol/sei-chain/x/aexburn/types"
+)
+
+// ========== gRPC Query Tests ==========
+
+func (suite *KeeperTestSuite) TestQueryParams() {
+	querier := keeper.NewQuerier(suite.App.AexburnKeeper)
+	ctx := sdk.WrapSDKContext(suite.Ctx)
+
+	res, err := querier.Params(ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.App.AexburnKeeper.GetParams(suite.Ctx), res.Params)
+}
+
+func (suite *KeeperTestSuite) TestQueryNetSupply() {
+	querier := keeper.NewQuerier(suite.App.AexburnKeeper)
+	params := suite.App.AexburnKeeper.GetParams(suite.Ctx)
+
+	suite.App.AexburnKeeper.SetMonthlyBurnData(suite.Ctx, types.MonthlyBurnData{
+		MonthIndex:   types.CalendarMonthIndex(suite.Ctx.BlockTime()),
+		BurnedAmount: sdk.NewInt(300),
+		MintedAmount: sdk.NewInt(1000),
+	})
+
+	res, err := querier.NetSupply(sdk.WrapSDKContext(suite.Ctx), &types.QueryNetSupplyRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(1000), res.TotalMinted_12M)
+	suite.Require().Equal(sdk.NewInt(300), res.TotalBurned_12M)
+	suite.Require().Equal(sdk.NewInt(700), res.NetSupplyChange)
+
+	maxNetSupply := params.MaxNetSupplyRatePerYear.MulInt(params.InitialSupply).TruncateInt()
+	suite.Require().Equal(maxNetSupply, res.MaxAllowedNetSupply)
+
+	// Remaining capacity is limited by whichever cap is tighter
+	maxAnnualInflation := params.MaxAnnualInflationRate.MulInt(params.InitialSupply).TruncateInt()
+	expected := sdk.MinInt(maxNetSupply.Sub(sdk.NewInt(700)), maxAnnualInflation)
+	suite.Require().Equal(expected, res.RemainingMintCapacity)
+}
+
+func (suite *KeeperTestSuite) TestQueryEpochGasUsage() {
+	querier := keeper.NewQuerier(suite.App.AexburnKeeper)
+	ctx := sdk.WrapSDKContext(suite.Ctx)
+
+	_, err := querier.EpochGasUsage(ctx, &types.QueryEpochGasUsageRequest{EpochNumber: 1})
+	suite.Require().Error(err)
+
+	suite.withBlockGasLimit(1000)
+	suite.App.AexburnKeeper.RecordBlockGasUsage(suite.Ctx, 250)
+
+	current, err := querier.CurrentEpochGasUsage(sdk.WrapSDKContext(suite.Ctx), &types.QueryCurrentEpochGasUsageRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecWithPrec(25, 2), current.AverageUsageRate)
+
+	suite.App.AexburnKeeper.FinalizeEpochGasUsage(suite.Ctx, 1)
+	res, err := querier.EpochGasUsage(sdk.WrapSDKContext(suite.Ctx), &types.QueryEpochGasUsageRequest{EpochNumber: 1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.EpochGasUsage.EpochNumber)
+	suite.Require().Equal(sdk.NewDecWithPrec(25, 2), res.AverageUsageRate)
+}
+
+func (suite *KeeperTestSuite) TestQueryMintRecordsPagination() {
+	querier := keeper.NewQuerier(suite.App.AexburnKeeper)
+
+	for epoch := uint64(1); epoch <= 5; epoch++ {
+		suite.App.AexburnKeeper.SaveMintRecord(suite.Ctx, types.MintRecord{
+			EpochNumber:  epoch,
+			MintedAmount: sdk.NewInt(int64(epoch * 100)),
+			GasUsageRate: sdk.ZeroDec(),
+		})
+	}
+
+	res, err := querier.MintRecords(sdk.WrapSDKContext(suite.Ctx), &types.QueryMintRecordsRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.MintRecords, 2)
+	suite.Require().Equal(uint64(1), res.MintRecords[0].EpochNumber)
+	suite.Require().Equal(uint64(5), res.Pagination.Total)
+
+	res, err = querier.MintRecords(sdk.WrapSDKContext(suite.Ctx), &types.QueryMintRecordsRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 10},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.MintRecords, 3)
+	suite.Require().Equal(uint64(3), res.MintRecords[0].EpochNumber)
+
+	_, err = querier.MintRecords(sdk.WrapSDKContext(suite.Ctx), nil)
+	suite.Require().Error(err)
+}
@@ -3,7 +3,10 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	appparams "github.com/sei-protocol/sei-chain/app/params"
+	"github.com/sei-protocol/sei-chain/x/aexburn/types"
 	epochTypes "github.com/sei-protocol/sei-chain/x/epoch/types"
+	minttypes "github.com/sei-protocol/sei-chain/x/mint/types"
 )
 
 // Hooks returns the epoch hooks for the aexburn module
@@ -11,21 +14,38 @@ func (k Keeper) Hooks() epochTypes.EpochHooks {
 	return Hooks{k}
 }
 
-// Hooks implements the epoch hooks interface
+// MintHooks returns the x/mint hooks for the aexburn module
+func (k Keeper) MintHooks() minttypes.MintHooks {
+	return Hooks{k}
+}
+
+// Hooks implements the epoch and mint hooks interfaces
 type Hooks struct {
 	k Keeper
 }
 
-var _ epochTypes.EpochHooks = Hooks{}
+var (
+	_ epochTypes.EpochHooks = Hooks{}
+	_ minttypes.MintHooks   = Hooks{}
+)
+
+// AfterDistributeMintedCoin is called after x/mint releases tokens. AEX released by
+// x/mint is not aexburn inflation, so it is added to the supply baseline instead
+func (h Hooks) AfterDistributeMintedCoin(ctx sdk.Context, mintedCoin sdk.Coin) {
+	if mintedCoin.Denom != appparams.BaseCoinUnit {
+		return
+	}
+	h.k.AddSupplyBaseline(ctx, mintedCoin.Amount)
+}
 
 // AfterEpochEnd is called at the end of each epoch
 // It triggers inflation minting based on chain activity and updates reverse brake state
 func (h Hooks) AfterEpochEnd(ctx sdk.Context, epoch epochTypes.Epoch) {
 	epochNumber := uint64(epoch.CurrentEpoch)
 
-	// Calculate gas usage rate for this epoch
-	// For now, we use a simplified approach based on block gas used
-	gasUsageRate := h.calculateGasUsageRate(ctx)
+	// Close the epoch's gas usage accumulator and derive the epoch's gas usage rate
+	epochGasUsage := h.k.FinalizeEpochGasUsage(ctx, epochNumber)
+	gasUsageRate := h.calculateGasUsageRate(ctx, epochGasUsage)
 
 	// Mint inflation tokens if conditions are met
 	if err := h.k.MintInflation(ctx, epochNumber, gasUsageRate); err != nil {
@@ -34,6 +54,9 @@ func (h Hooks) AfterEpochEnd(ctx sdk.Context, epoch epochTypes.Epoch) {
 
 	// Update reverse brake state based on net supply
 	h.k.UpdateReverseBrakeState(ctx, epochNumber)
+
+	// Drop the months that left the net supply window
+	h.k.PruneMonthlyBurnData(ctx)
 }
 
 // BeforeEpochStart is called at the start of each epoch
@@ -41,43 +64,12 @@ func (h Hooks) BeforeEpochStart(ctx sdk.Context, epoch epochTypes.Epoch) {
 	// Nothing to do at epoch start for inflation
 }
 
-// calculateGasUsageRate calculates the gas usage rate for the current epoch
-// Returns a value between 0 and 1 representing the percentage of block gas limit used
-func (h Hooks) calculateGasUsageRate(ctx sdk.Context) sdk.Dec {
-	// Get the consensus params to find the max gas per block
-	consensusParams := ctx.ConsensusParams()
-	if consensusParams == nil || consensusParams.Block == nil {
-		// No consensus params available, return default rate
-		return sdk.NewDecWithPrec(50, 2) // 50% default
-	}
-
-	maxGas := consensusParams.Block.MaxGas
-	if maxGas <= 0 {
-		// No gas limit set, use a default reasonable rate
-		return sdk.NewDecWithPrec(50, 2) // 50% default
+// calculateGasUsageRate calculates the gas usage rate for the ended epoch
+// Returns a value between 0 and 1 representing the average percentage of block gas limit used
+// across the epoch's blocks. Falls back to the rolling block window if no block was accumulated
+func (h Hooks) calculateGasUsageRate(ctx sdk.Context, epochGasUsage types.EpochGasUsage) sdk.Dec {
+	if epochGasUsage.TotalGasLimit == 0 {
+		return h.k.GetGasUsageRate(ctx)
 	}
-
-	// Get the gas used from the transaction gas meter
-	// Note: In epoch end hook, we estimate based on transaction gas meter
-	gasMeter := ctx.GasMeter()
-	if gasMeter == nil {
-		return sdk.NewDecWithPrec(50, 2) // 50% default
-	}
-
-	gasUsed := gasMeter.GasConsumed()
-	if gasUsed == 0 {
-		// No gas consumed in this context, use a moderate rate
-		return sdk.NewDecWithPrec(50, 2) // 50% default
-	}
-
-	// Calculate the usage rate
-	usageRate := sdk.NewDec(int64(gasUsed)).Quo(sdk.NewDec(maxGas))
-
-	// Cap at 1.0
-	if usageRate.GT(sdk.OneDec()) {
-		usageRate = sdk.OneDec()
-	}
-
-	return usageRate
+	return epochGasUsage.AverageUsageRate()
 }
-
@@ -194,12 +194,5 @@ func (k Keeper) releaseFromBuffer(
 // GetCurrentActivityLevel returns the current gas usage rate as a decimal (0-1)
 // This is used to determine whether we're in a high or low activity period
 func (k Keeper) GetCurrentActivityLevel(ctx sdk.Context) sdk.Dec {
-	// TODO: Implement actual gas usage tracking from EVM module
-	// For now, use a default value of 50% (normal activity)
-	// This should be replaced with actual gas usage data from the EVM module
-	// Example: actual_gas_used / target_gas_per_block
-
-	// Return a default value representing normal activity
-	return sdk.NewDecWithPrec(50, 2) // 50%
+	return k.GetGasUsageRate(ctx)
 }
-
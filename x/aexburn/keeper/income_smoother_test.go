@@ -68,9 +68,9 @@ func (suite *KeeperTestSuite) TestIncomeSmootherLowActivity() {
 	// Enable income smoother
 	params := suite.App.AexburnKeeper.GetParams(suite.Ctx)
 	params.IncomeSmootherEnabled = true
-	params.BufferReleaseRate = sdk.NewDecWithPrec(5, 2)      // 5%
-	params.LowActivityThreshold = sdk.NewDecWithPrec(60, 2)  // 60% (higher than default 50%)
-	params.MaxBufferSize = sdk.NewDecWithPrec(10, 2)         // 10% of initial supply
+	params.BufferReleaseRate = sdk.NewDecWithPrec(5, 2)     // 5%
+	params.LowActivityThreshold = sdk.NewDecWithPrec(60, 2) // 60% (higher than default 50%)
+	params.MaxBufferSize = sdk.NewDecWithPrec(10, 2)        // 10% of initial supply
 	suite.App.AexburnKeeper.SetParams(suite.Ctx, params)
 
 	// Pre-fund the buffer with some balance
@@ -120,10 +120,10 @@ func (suite *KeeperTestSuite) TestIncomeSmootherMaxBufferSize() {
 	// Enable income smoother with small max buffer
 	params := suite.App.AexburnKeeper.GetParams(suite.Ctx)
 	params.IncomeSmootherEnabled = true
-	params.BufferContributionRate = sdk.NewDecWithPrec(50, 2)  // 50%
-	params.HighActivityThreshold = sdk.NewDecWithPrec(40, 2)   // 40%
-	params.MaxBufferSize = sdk.NewDecWithPrec(1, 4)            // 0.01% of initial supply
-	params.InitialSupply = sdk.NewInt(1000000000000)           // 1 trillion
+	params.BufferContributionRate = sdk.NewDecWithPrec(50, 2) // 50%
+	params.HighActivityThreshold = sdk.NewDecWithPrec(40, 2)  // 40%
+	params.MaxBufferSize = sdk.NewDecWithPrec(1, 4)           // 0.01% of initial supply
+	params.InitialSupply = sdk.NewInt(1000000000000)          // 1 trillion
 	suite.App.AexburnKeeper.SetParams(suite.Ctx, params)
 
 	// Max buffer = 0.01% of 1 trillion = 100 million
@@ -179,4 +179,3 @@ func (suite *KeeperTestSuite) TestGetIncomeBufferDefault() {
 	suite.Require().Equal(int64(0), buffer.LastReleaseBlock)
 	suite.Require().True(buffer.LastActivityLevel.IsZero())
 }
-
@@ -12,8 +12,8 @@ import (
 func (k Keeper) MintInflation(ctx sdk.Context, epochNumber uint64, gasUsageRate sdk.Dec) error {
 	params := k.GetParams(ctx)
 
-	// Check if inflation is enabled
-	if !params.InflationEnabled {
+	// Check if inflation is enabled and not paused by governance
+	if !params.InflationEnabled || k.GetEmergencyState(ctx).MintPaused {
 		return nil
 	}
 
@@ -27,7 +27,7 @@ func (k Keeper) MintInflation(ctx sdk.Context, epochNumber uint64, gasUsageRate
 	}
 
 	// Calculate the inflation amount for this epoch
-	inflationAmount := k.calculateInflationAmount(ctx, params, epochNumber, gasUsageRate)
+	inflationAmount := k.calculateInflationAmount(ctx, params, gasUsageRate)
 	if inflationAmount.IsZero() {
 		return nil
 	}
@@ -43,25 +43,26 @@ func (k Keeper) MintInflation(ctx sdk.Context, epochNumber uint64, gasUsageRate
 		return err
 	}
 
+	// Save mint record, which also feeds the rolling annual window
+	k.SaveMintRecord(ctx, types.MintRecord{
+		EpochNumber:   epochNumber,
+		BlockHeight:   ctx.BlockHeight(),
+		MintedAmount:  inflationAmount,
+		GasUsageRate:  gasUsageRate,
+		TriggerReason: "gas_usage",
+		BlockTime:     ctx.BlockTime(),
+	})
+
 	// Update inflation stats
 	stats := k.GetInflationStats(ctx)
 	stats.TotalMinted = stats.TotalMinted.Add(inflationAmount)
-	stats.AnnualMinted = stats.AnnualMinted.Add(inflationAmount)
+	stats.AnnualMinted = k.GetAnnualMinted(ctx)
 	stats.LastMintEpoch = epochNumber
 	stats.LastMintBlockHeight = ctx.BlockHeight()
 	k.SetInflationStats(ctx, stats)
 
 	// Update monthly data
-	k.updateMonthlyMintData(ctx, epochNumber, inflationAmount, params.EpochsPerYear)
-
-	// Save mint record
-	k.SaveMintRecord(ctx, types.MintRecord{
-		EpochNumber:   epochNumber,
-		BlockHeight:   ctx.BlockHeight(),
-		MintedAmount:  inflationAmount,
-		GasUsageRate:  gasUsageRate,
-		TriggerReason: "gas_usage",
-	})
+	k.updateMonthlyBurnData(ctx, epochNumber, inflationAmount, sdk.ZeroInt())
 
 	// Emit event
 	ctx.EventManager().EmitEvent(
@@ -83,24 +84,17 @@ func (k Keeper) MintInflation(ctx sdk.Context, epochNumber uint64, gasUsageRate
 }
 
 // calculateInflationAmount calculates how much to mint this epoch
-func (k Keeper) calculateInflationAmount(ctx sdk.Context, params types.Params, epochNumber uint64, gasUsageRate sdk.Dec) sdk.Int {
-	stats := k.GetInflationStats(ctx)
+func (k Keeper) calculateInflationAmount(ctx sdk.Context, params types.Params, gasUsageRate sdk.Dec) sdk.Int {
+	// Amount already minted in the rolling 365-day window
+	annualMinted := k.GetAnnualMinted(ctx)
 
-	// Check if we need to reset annual counter
-	epochsSinceReset := epochNumber - stats.LastAnnualResetEpoch
-	if epochsSinceReset >= params.EpochsPerYear {
-		// Reset annual minted counter
-		stats.AnnualMinted = sdk.ZeroInt()
-		stats.LastAnnualResetEpoch = epochNumber
-		k.SetInflationStats(ctx, stats)
-	}
-
-	// Calculate max inflation for this epoch (annual cap / epochs per year)
+	// Calculate max inflation for this epoch (annual cap / epochs per year). EpochsPerYear
+	// only paces minting, the caps themselves are enforced over block time windows
 	maxAnnualInflation := params.MaxAnnualInflationRate.MulInt(params.InitialSupply).TruncateInt()
 	maxEpochInflation := maxAnnualInflation.Quo(sdk.NewInt(int64(params.EpochsPerYear)))
 
 	// Check annual cap constraint
-	remainingAnnualBudget := maxAnnualInflation.Sub(stats.AnnualMinted)
+	remainingAnnualBudget := maxAnnualInflation.Sub(annualMinted)
 	if remainingAnnualBudget.IsNegative() || remainingAnnualBudget.IsZero() {
 		k.Logger(ctx).Info("annual inflation cap reached")
 		return sdk.ZeroInt()
@@ -140,30 +134,3 @@ func (k Keeper) calculateInflationAmount(ctx sdk.Context, params types.Params, e
 
 	return epochInflation
 }
-
-// updateMonthlyMintData updates the monthly mint tracking data
-func (k Keeper) updateMonthlyMintData(ctx sdk.Context, epochNumber uint64, mintedAmount sdk.Int, epochsPerYear uint64) {
-	// Calculate month index (0-11) based on epoch
-	epochsPerMonth := epochsPerYear / 12
-	if epochsPerMonth == 0 {
-		epochsPerMonth = 1
-	}
-	monthIndex := uint32((epochNumber / epochsPerMonth) % 12)
-
-	data, found := k.GetMonthlyBurnData(ctx, monthIndex)
-	if !found {
-		data = types.MonthlyBurnData{
-			MonthIndex:   monthIndex,
-			BurnedAmount: sdk.ZeroInt(),
-			MintedAmount: sdk.ZeroInt(),
-			StartEpoch:   epochNumber,
-			EndEpoch:     epochNumber,
-		}
-	}
-
-	data.MintedAmount = data.MintedAmount.Add(mintedAmount)
-	data.EndEpoch = epochNumber
-
-	k.SetMonthlyBurnData(ctx, data)
-}
-
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
@@ -80,29 +81,28 @@ func (suite *InflationTestSuite) TestMintInflation_AnnualCapConstraint() {
 	initialSupply := params.InitialSupply
 	annualCap := sdk.NewDecFromInt(initialSupply).Mul(params.MaxAnnualInflationRate).TruncateInt()
 
-	stats := types.InflationStats{
-		TotalMinted:          sdk.ZeroInt(),
-		AnnualMinted:         annualCap.Sub(sdk.NewInt(1000)), // Just below cap
-		LastAnnualResetEpoch: 0,
-		LastMintEpoch:        0,
-		LastMintBlockHeight:  0,
-	}
-	suite.App.AexburnKeeper.SetInflationStats(suite.Ctx, stats)
+	suite.App.AexburnKeeper.SaveMintRecord(suite.Ctx, types.MintRecord{
+		EpochNumber:  1,
+		MintedAmount: annualCap.Sub(sdk.NewInt(1000)), // Just below cap
+		BlockTime:    suite.Ctx.BlockTime().Add(-time.Hour),
+	})
 
 	// Try to mint - should be limited by annual cap
-	err := suite.App.AexburnKeeper.MintInflation(suite.Ctx, 1, sdk.NewDecWithPrec(60, 2))
+	err := suite.App.AexburnKeeper.MintInflation(suite.Ctx, 2, sdk.NewDecWithPrec(60, 2))
 	suite.Require().NoError(err)
 
 	newStats := suite.App.AexburnKeeper.GetInflationStats(suite.Ctx)
 	// Annual minted should not exceed cap
-	suite.Require().True(newStats.AnnualMinted.LTE(annualCap))
+	suite.Require().Equal(sdk.NewInt(1000), newStats.TotalMinted)
+	suite.Require().Equal(annualCap, newStats.AnnualMinted)
 }
 
 func (suite *InflationTestSuite) TestGet12MonthNetSupply() {
 	// Set up monthly data with more minted than burned
+	currentMonth := types.CalendarMonthIndex(suite.Ctx.BlockTime())
 	for i := uint32(0); i < 12; i++ {
 		data := types.MonthlyBurnData{
-			MonthIndex:   i,
+			MonthIndex:   currentMonth - i,
 			BurnedAmount: sdk.NewInt(100000),
 			MintedAmount: sdk.NewInt(150000),
 		}
@@ -116,9 +116,10 @@ func (suite *InflationTestSuite) TestGet12MonthNetSupply() {
 
 func (suite *InflationTestSuite) TestGet12MonthNetSupply_Negative() {
 	// Set up monthly data with more burned than minted
+	currentMonth := types.CalendarMonthIndex(suite.Ctx.BlockTime())
 	for i := uint32(0); i < 12; i++ {
 		data := types.MonthlyBurnData{
-			MonthIndex:   i,
+			MonthIndex:   currentMonth - i,
 			BurnedAmount: sdk.NewInt(200000),
 			MintedAmount: sdk.NewInt(100000),
 		}
@@ -142,9 +143,10 @@ func (suite *InflationTestSuite) TestMintInflation_NetSupplyConstraint() {
 	netSupplyLimit := sdk.NewDecFromInt(initialSupply).Mul(params.MaxNetSupplyRatePerYear).TruncateInt()
 
 	// Set monthly data with high minted amounts
+	currentMonth := types.CalendarMonthIndex(suite.Ctx.BlockTime())
 	for i := uint32(0); i < 12; i++ {
 		data := types.MonthlyBurnData{
-			MonthIndex:   i,
+			MonthIndex:   currentMonth - i,
 			BurnedAmount: sdk.ZeroInt(),
 			MintedAmount: netSupplyLimit.Quo(sdk.NewInt(12)),
 		}
@@ -155,4 +157,3 @@ func (suite *InflationTestSuite) TestMintInflation_NetSupplyConstraint() {
 	err := suite.App.AexburnKeeper.MintInflation(suite.Ctx, 1, sdk.NewDecWithPrec(60, 2))
 	suite.Require().NoError(err)
 }
-
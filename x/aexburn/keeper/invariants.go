@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	appparams "github.com/sei-protocol/sei-chain/app/params"
+	"github.com/sei-protocol/sei-chain/x/aexburn/types"
+)
+
+// RegisterInvariants registers the aexburn module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "income-buffer-balance", IncomeBufferBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "supply-reconciliation", SupplyReconciliationInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "net-supply-cap", NetSupplyCapInvariant(k))
+}
+
+// AllInvariants runs all invariants of the x/aexburn module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := IncomeBufferBalanceInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = SupplyReconciliationInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return NetSupplyCapInvariant(k)(ctx)
+	}
+}
+
+// IncomeBufferBalanceInvariant checks that the AEX held by the aexburn module
+// account equals the tracked income buffer balance. Minted inflation only
+// passes through the module account within a single call, so at block
+// boundaries the income buffer is the only thing the account holds
+func IncomeBufferBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+		moduleBalance := k.bankKeeper.GetBalance(ctx, moduleAddr, appparams.BaseCoinUnit).Amount
+		bufferBalance := zeroIfNil(k.GetIncomeBuffer(ctx).Balance)
+
+		broken := !moduleBalance.Equal(bufferBalance)
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "income-buffer-balance",
+			fmt.Sprintf("\tmodule account balance: %s%s\n\tincome buffer balance: %s%s\n",
+				moduleBalance, appparams.BaseCoinUnit, bufferBalance, appparams.BaseCoinUnit),
+		), broken
+	}
+}
+
+// SupplyReconciliationInvariant checks that the bank supply of AEX does not
+// exceed the supply issued outside of aexburn (genesis supply plus x/mint
+// releases) plus the net amount aexburn has minted. Other modules (e.g.
+// slashing) may legitimately burn AEX, so supply falling short of the
+// accounting is tolerated, while any supply above it means AEX was minted
+// without being recorded or burns were recorded without burning
+func SupplyReconciliationInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		supply := k.bankKeeper.GetSupply(ctx, appparams.BaseCoinUnit).Amount
+		baseline := k.GetSupplyBaseline(ctx)
+		totalMinted := zeroIfNil(k.GetInflationStats(ctx).TotalMinted)
+		totalBurned := zeroIfNil(k.GetBurnStats(ctx).TotalBurned)
+
+		expectedMaxSupply := baseline.Add(totalMinted).Sub(totalBurned)
+		broken := supply.GT(expectedMaxSupply)
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "supply-reconciliation",
+			fmt.Sprintf("\tbank supply: %s%s\n\tsupply baseline + total minted - total burned: %s%s (%s + %s - %s)\n",
+				supply, appparams.BaseCoinUnit, expectedMaxSupply, appparams.BaseCoinUnit,
+				baseline, totalMinted, totalBurned),
+		), broken
+	}
+}
+
+// NetSupplyCapInvariant checks that the net supply change over the 12-month
+// rolling window stays within MaxNetSupplyRatePerYear of InitialSupply
+func NetSupplyCapInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		params := k.GetParams(ctx)
+		netSupply := k.Get12MonthNetSupply(ctx)
+		maxNetSupply := params.MaxNetSupplyRatePerYear.MulInt(params.InitialSupply).TruncateInt()
+
+		broken := netSupply.GT(maxNetSupply)
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "net-supply-cap",
+			fmt.Sprintf("\t12-month net supply change: %s%s\n\tmax allowed net supply change: %s%s\n",
+				netSupply, appparams.BaseCoinUnit, maxNetSupply, appparams.BaseCoinUnit),
+		), broken
+	}
+}
+
+func zeroIfNil(i sdk.Int) sdk.Int {
+	if i.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return i
+}
@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	appparams "github.com/sei-protocol/sei-chain/app/params"
+	"github.com/sei-protocol/sei-chain/x/aexburn"
+	"github.com/sei-protocol/sei-chain/x/aexburn/keeper"
+	"github.com/sei-protocol/sei-chain/x/aexburn/types"
+	epochtypes "github.com/sei-protocol/sei-chain/x/epoch/types"
+	minttypes "github.com/sei-protocol/sei-chain/x/mint/types"
+)
+
+// ========== Invariant Tests ==========
+
+func (suite *KeeperTestSuite) TestInvariantsHoldOnFreshState() {
+	_, broken := keeper.AllInvariants(suite.App.AexburnKeeper)(suite.Ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestIncomeBufferBalanceInvariant() {
+	invariant := keeper.IncomeBufferBalanceInvariant(suite.App.AexburnKeeper)
+
+	// Contributions through the income smoother keep the buffer and module account in sync
+	params := suite.App.AexburnKeeper.GetParams(suite.Ctx)
+	params.IncomeSmootherEnabled = true
+	params.HighActivityThreshold = sdk.NewDecWithPrec(40, 2)
+	params.LowActivityThreshold = sdk.NewDecWithPrec(30, 2)
+	suite.App.AexburnKeeper.SetParams(suite.Ctx, params)
+	suite.withBlockGasLimit(1000)
+	suite.App.AexburnKeeper.RecordBlockGasUsage(suite.Ctx, 900)
+
+	feeCoins := sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, sdk.NewInt(1000000)))
+	suite.Require().NoError(suite.App.BankKeeper.MintCoins(suite.Ctx, types.ModuleName, feeCoins))
+	suite.Require().NoError(suite.App.BankKeeper.SendCoinsFromModuleToModule(suite.Ctx, types.ModuleName, authtypes.FeeCollectorName, feeCoins))
+	_, err := suite.App.AexburnKeeper.SmoothIncome(suite.Ctx, feeCoins)
+	suite.Require().NoError(err)
+	suite.Require().True(suite.App.AexburnKeeper.GetIncomeBuffer(suite.Ctx).Balance.IsPositive())
+
+	_, broken := invariant(suite.Ctx)
+	suite.Require().False(broken)
+
+	// Buffer accounting that drifts from the module account is caught
+	buffer := suite.App.AexburnKeeper.GetIncomeBuffer(suite.Ctx)
+	buffer.Balance = buffer.Balance.AddRaw(1)
+	suite.App.AexburnKeeper.SetIncomeBuffer(suite.Ctx, buffer)
+
+	_, broken = invariant(suite.Ctx)
+	suite.Require().True(broken)
+}
+
+func (suite *KeeperTestSuite) TestSupplyReconciliationInvariant() {
+	invariant := keeper.SupplyReconciliationInvariant(suite.App.AexburnKeeper)
+
+	// A genesis supply that does not match InitialSupply is anchored at genesis
+	genesisCoins := sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, sdk.NewInt(123_456_789)))
+	suite.Require().NoError(suite.App.BankKeeper.MintCoins(suite.Ctx, minttypes.ModuleName, genesisCoins))
+	aexburn.InitGenesis(suite.Ctx, suite.App.AexburnKeeper, *types.DefaultGenesis())
+	supply := suite.App.BankKeeper.GetSupply(suite.Ctx, appparams.BaseCoinUnit).Amount
+	suite.Require().False(supply.Equal(types.DefaultInitialSupply))
+	suite.Require().Equal(supply, suite.App.AexburnKeeper.GetSupplyBaseline(suite.Ctx))
+
+	_, broken := invariant(suite.Ctx)
+	suite.Require().False(broken)
+
+	// Releases by x/mint are reported through the mint hooks
+	releaseTime := time.Now().UTC()
+	suite.App.MintKeeper.SetParams(suite.Ctx, minttypes.NewParams(appparams.BaseCoinUnit, []minttypes.ScheduledTokenRelease{{
+		StartDate:          releaseTime.Format(minttypes.TokenReleaseDateFormat),
+		EndDate:            releaseTime.AddDate(0, 0, 10).Format(minttypes.TokenReleaseDateFormat),
+		TokenReleaseAmount: 1_000_000,
+	}}))
+	suite.App.MintKeeper.Hooks().AfterEpochEnd(suite.Ctx, epochtypes.Epoch{
+		CurrentEpoch:          1,
+		CurrentEpochStartTime: releaseTime,
+		CurrentEpochHeight:    suite.Ctx.BlockHeight(),
+	})
+	mintedSupply := suite.App.BankKeeper.GetSupply(suite.Ctx, appparams.BaseCoinUnit).Amount
+	suite.Require().True(mintedSupply.GT(supply))
+	suite.Require().Equal(mintedSupply, suite.App.AexburnKeeper.GetSupplyBaseline(suite.Ctx))
+
+	_, broken = invariant(suite.Ctx)
+	suite.Require().False(broken)
+
+	// Recorded burns that never reduced supply break the reconciliation
+	stats := suite.App.AexburnKeeper.GetBurnStats(suite.Ctx)
+	stats.TotalBurned = sdk.NewInt(1)
+	suite.App.AexburnKeeper.SetBurnStats(suite.Ctx, stats)
+
+	_, broken = invariant(suite.Ctx)
+	suite.Require().True(broken)
+
+	// Reset, then mint outside of aexburn and x/mint accounting
+	stats.TotalBurned = sdk.ZeroInt()
+	suite.App.AexburnKeeper.SetBurnStats(suite.Ctx, stats)
+	coins := sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, sdk.NewInt(1)))
+	suite.Require().NoError(suite.App.BankKeeper.MintCoins(suite.Ctx, minttypes.ModuleName, coins))
+
+	_, broken = invariant(suite.Ctx)
+	suite.Require().True(broken)
+}
+
+func (suite *KeeperTestSuite) TestNetSupplyCapInvariant() {
+	invariant := keeper.NetSupplyCapInvariant(suite.App.AexburnKeeper)
+	params := suite.App.AexburnKeeper.GetParams(suite.Ctx)
+	maxNetSupply := params.MaxNetSupplyRatePerYear.MulInt(params.InitialSupply).TruncateInt()
+	currentMonth := types.CalendarMonthIndex(suite.Ctx.BlockTime())
+
+	suite.App.AexburnKeeper.SetMonthlyBurnData(suite.Ctx, types.MonthlyBurnData{
+		MonthIndex:   currentMonth,
+		BurnedAmount: sdk.ZeroInt(),
+		MintedAmount: maxNetSupply,
+	})
+	_, broken := invariant(suite.Ctx)
+	suite.Require().False(broken)
+
+	suite.App.AexburnKeeper.SetMonthlyBurnData(suite.Ctx, types.MonthlyBurnData{
+		MonthIndex:   currentMonth - 1,
+		BurnedAmount: sdk.ZeroInt(),
+		MintedAmount: sdk.OneInt(),
+	})
+	_, broken = invariant(suite.Ctx)
+	suite.Require().True(broken)
+}
+
+func (suite *KeeperTestSuite) TestUpdateParamsRejectsCapBelowNetSupply() {
+	params := suite.App.AexburnKeeper.GetParams(suite.Ctx)
+	suite.App.AexburnKeeper.SetMonthlyBurnData(suite.Ctx, types.MonthlyBurnData{
+		MonthIndex:   types.CalendarMonthIndex(suite.Ctx.BlockTime()),
+		BurnedAmount: sdk.ZeroInt(),
+		MintedAmount: params.MaxNetSupplyRatePerYear.MulInt(params.InitialSupply).TruncateInt(),
+	})
+
+	// Lowering the cap below the net supply already minted would break the invariant
+	params.MaxNetSupplyRatePerYear = sdk.NewDecWithPrec(1, 2)
+	err := suite.App.AexburnKeeper.UpdateParams(suite.Ctx, params)
+	suite.Require().ErrorIs(err, types.ErrInvalidParams)
+
+	params.MaxNetSupplyRatePerYear = sdk.NewDecWithPrec(10, 2)
+	suite.Require().NoError(suite.App.AexburnKeeper.UpdateParams(suite.Ctx, params))
+}
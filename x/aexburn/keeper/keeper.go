@@ -4,6 +4,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -18,6 +19,12 @@ type Keeper struct {
 
 	accountKeeper types.AccountKeeper
 	bankKeeper    types.BankKeeper
+	distrKeeper   types.DistributionKeeper
+	oracleKeeper  types.OracleKeeper
+
+	// authority is the address allowed to execute the module's Msg service,
+	// usually the gov module account
+	authority string
 }
 
 // NewKeeper creates a new aexburn Keeper instance
@@ -27,6 +34,9 @@ func NewKeeper(
 	paramSpace paramtypes.Subspace,
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
+	distrKeeper types.DistributionKeeper,
+	oracleKeeper types.OracleKeeper,
+	authority string,
 ) Keeper {
 	// Set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
@@ -39,9 +49,17 @@ func NewKeeper(
 		paramSpace:    paramSpace,
 		accountKeeper: accountKeeper,
 		bankKeeper:    bankKeeper,
+		distrKeeper:   distrKeeper,
+		oracleKeeper:  oracleKeeper,
+		authority:     authority,
 	}
 }
 
+// GetAuthority returns the address allowed to execute the module's Msg service
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -54,21 +72,47 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return params
 }
 
+// GetParamsIfExists returns the module parameters, leaving params missing from the store unset
+func (k Keeper) GetParamsIfExists(ctx sdk.Context) types.Params {
+	var params types.Params
+	k.paramSpace.GetParamSetIfExists(ctx, &params)
+	return params
+}
+
 // SetParams sets the module parameters
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
 }
 
+// UpdateParams validates and sets new module parameters on behalf of governance.
+// Updates that would immediately break the net supply cap invariant are rejected
+func (k Keeper) UpdateParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidParams, err.Error())
+	}
+
+	netSupply := k.Get12MonthNetSupply(ctx)
+	maxNetSupply := params.MaxNetSupplyRatePerYear.MulInt(params.InitialSupply).TruncateInt()
+	if netSupply.GT(maxNetSupply) {
+		return sdkerrors.Wrapf(types.ErrInvalidParams,
+			"max net supply %s is below the current 12-month net supply %s", maxNetSupply, netSupply)
+	}
+
+	k.SetParams(ctx, params)
+	return nil
+}
+
 // GetBurnStats returns the burn statistics
 func (k Keeper) GetBurnStats(ctx sdk.Context) types.BurnStats {
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(types.BurnStatsKey)
 	if bz == nil {
 		return types.BurnStats{
-			TotalBurned:     sdk.ZeroInt(),
-			LastBurnRate:    sdk.ZeroDec(),
-			LastEpochNumber: 0,
-			LastBlockHeight: 0,
+			TotalBurned:              sdk.ZeroInt(),
+			LastBurnRate:             sdk.ZeroDec(),
+			LastEpochNumber:          0,
+			LastBlockHeight:          0,
+			TotalAexEquivalentBurned: sdk.ZeroInt(),
 		}
 	}
 
@@ -84,7 +128,7 @@ func (k Keeper) SetBurnStats(ctx sdk.Context, stats types.BurnStats) {
 	store.Set(types.BurnStatsKey, bz)
 }
 
-// GetMonthlyBurnData returns the monthly burn data for a specific month index
+// GetMonthlyBurnData returns the monthly burn data for a specific calendar month index
 func (k Keeper) GetMonthlyBurnData(ctx sdk.Context, monthIndex uint32) (types.MonthlyBurnData, bool) {
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(types.GetMonthlyBurnDataKey(monthIndex))
@@ -97,7 +141,7 @@ func (k Keeper) GetMonthlyBurnData(ctx sdk.Context, monthIndex uint32) (types.Mo
 	return data, true
 }
 
-// SetMonthlyBurnData sets the monthly burn data for a specific month index
+// SetMonthlyBurnData sets the monthly burn data for a specific calendar month index
 func (k Keeper) SetMonthlyBurnData(ctx sdk.Context, data types.MonthlyBurnData) {
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(&data)
@@ -167,16 +211,29 @@ func (k Keeper) GetMintRecord(ctx sdk.Context, epochNumber uint64) (types.MintRe
 	return record, true
 }
 
-// Get12MonthNetSupply calculates the net supply change over the last 12 months
+// GetAllMintRecords returns all mint records ordered by epoch
+func (k Keeper) GetAllMintRecords(ctx sdk.Context) []types.MintRecord {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.MintRecordPrefix)
+	defer iterator.Close()
+
+	var records []types.MintRecord
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.MintRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// Get12MonthNetSupply calculates the net supply change over the current calendar
+// month and the 11 months before it
 // Net supply = minted - burned over the period
 func (k Keeper) Get12MonthNetSupply(ctx sdk.Context) sdk.Int {
-	monthlyData := k.GetAllMonthlyBurnData(ctx)
-
 	totalBurned := sdk.ZeroInt()
 	totalMinted := sdk.ZeroInt()
 
-	// Sum up last 12 months of data
-	for _, data := range monthlyData {
+	for _, data := range k.GetNetSupplyWindow(ctx) {
 		totalBurned = totalBurned.Add(data.BurnedAmount)
 		totalMinted = totalMinted.Add(data.MintedAmount)
 	}
@@ -142,4 +142,3 @@ func (suite *KeeperTestSuite) TestGetReverseBrakeStateDefault() {
 	suite.Require().False(state.IsBrakeActive)
 	suite.Require().True(state.CurrentReduction.IsZero())
 }
-
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/aexburn/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 adds the gas usage window param introduced with block gas usage tracking
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params := m.keeper.GetParamsIfExists(ctx)
+	params.GasUsageWindow = types.DefaultGasUsageWindow
+	m.keeper.SetParams(ctx, params)
+	return nil
+}
+
+// Migrate2to3 adds the burn record retention param introduced with per-block burn records
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	params := m.keeper.GetParamsIfExists(ctx)
+	params.BurnRecordRetention = types.DefaultBurnRecordRetention
+	m.keeper.SetParams(ctx, params)
+	return nil
+}
+
+// Migrate3to4 adds the denom burn policies param introduced with multi-denom fee burning
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	params := m.keeper.GetParamsIfExists(ctx)
+	params.DenomBurnPolicies = types.DefaultDenomBurnPolicies
+	m.keeper.SetParams(ctx, params)
+	return nil
+}
+
+// Migrate4to5 moves the supply windows from epoch counts to block time. The epoch
+// indexed monthly buckets cannot be mapped back to calendar months, so they are merged
+// into the current month and keep counting towards the 12-month net supply for a full
+// window. Mint records of the current annual period are stamped with the upgrade time
+// for the same reason, older records fall out of the rolling annual window
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	k := m.keeper
+	store := ctx.KVStore(k.storeKey)
+
+	// Legacy monthly keys are the prefix followed by a single month index byte
+	iterator := sdk.KVStorePrefixIterator(store, types.MonthlyBurnDataPrefix)
+	var legacyKeys [][]byte
+	var legacyData []types.MonthlyBurnData
+	for ; iterator.Valid(); iterator.Next() {
+		if len(iterator.Key()) != len(types.MonthlyBurnDataPrefix)+1 {
+			continue
+		}
+		var data types.MonthlyBurnData
+		k.cdc.MustUnmarshal(iterator.Value(), &data)
+		legacyKeys = append(legacyKeys, iterator.Key())
+		legacyData = append(legacyData, data)
+	}
+	iterator.Close()
+
+	for _, key := range legacyKeys {
+		store.Delete(key)
+	}
+	if len(legacyData) > 0 {
+		merged := types.MonthlyBurnData{
+			MonthIndex:   types.CalendarMonthIndex(ctx.BlockTime()),
+			BurnedAmount: sdk.ZeroInt(),
+			MintedAmount: sdk.ZeroInt(),
+			StartHeight:  ctx.BlockHeight(),
+			EndHeight:    ctx.BlockHeight(),
+			StartEpoch:   legacyData[0].StartEpoch,
+			EndEpoch:     legacyData[0].EndEpoch,
+		}
+		for _, data := range legacyData {
+			merged.BurnedAmount = merged.BurnedAmount.Add(zeroIfNil(data.BurnedAmount))
+			merged.MintedAmount = merged.MintedAmount.Add(zeroIfNil(data.MintedAmount))
+			if data.StartEpoch < merged.StartEpoch {
+				merged.StartEpoch = data.StartEpoch
+			}
+			if data.EndEpoch > merged.EndEpoch {
+				merged.EndEpoch = data.EndEpoch
+			}
+		}
+		k.SetMonthlyBurnData(ctx, merged)
+	}
+
+	// The old annual counter covered the mints since the last annual reset
+	stats := k.GetInflationStats(ctx)
+	for _, record := range k.GetAllMintRecords(ctx) {
+		if record.EpochNumber < stats.LastAnnualResetEpoch {
+			continue
+		}
+		record.BlockTime = ctx.BlockTime()
+		k.SaveMintRecord(ctx, record)
+	}
+	stats.AnnualMinted = k.GetAnnualMinted(ctx)
+	k.SetInflationStats(ctx, stats)
+	return nil
+}
+
+// Migrate5to6 anchors the supply baseline introduced to decouple the supply
+// reconciliation invariant from the InitialSupply param
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	m.keeper.ResetSupplyBaseline(ctx)
+	return nil
+}
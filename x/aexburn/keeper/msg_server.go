@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/sei-protocol/sei-chain/x/aexburn/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (server msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if err := server.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := server.Keeper.UpdateParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"aex_params_updated",
+			sdk.NewAttribute("authority", msg.Authority),
+		),
+	)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+func (server msgServer) SetEmergencyPause(goCtx context.Context, msg *types.MsgSetEmergencyPause) (*types.MsgSetEmergencyPauseResponse, error) {
+	if err := server.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	server.Keeper.SetEmergencyPause(ctx, msg.BurnPaused, msg.MintPaused)
+
+	return &types.MsgSetEmergencyPauseResponse{}, nil
+}
+
+func (server msgServer) DrainIncomeBuffer(goCtx context.Context, msg *types.MsgDrainIncomeBuffer) (*types.MsgDrainIncomeBufferResponse, error) {
+	if err := server.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	var recipient sdk.AccAddress
+	if msg.Recipient != "" {
+		addr, err := sdk.AccAddressFromBech32(msg.Recipient)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+		}
+		recipient = addr
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	amount, err := server.Keeper.DrainIncomeBuffer(ctx, recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDrainIncomeBufferResponse{Amount: amount}, nil
+}
+
+func (server msgServer) ResetIncomeBuffer(goCtx context.Context, msg *types.MsgResetIncomeBuffer) (*types.MsgResetIncomeBufferResponse, error) {
+	if err := server.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	server.Keeper.ResetIncomeBuffer(ctx)
+
+	return &types.MsgResetIncomeBufferResponse{}, nil
+}
+
+func (server msgServer) validateAuthority(authority string) error {
+	if server.Keeper.GetAuthority() != authority {
+		return sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", server.Keeper.GetAuthority(), authority)
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	appparams "github.com/sei-protocol/sei-chain/app/params"
+	"github.com/sei-protocol/sei-chain/x/aexburn"
+	"github.com/sei-protocol/sei-chain/x/aexburn/keeper"
+	"github.com/sei-protocol/sei-chain/x/aexburn/types"
+)
+
+// ========== Msg Service Tests ==========
+
+func (suite *KeeperTestSuite) govAuthority() string {
+	return authtypes.NewModuleAddress(govtypes.ModuleName).String()
+}
+
+// fundIncomeBuffer puts amount into the module account and records it in the buffer
+func (suite *KeeperTestSuite) fundIncomeBuffer(amount sdk.Int) {
+	coins := sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, amount))
+	suite.Require().NoError(suite.App.BankKeeper.MintCoins(suite.Ctx, types.ModuleName, coins))
+
+	buffer := suite.App.AexburnKeeper.GetIncomeBuffer(suite.Ctx)
+	buffer.Balance = buffer.Balance.Add(amount)
+	buffer.TotalContributed = buffer.TotalContributed.Add(amount)
+	suite.App.AexburnKeeper.SetIncomeBuffer(suite.Ctx, buffer)
+}
+
+func (suite *KeeperTestSuite) TestMsgUpdateParams() {
+	msgServer := keeper.NewMsgServerImpl(suite.App.AexburnKeeper)
+	ctx := sdk.WrapSDKContext(suite.Ctx)
+
+	params := types.DefaultParams()
+	params.TargetBurnRate = sdk.NewDecWithPrec(40, 2)
+
+	// Only the authority may update params
+	_, err := msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(suite.TestAccs[0].String(), params))
+	suite.Require().ErrorIs(err, types.ErrInvalidAuthority)
+
+	_, err = msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(suite.govAuthority(), params))
+	suite.Require().NoError(err)
+	suite.Require().Equal(params.TargetBurnRate, suite.App.AexburnKeeper.GetParams(suite.Ctx).TargetBurnRate)
+
+	// Target burn rate outside [min, max] is rejected
+	params.TargetBurnRate = sdk.NewDecWithPrec(90, 2)
+	_, err = msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(suite.govAuthority(), params))
+	suite.Require().ErrorIs(err, types.ErrInvalidParams)
+	suite.Require().Equal(sdk.NewDecWithPrec(40, 2), suite.App.AexburnKeeper.GetParams(suite.Ctx).TargetBurnRate)
+}
+
+func (suite *KeeperTestSuite) TestMsgUpdateParamsValidateBasic() {
+	params := types.DefaultParams()
+	suite.Require().NoError(types.NewMsgUpdateParams(suite.govAuthority(), params).ValidateBasic())
+	suite.Require().Error(types.NewMsgUpdateParams("invalid", params).ValidateBasic())
+
+	// Gas thresholds must be ordered
+	unordered := types.DefaultParams()
+	unordered.LowGasThreshold = sdk.NewDecWithPrec(80, 2)
+	suite.Require().Error(types.NewMsgUpdateParams(suite.govAuthority(), unordered).ValidateBasic())
+
+	// A high gas threshold of 100% leaves no range to interpolate the burn rate over
+	saturated := types.DefaultParams()
+	saturated.HighGasThreshold = sdk.OneDec()
+	suite.Require().Error(types.NewMsgUpdateParams(suite.govAuthority(), saturated).ValidateBasic())
+
+	noInflationRange := types.DefaultParams()
+	noInflationRange.MinGasUsageForInflation = sdk.OneDec()
+	suite.Require().Error(types.NewMsgUpdateParams(suite.govAuthority(), noInflationRange).ValidateBasic())
+
+	// Params omitted from a JSON params file decode as nil decimals and must be rejected rather than panic
+	nilBurnRate := types.DefaultParams()
+	nilBurnRate.MaxBurnRate = sdk.Dec{}
+	suite.Require().Error(types.NewMsgUpdateParams(suite.govAuthority(), nilBurnRate).ValidateBasic())
+
+	nilThreshold := types.DefaultParams()
+	nilThreshold.LowGasThreshold = sdk.Dec{}
+	suite.Require().Error(types.NewMsgUpdateParams(suite.govAuthority(), nilThreshold).ValidateBasic())
+}
+
+func (suite *KeeperTestSuite) TestMsgSetEmergencyPause() {
+	msgServer := keeper.NewMsgServerImpl(suite.App.AexburnKeeper)
+	ctx := sdk.WrapSDKContext(suite.Ctx)
+
+	_, err := msgServer.SetEmergencyPause(ctx, types.NewMsgSetEmergencyPause(suite.TestAccs[0].String(), true, true))
+	suite.Require().ErrorIs(err, types.ErrInvalidAuthority)
+
+	_, err = msgServer.SetEmergencyPause(ctx, types.NewMsgSetEmergencyPause(suite.govAuthority(), true, true))
+	suite.Require().NoError(err)
+
+	state := suite.App.AexburnKeeper.GetEmergencyState(suite.Ctx)
+	suite.Require().True(state.BurnPaused)
+	suite.Require().True(state.MintPaused)
+
+	// Paused burning leaves all fees in the fee collector
+	feeCoins := sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, sdk.NewInt(1000000)))
+	suite.Require().NoError(suite.App.BankKeeper.MintCoins(suite.Ctx, types.ModuleName, feeCoins))
+	suite.Require().NoError(suite.App.BankKeeper.SendCoinsFromModuleToModule(suite.Ctx, types.ModuleName, authtypes.FeeCollectorName, feeCoins))
+
+	burned, remaining, err := suite.App.AexburnKeeper.BurnFees(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().True(burned.IsZero())
+	suite.Require().Equal(feeCoins, remaining)
+
+	// Paused minting skips inflation even at full gas usage
+	suite.Require().NoError(suite.App.AexburnKeeper.MintInflation(suite.Ctx, 1, sdk.OneDec()))
+	_, found := suite.App.AexburnKeeper.GetMintRecord(suite.Ctx, 1)
+	suite.Require().False(found)
+
+	// Resuming restores normal operation
+	_, err = msgServer.SetEmergencyPause(ctx, types.NewMsgSetEmergencyPause(suite.govAuthority(), false, false))
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.App.AexburnKeeper.MintInflation(suite.Ctx, 2, sdk.OneDec()))
+	_, found = suite.App.AexburnKeeper.GetMintRecord(suite.Ctx, 2)
+	suite.Require().True(found)
+}
+
+func (suite *KeeperTestSuite) TestMsgDrainIncomeBuffer() {
+	msgServer := keeper.NewMsgServerImpl(suite.App.AexburnKeeper)
+	ctx := sdk.WrapSDKContext(suite.Ctx)
+	recipient := suite.TestAccs[0]
+
+	// Nothing to drain yet
+	_, err := msgServer.DrainIncomeBuffer(ctx, types.NewMsgDrainIncomeBuffer(suite.govAuthority(), recipient.String()))
+	suite.Require().ErrorIs(err, types.ErrEmptyIncomeBuffer)
+
+	suite.fundIncomeBuffer(sdk.NewInt(5000))
+	before := suite.App.BankKeeper.GetBalance(suite.Ctx, recipient, appparams.BaseCoinUnit)
+
+	_, err = msgServer.DrainIncomeBuffer(ctx, types.NewMsgDrainIncomeBuffer(recipient.String(), recipient.String()))
+	suite.Require().ErrorIs(err, types.ErrInvalidAuthority)
+
+	res, err := msgServer.DrainIncomeBuffer(ctx, types.NewMsgDrainIncomeBuffer(suite.govAuthority(), recipient.String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(5000), res.Amount)
+
+	after := suite.App.BankKeeper.GetBalance(suite.Ctx, recipient, appparams.BaseCoinUnit)
+	suite.Require().Equal(sdk.NewInt(5000), after.Amount.Sub(before.Amount))
+
+	buffer := suite.App.AexburnKeeper.GetIncomeBuffer(suite.Ctx)
+	suite.Require().True(buffer.Balance.IsZero())
+	suite.Require().Equal(sdk.NewInt(5000), buffer.TotalReleased)
+}
+
+func (suite *KeeperTestSuite) TestMsgDrainIncomeBufferToFeeCollector() {
+	msgServer := keeper.NewMsgServerImpl(suite.App.AexburnKeeper)
+	suite.fundIncomeBuffer(sdk.NewInt(700))
+
+	_, err := msgServer.DrainIncomeBuffer(sdk.WrapSDKContext(suite.Ctx), types.NewMsgDrainIncomeBuffer(suite.govAuthority(), ""))
+	suite.Require().NoError(err)
+
+	feeCollector := suite.App.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	suite.Require().Equal(sdk.NewInt(700), suite.App.BankKeeper.GetBalance(suite.Ctx, feeCollector, appparams.BaseCoinUnit).Amount)
+}
+
+func (suite *KeeperTestSuite) TestMsgResetIncomeBuffer() {
+	msgServer := keeper.NewMsgServerImpl(suite.App.AexburnKeeper)
+	suite.fundIncomeBuffer(sdk.NewInt(1000))
+
+	// Simulate accounting that drifted from the module account balance
+	buffer := suite.App.AexburnKeeper.GetIncomeBuffer(suite.Ctx)
+	buffer.Balance = sdk.NewInt(42)
+	buffer.TotalReleased = sdk.NewInt(300)
+	suite.App.AexburnKeeper.SetIncomeBuffer(suite.Ctx, buffer)
+
+	_, err := msgServer.ResetIncomeBuffer(sdk.WrapSDKContext(suite.Ctx), types.NewMsgResetIncomeBuffer(suite.govAuthority()))
+	suite.Require().NoError(err)
+
+	buffer = suite.App.AexburnKeeper.GetIncomeBuffer(suite.Ctx)
+	suite.Require().Equal(sdk.NewInt(1000), buffer.Balance)
+	suite.Require().True(buffer.TotalContributed.IsZero())
+	suite.Require().True(buffer.TotalReleased.IsZero())
+}
+
+func (suite *KeeperTestSuite) TestProposalHandler() {
+	handler := aexburn.NewProposalHandler(suite.App.AexburnKeeper)
+
+	params := types.DefaultParams()
+	params.BurnEnabled = false
+	err := handler(suite.Ctx, types.NewUpdateParamsProposal("title", "description", params))
+	suite.Require().NoError(err)
+	suite.Require().False(suite.App.AexburnKeeper.GetParams(suite.Ctx).BurnEnabled)
+
+	err = handler(suite.Ctx, types.NewSetEmergencyPauseProposal("title", "description", false, true))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.App.AexburnKeeper.GetEmergencyState(suite.Ctx).MintPaused)
+
+	suite.fundIncomeBuffer(sdk.NewInt(100))
+	err = handler(suite.Ctx, types.NewDrainIncomeBufferProposal("title", "description", suite.TestAccs[1].String()))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.App.AexburnKeeper.GetIncomeBuffer(suite.Ctx).Balance.IsZero())
+
+	err = handler(suite.Ctx, types.NewResetIncomeBufferProposal("title", "description"))
+	suite.Require().NoError(err)
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	appparams "github.com/sei-protocol/sei-chain/app/params"
+	"github.com/sei-protocol/sei-chain/x/aexburn/types"
+)
+
+// GetSupplyBaseline returns the AEX supply issued outside of aexburn, i.e. the
+// genesis supply plus everything x/mint released since
+func (k Keeper) GetSupplyBaseline(ctx sdk.Context) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.SupplyBaselineKey)
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	var baseline sdk.IntProto
+	k.cdc.MustUnmarshal(bz, &baseline)
+	return baseline.Int
+}
+
+// SetSupplyBaseline sets the AEX supply issued outside of aexburn
+func (k Keeper) SetSupplyBaseline(ctx sdk.Context, baseline sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: baseline})
+	store.Set(types.SupplyBaselineKey, bz)
+}
+
+// AddSupplyBaseline adds AEX issued outside of aexburn to the baseline
+func (k Keeper) AddSupplyBaseline(ctx sdk.Context, amount sdk.Int) {
+	k.SetSupplyBaseline(ctx, k.GetSupplyBaseline(ctx).Add(amount))
+}
+
+// ResetSupplyBaseline anchors the baseline to the current bank supply net of what
+// aexburn has minted and burned so far, so that the supply reconciliation holds
+// with equality. Used at genesis and when upgrading a chain without a baseline
+func (k Keeper) ResetSupplyBaseline(ctx sdk.Context) {
+	supply := k.bankKeeper.GetSupply(ctx, appparams.BaseCoinUnit).Amount
+	totalMinted := zeroIfNil(k.GetInflationStats(ctx).TotalMinted)
+	totalBurned := zeroIfNil(k.GetBurnStats(ctx).TotalBurned)
+	k.SetSupplyBaseline(ctx, supply.Sub(totalMinted).Add(totalBurned))
+}
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/aexburn/types"
+)
+
+// GetAnnualMinted returns the amount minted in the rolling 365 days before the block
+// time. Mint records are keyed by epoch, so they are walked from the latest one until
+// a record falls out of the window
+func (k Keeper) GetAnnualMinted(ctx sdk.Context) sdk.Int {
+	cutoff := ctx.BlockTime().Add(-types.AnnualInflationWindow)
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.MintRecordPrefix)
+	defer iterator.Close()
+
+	annualMinted := sdk.ZeroInt()
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.MintRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		if !record.BlockTime.After(cutoff) {
+			break
+		}
+		annualMinted = annualMinted.Add(record.MintedAmount)
+	}
+	return annualMinted
+}
+
+// GetNetSupplyWindow returns the monthly data of the current calendar month and the
+// 11 months before it, oldest first. Months without mints or burns are skipped
+func (k Keeper) GetNetSupplyWindow(ctx sdk.Context) []types.MonthlyBurnData {
+	currentMonth := types.CalendarMonthIndex(ctx.BlockTime())
+
+	var window []types.MonthlyBurnData
+	for month := oldestWindowMonth(currentMonth); month <= currentMonth; month++ {
+		if data, found := k.GetMonthlyBurnData(ctx, month); found {
+			window = append(window, data)
+		}
+	}
+	return window
+}
+
+// PruneMonthlyBurnData deletes the monthly data that fell out of the 12-month window
+func (k Keeper) PruneMonthlyBurnData(ctx sdk.Context) {
+	oldestMonth := oldestWindowMonth(types.CalendarMonthIndex(ctx.BlockTime()))
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.MonthlyBurnDataPrefix)
+	defer iterator.Close()
+
+	var expired [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var data types.MonthlyBurnData
+		k.cdc.MustUnmarshal(iterator.Value(), &data)
+		if data.MonthIndex >= oldestMonth {
+			// Keys are ordered by month
+			break
+		}
+		expired = append(expired, iterator.Key())
+	}
+	for _, key := range expired {
+		store.Delete(key)
+	}
+}
+
+// updateMonthlyBurnData adds minted and burned uaex to the bucket of the block's
+// calendar month
+func (k Keeper) updateMonthlyBurnData(ctx sdk.Context, epochNumber uint64, minted, burned sdk.Int) {
+	monthIndex := types.CalendarMonthIndex(ctx.BlockTime())
+
+	data, found := k.GetMonthlyBurnData(ctx, monthIndex)
+	if !found {
+		data = types.MonthlyBurnData{
+			MonthIndex:   monthIndex,
+			BurnedAmount: sdk.ZeroInt(),
+			MintedAmount: sdk.ZeroInt(),
+			StartHeight:  ctx.BlockHeight(),
+			StartEpoch:   epochNumber,
+		}
+	}
+
+	data.MintedAmount = data.MintedAmount.Add(minted)
+	data.BurnedAmount = data.BurnedAmount.Add(burned)
+	data.EndHeight = ctx.BlockHeight()
+	data.EndEpoch = epochNumber
+
+	k.SetMonthlyBurnData(ctx, data)
+}
+
+// oldestWindowMonth returns the first calendar month of the 12-month window ending
+// with currentMonth
+func oldestWindowMonth(currentMonth uint32) uint32 {
+	if currentMonth < types.NetSupplyWindowMonths-1 {
+		return 0
+	}
+	return currentMonth - (types.NetSupplyWindowMonths - 1)
+}
@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	appparams "github.com/sei-protocol/sei-chain/app/params"
+	"github.com/sei-protocol/sei-chain/x/aexburn/keeper"
+	"github.com/sei-protocol/sei-chain/x/aexburn/types"
+)
+
+// ========== Supply Window Tests ==========
+
+func (suite *KeeperTestSuite) TestCalendarMonthIndex() {
+	dec := types.CalendarMonthIndex(time.Date(2025, 12, 31, 23, 59, 59, 0, time.UTC))
+	jan := types.CalendarMonthIndex(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	suite.Require().Equal(uint32(2025*12+11), dec)
+	suite.Require().Equal(dec+1, jan)
+
+	// Month boundaries are UTC
+	suite.Require().Equal(dec, types.CalendarMonthIndex(time.Date(2026, 1, 1, 7, 0, 0, 0, time.FixedZone("UTC+8", 8*3600))))
+}
+
+func (suite *KeeperTestSuite) TestGetAnnualMinted() {
+	now := suite.Ctx.BlockTime()
+	records := []types.MintRecord{
+		{EpochNumber: 1, MintedAmount: sdk.NewInt(100), BlockTime: now.Add(-types.AnnualInflationWindow - time.Hour)},
+		{EpochNumber: 2, MintedAmount: sdk.NewInt(200), BlockTime: now.Add(-types.AnnualInflationWindow)},
+		{EpochNumber: 3, MintedAmount: sdk.NewInt(300), BlockTime: now.Add(-types.AnnualInflationWindow + time.Hour)},
+		{EpochNumber: 4, MintedAmount: sdk.NewInt(400), BlockTime: now},
+	}
+	for _, record := range records {
+		suite.App.AexburnKeeper.SaveMintRecord(suite.Ctx, record)
+	}
+
+	// Records at or before the cutoff are outside the window
+	suite.Require().Equal(sdk.NewInt(700), suite.App.AexburnKeeper.GetAnnualMinted(suite.Ctx))
+
+	// A year later only the latest record is left
+	later := suite.Ctx.WithBlockTime(now.Add(types.AnnualInflationWindow - time.Minute))
+	suite.Require().Equal(sdk.NewInt(400), suite.App.AexburnKeeper.GetAnnualMinted(later))
+}
+
+func (suite *KeeperTestSuite) TestGetNetSupplyWindow() {
+	currentMonth := types.CalendarMonthIndex(suite.Ctx.BlockTime())
+	for i := uint32(0); i <= types.NetSupplyWindowMonths; i++ {
+		suite.App.AexburnKeeper.SetMonthlyBurnData(suite.Ctx, types.MonthlyBurnData{
+			MonthIndex:   currentMonth - i,
+			BurnedAmount: sdk.NewInt(10),
+			MintedAmount: sdk.NewInt(100),
+		})
+	}
+
+	window := suite.App.AexburnKeeper.GetNetSupplyWindow(suite.Ctx)
+	suite.Require().Len(window, types.NetSupplyWindowMonths)
+	suite.Require().Equal(currentMonth-(types.NetSupplyWindowMonths-1), window[0].MonthIndex)
+	suite.Require().Equal(currentMonth, window[len(window)-1].MonthIndex)
+	suite.Require().Equal(sdk.NewInt(90*types.NetSupplyWindowMonths), suite.App.AexburnKeeper.Get12MonthNetSupply(suite.Ctx))
+}
+
+func (suite *KeeperTestSuite) TestPruneMonthlyBurnData() {
+	currentMonth := types.CalendarMonthIndex(suite.Ctx.BlockTime())
+	for i := uint32(0); i < 15; i++ {
+		suite.App.AexburnKeeper.SetMonthlyBurnData(suite.Ctx, types.MonthlyBurnData{
+			MonthIndex:   currentMonth - i,
+			BurnedAmount: sdk.ZeroInt(),
+			MintedAmount: sdk.OneInt(),
+		})
+	}
+
+	suite.App.AexburnKeeper.PruneMonthlyBurnData(suite.Ctx)
+
+	allData := suite.App.AexburnKeeper.GetAllMonthlyBurnData(suite.Ctx)
+	suite.Require().Len(allData, types.NetSupplyWindowMonths)
+	suite.Require().Equal(currentMonth-(types.NetSupplyWindowMonths-1), allData[0].MonthIndex)
+}
+
+func (suite *KeeperTestSuite) TestBurnFeesRecordsMonthlyBurn() {
+	params := suite.App.AexburnKeeper.GetParams(suite.Ctx)
+	params.BurnEnabled = true
+	params.IncomeSmootherEnabled = false
+	suite.App.AexburnKeeper.SetParams(suite.Ctx, params)
+
+	feeCoins := sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, sdk.NewInt(1000000)))
+	suite.Require().NoError(suite.App.BankKeeper.MintCoins(suite.Ctx, types.ModuleName, feeCoins))
+	suite.Require().NoError(suite.App.BankKeeper.SendCoinsFromModuleToModule(suite.Ctx, types.ModuleName, authtypes.FeeCollectorName, feeCoins))
+
+	burned, _, err := suite.App.AexburnKeeper.BurnFees(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().True(burned.AmountOf(appparams.BaseCoinUnit).IsPositive())
+
+	data, found := suite.App.AexburnKeeper.GetMonthlyBurnData(suite.Ctx, types.CalendarMonthIndex(suite.Ctx.BlockTime()))
+	suite.Require().True(found)
+	suite.Require().Equal(burned.AmountOf(appparams.BaseCoinUnit), data.BurnedAmount)
+	suite.Require().True(data.MintedAmount.IsZero())
+}
+
+func (suite *KeeperTestSuite) TestMigrate4to5() {
+	k := suite.App.AexburnKeeper
+	store := suite.Ctx.KVStore(suite.App.GetKey(types.StoreKey))
+
+	// Epoch indexed buckets under the legacy single byte keys
+	for i, data := range []types.MonthlyBurnData{
+		{MonthIndex: 0, BurnedAmount: sdk.NewInt(10), MintedAmount: sdk.NewInt(100), StartEpoch: 1, EndEpoch: 30},
+		{MonthIndex: 1, BurnedAmount: sdk.NewInt(20), MintedAmount: sdk.NewInt(200), StartEpoch: 31, EndEpoch: 45},
+	} {
+		bz, err := suite.App.AppCodec().Marshal(&data)
+		suite.Require().NoError(err)
+		store.Set(append(append([]byte{}, types.MonthlyBurnDataPrefix...), byte(i)), bz)
+	}
+
+	stats := k.GetInflationStats(suite.Ctx)
+	stats.LastAnnualResetEpoch = 40
+	k.SetInflationStats(suite.Ctx, stats)
+	k.SaveMintRecord(suite.Ctx, types.MintRecord{EpochNumber: 39, MintedAmount: sdk.NewInt(100)})
+	k.SaveMintRecord(suite.Ctx, types.MintRecord{EpochNumber: 40, MintedAmount: sdk.NewInt(200)})
+	k.SaveMintRecord(suite.Ctx, types.MintRecord{EpochNumber: 45, MintedAmount: sdk.NewInt(300)})
+
+	suite.Require().NoError(keeper.NewMigrator(k).Migrate4to5(suite.Ctx))
+
+	allData := k.GetAllMonthlyBurnData(suite.Ctx)
+	suite.Require().Len(allData, 1)
+	suite.Require().Equal(types.CalendarMonthIndex(suite.Ctx.BlockTime()), allData[0].MonthIndex)
+	suite.Require().Equal(sdk.NewInt(30), allData[0].BurnedAmount)
+	suite.Require().Equal(sdk.NewInt(300), allData[0].MintedAmount)
+	suite.Require().Equal(uint64(1), allData[0].StartEpoch)
+	suite.Require().Equal(uint64(45), allData[0].EndEpoch)
+	suite.Require().Equal(sdk.NewInt(270), k.Get12MonthNetSupply(suite.Ctx))
+
+	// Only the mints since the last annual reset stay in the annual window
+	suite.Require().Equal(sdk.NewInt(500), k.GetAnnualMinted(suite.Ctx))
+	suite.Require().Equal(sdk.NewInt(500), k.GetInflationStats(suite.Ctx).AnnualMinted)
+}
+
+func (suite *KeeperTestSuite) TestGenesisValidateDuplicateWindows() {
+	genesis := types.DefaultGenesis()
+	genesis.MonthlyBurnData = []types.MonthlyBurnData{
+		{MonthIndex: 1, BurnedAmount: sdk.ZeroInt(), MintedAmount: sdk.ZeroInt()},
+		{MonthIndex: 1, BurnedAmount: sdk.ZeroInt(), MintedAmount: sdk.ZeroInt()},
+	}
+	suite.Require().Error(genesis.Validate())
+
+	genesis = types.DefaultGenesis()
+	genesis.MintRecords = []types.MintRecord{
+		{EpochNumber: 1, MintedAmount: sdk.OneInt()},
+		{EpochNumber: 1, MintedAmount: sdk.OneInt()},
+	}
+	suite.Require().Error(genesis.Validate())
+}
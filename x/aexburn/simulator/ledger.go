@@ -0,0 +1,104 @@
+package simulator
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/sei-protocol/sei-chain/x/aexburn/types"
+)
+
+// holdersAccount holds the circulating supply that pays fees and receives the
+// fees distributed to validators
+var holdersAccount = authtypes.NewModuleAddress("aexburn-simulator-holders")
+
+// ledger is an in-memory stand-in for the bank, account, distribution and oracle
+// keepers, so that the aexburn keeper can run without the rest of the app
+type ledger struct {
+	balances map[string]sdk.Coins
+	supply   sdk.Coins
+}
+
+var (
+	_ types.AccountKeeper      = (*ledger)(nil)
+	_ types.BankKeeper         = (*ledger)(nil)
+	_ types.DistributionKeeper = (*ledger)(nil)
+	_ types.OracleKeeper       = (*ledger)(nil)
+)
+
+func newLedger() *ledger {
+	return &ledger{
+		balances: make(map[string]sdk.Coins),
+		supply:   sdk.NewCoins(),
+	}
+}
+
+func (l *ledger) GetModuleAddress(moduleName string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(moduleName)
+}
+
+func (l *ledger) GetModuleAccount(_ sdk.Context, moduleName string) authtypes.ModuleAccountI {
+	return authtypes.NewEmptyModuleAccount(moduleName)
+}
+
+func (l *ledger) GetBalance(_ sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, l.balances[addr.String()].AmountOf(denom))
+}
+
+func (l *ledger) GetAllBalances(_ sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	return l.balances[addr.String()]
+}
+
+func (l *ledger) GetSupply(_ sdk.Context, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, l.supply.AmountOf(denom))
+}
+
+func (l *ledger) SendCoinsFromModuleToModule(_ sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	return l.send(authtypes.NewModuleAddress(senderModule), authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (l *ledger) SendCoinsFromModuleToAccount(_ sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return l.send(authtypes.NewModuleAddress(senderModule), recipientAddr, amt)
+}
+
+func (l *ledger) BurnCoins(_ sdk.Context, moduleName string, amt sdk.Coins) error {
+	addr := authtypes.NewModuleAddress(moduleName).String()
+	balance, negative := l.balances[addr].SafeSub(amt)
+	if negative {
+		return fmt.Errorf("insufficient funds to burn %s from %s", amt, moduleName)
+	}
+	l.balances[addr] = balance
+	l.supply = l.supply.Sub(amt)
+	return nil
+}
+
+func (l *ledger) MintCoins(_ sdk.Context, moduleName string, amt sdk.Coins) error {
+	l.mint(authtypes.NewModuleAddress(moduleName), amt)
+	return nil
+}
+
+func (l *ledger) FundCommunityPool(_ sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	return l.send(sender, authtypes.NewModuleAddress(distrtypes.ModuleName), amount)
+}
+
+// GetBaseExchangeRate reports no oracle prices, the simulation only covers AEX fees
+func (l *ledger) GetBaseExchangeRate(_ sdk.Context, denom string) (sdk.Dec, sdk.Int, int64, error) {
+	return sdk.ZeroDec(), sdk.ZeroInt(), 0, fmt.Errorf("no exchange rate for %s in simulation", denom)
+}
+
+func (l *ledger) mint(addr sdk.AccAddress, amt sdk.Coins) {
+	l.balances[addr.String()] = l.balances[addr.String()].Add(amt...)
+	l.supply = l.supply.Add(amt...)
+}
+
+func (l *ledger) send(from, to sdk.AccAddress, amt sdk.Coins) error {
+	balance, negative := l.balances[from.String()].SafeSub(amt)
+	if negative {
+		return fmt.Errorf("insufficient funds to send %s from %s", amt, from)
+	}
+	l.balances[from.String()] = balance
+	l.balances[to.String()] = l.balances[to.String()].Add(amt...)
+	return nil
+}
@@ -0,0 +1,52 @@
+package simulator
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+)
+
+// Output formats
+const (
+	FormatCSV  = "csv"
+	FormatJSON = "json"
+)
+
+var csvHeader = []string{
+	"epoch", "gas_utilization", "burn_rate", "fees", "minted", "burned",
+	"buffer_balance", "net_supply_12_month", "total_supply", "brake_active",
+}
+
+// WriteCSV writes one row per epoch with a header row
+func WriteCSV(w io.Writer, results []EpochResult) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, res := range results {
+		if err := writer.Write([]string{
+			strconv.FormatUint(res.Epoch, 10),
+			res.GasUtilization.String(),
+			res.BurnRate.String(),
+			res.Fees.String(),
+			res.Minted.String(),
+			res.Burned.String(),
+			res.BufferBalance.String(),
+			res.NetSupply12Month.String(),
+			res.TotalSupply.String(),
+			strconv.FormatBool(res.BrakeActive),
+		}); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteJSON writes the results as an indented JSON array
+func WriteJSON(w io.Writer, results []EpochResult) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(results)
+}
@@ -0,0 +1,197 @@
+// Package simulator projects the AEX supply curve of an aexburn parameter set by
+// running the real aexburn keeper logic against an in-memory store.
+package simulator
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmdb "github.com/tendermint/tm-db"
+
+	appparams "github.com/sei-protocol/sei-chain/app/params"
+	"github.com/sei-protocol/sei-chain/x/aexburn/keeper"
+	"github.com/sei-protocol/sei-chain/x/aexburn/types"
+	epochtypes "github.com/sei-protocol/sei-chain/x/epoch/types"
+)
+
+// DefaultBlockGasLimit is the block gas limit used to turn gas utilization into gas used
+const DefaultBlockGasLimit = uint64(10_000_000)
+
+// StartTime is the block time of the first simulated block
+var StartTime = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// Config defines a simulation run
+type Config struct {
+	// Params are the aexburn params to simulate
+	Params types.Params
+	// GasTrace is the gas utilization (0-1) of each epoch, repeated if shorter than Epochs
+	GasTrace []sdk.Dec
+	// Epochs is the number of epochs to simulate
+	Epochs uint64
+	// BlocksPerEpoch is the number of blocks simulated per epoch. Each simulated block
+	// stands for a share of the epoch's real blocks
+	BlocksPerEpoch uint64
+	// EpochDuration is the block time an epoch spans, which drives the rolling annual
+	// and calendar month supply windows
+	EpochDuration time.Duration
+	// FeesPerEpoch is the amount of uaex fees collected over an epoch at 100% gas
+	// utilization, fees scale linearly with utilization
+	FeesPerEpoch sdk.Int
+}
+
+// EpochResult is the state of the economic model at the end of an epoch. Minted
+// rewards are paid through the fee collector, so Burned also covers the share of
+// the previous epoch's rewards burned on their way to validators
+type EpochResult struct {
+	Epoch            uint64  `json:"epoch"`
+	GasUtilization   sdk.Dec `json:"gas_utilization"`
+	BurnRate         sdk.Dec `json:"burn_rate"`
+	Fees             sdk.Int `json:"fees"`
+	Minted           sdk.Int `json:"minted"`
+	Burned           sdk.Int `json:"burned"`
+	BufferBalance    sdk.Int `json:"buffer_balance"`
+	NetSupply12Month sdk.Int `json:"net_supply_12_month"`
+	TotalSupply      sdk.Int `json:"total_supply"`
+	BrakeActive      bool    `json:"brake_active"`
+}
+
+// Validate checks that the run can be simulated
+func (c Config) Validate() error {
+	if err := c.Params.Validate(); err != nil {
+		return err
+	}
+	if len(c.GasTrace) == 0 {
+		return fmt.Errorf("gas trace must not be empty")
+	}
+	for i, utilization := range c.GasTrace {
+		if utilization.IsNegative() || utilization.GT(sdk.OneDec()) {
+			return fmt.Errorf("gas utilization of epoch %d must be between 0 and 1: %s", i+1, utilization)
+		}
+	}
+	if c.Epochs == 0 {
+		return fmt.Errorf("epochs must be positive")
+	}
+	if c.BlocksPerEpoch == 0 {
+		return fmt.Errorf("blocks per epoch must be positive")
+	}
+	if c.EpochDuration < time.Duration(c.BlocksPerEpoch) {
+		return fmt.Errorf("epoch duration must be at least one nanosecond per block")
+	}
+	if c.FeesPerEpoch.IsNil() || c.FeesPerEpoch.IsNegative() {
+		return fmt.Errorf("fees per epoch must not be negative")
+	}
+	return nil
+}
+
+// Run simulates cfg.Epochs epochs. Every block the fees collected go through
+// BurnFees (income smoothing and the dynamic burn rate) before the rest is paid
+// to validators, and the block's gas is recorded as in EndBlock. Every epoch ends
+// with the aexburn epoch hook, which mints inflation and updates the reverse brake.
+// The simulated chain starts with Params.InitialSupply in circulation
+func Run(cfg Config) ([]EpochResult, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	k, ctx, bank, err := newKeeper()
+	if err != nil {
+		return nil, err
+	}
+	k.SetParams(ctx, cfg.Params)
+	bank.mint(holdersAccount, sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, cfg.Params.InitialSupply)))
+
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	blockInterval := cfg.EpochDuration / time.Duration(cfg.BlocksPerEpoch)
+	results := make([]EpochResult, 0, cfg.Epochs)
+	height := int64(0)
+	blockTime := StartTime
+	for epoch := uint64(1); epoch <= cfg.Epochs; epoch++ {
+		utilization := cfg.GasTrace[(epoch-1)%uint64(len(cfg.GasTrace))]
+		gasUsed := utilization.MulInt64(int64(DefaultBlockGasLimit)).TruncateInt().Uint64()
+		blockFees := utilization.MulInt(cfg.FeesPerEpoch).QuoInt64(int64(cfg.BlocksPerEpoch)).TruncateInt()
+
+		mintedBefore := k.GetInflationStats(ctx).TotalMinted
+		burnedBefore := k.GetBurnStats(ctx).TotalBurned
+		epochFees := sdk.ZeroInt()
+
+		for block := uint64(0); block < cfg.BlocksPerEpoch; block++ {
+			height++
+			blockTime = blockTime.Add(blockInterval)
+			ctx = ctx.WithBlockHeight(height).WithBlockTime(blockTime)
+
+			if blockFees.IsPositive() {
+				fees := sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, blockFees))
+				if err := bank.send(holdersAccount, feeCollector, fees); err != nil {
+					return nil, err
+				}
+				epochFees = epochFees.Add(blockFees)
+			}
+
+			// Distribution BeginBlock: burn, then pay out the rest
+			if _, _, err := k.BurnFees(ctx); err != nil {
+				return nil, err
+			}
+			if err := bank.send(feeCollector, holdersAccount, bank.GetAllBalances(ctx, feeCollector)); err != nil {
+				return nil, err
+			}
+
+			// aexburn EndBlock
+			k.RecordBlockGasUsage(ctx, gasUsed)
+			k.PruneBurnRecords(ctx)
+		}
+
+		k.Hooks().AfterEpochEnd(ctx, epochtypes.Epoch{CurrentEpoch: epoch})
+
+		results = append(results, EpochResult{
+			Epoch:            epoch,
+			GasUtilization:   utilization,
+			BurnRate:         k.GetBurnStats(ctx).LastBurnRate,
+			Fees:             epochFees,
+			Minted:           k.GetInflationStats(ctx).TotalMinted.Sub(mintedBefore),
+			Burned:           k.GetBurnStats(ctx).TotalBurned.Sub(burnedBefore),
+			BufferBalance:    k.GetIncomeBuffer(ctx).Balance,
+			NetSupply12Month: k.Get12MonthNetSupply(ctx),
+			TotalSupply:      bank.GetSupply(ctx, appparams.BaseCoinUnit).Amount,
+			BrakeActive:      k.GetReverseBrakeState(ctx).IsBrakeActive,
+		})
+	}
+
+	return results, nil
+}
+
+// newKeeper creates an aexburn keeper backed by an in-memory store and ledger
+func newKeeper() (keeper.Keeper, sdk.Context, *ledger, error) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+
+	db := tmdb.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db)
+	stateStore.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, nil)
+	if err := stateStore.LoadLatestVersion(); err != nil {
+		return keeper.Keeper{}, sdk.Context{}, nil, err
+	}
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	subspace := paramstypes.NewSubspace(cdc, codec.NewLegacyAmino(), paramsKey, paramsTKey, types.ModuleName)
+
+	bank := newLedger()
+	k := keeper.NewKeeper(cdc, storeKey, subspace, bank, bank, bank, bank, authtypes.NewModuleAddress("gov").String())
+
+	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger()).
+		WithConsensusParams(&tmproto.ConsensusParams{
+			Block: &tmproto.BlockParams{MaxGas: int64(DefaultBlockGasLimit)},
+		})
+
+	return k, ctx, bank, nil
+}
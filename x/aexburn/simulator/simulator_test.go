@@ -0,0 +1,148 @@
+package simulator_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sei-protocol/sei-chain/x/aexburn/simulator"
+	"github.com/sei-protocol/sei-chain/x/aexburn/types"
+)
+
+func newConfig(trace ...sdk.Dec) simulator.Config {
+	return simulator.Config{
+		Params:         types.DefaultParams(),
+		GasTrace:       trace,
+		Epochs:         5,
+		BlocksPerEpoch: 10,
+		EpochDuration:  24 * time.Hour,
+		FeesPerEpoch:   sdk.NewInt(1_000_000_000),
+	}
+}
+
+func TestRun_HighUtilization(t *testing.T) {
+	cfg := newConfig(sdk.NewDecWithPrec(80, 2))
+
+	results, err := simulator.Run(cfg)
+	require.NoError(t, err)
+	require.Len(t, results, int(cfg.Epochs))
+
+	totalMinted, totalBurned := sdk.ZeroInt(), sdk.ZeroInt()
+	for i, res := range results {
+		require.Equal(t, uint64(i+1), res.Epoch)
+		require.Equal(t, sdk.NewInt(800_000_000), res.Fees)
+		require.True(t, res.Burned.IsPositive())
+		totalMinted = totalMinted.Add(res.Minted)
+		totalBurned = totalBurned.Add(res.Burned)
+	}
+	require.True(t, totalMinted.IsPositive())
+
+	// Supply only moves through minting and burning
+	last := results[len(results)-1]
+	require.Equal(t, cfg.Params.InitialSupply.Add(totalMinted).Sub(totalBurned), last.TotalSupply)
+}
+
+func TestRun_LowUtilizationDoesNotMint(t *testing.T) {
+	cfg := newConfig(sdk.NewDecWithPrec(10, 2))
+
+	results, err := simulator.Run(cfg)
+	require.NoError(t, err)
+	for _, res := range results {
+		require.True(t, res.Minted.IsZero())
+	}
+}
+
+func TestRun_TraceRepeats(t *testing.T) {
+	cfg := newConfig(sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(70, 2))
+
+	results, err := simulator.Run(cfg)
+	require.NoError(t, err)
+	for i, res := range results {
+		require.Equal(t, cfg.GasTrace[i%2], res.GasUtilization)
+	}
+}
+
+func TestRun_InvalidConfig(t *testing.T) {
+	_, err := simulator.Run(newConfig())
+	require.Error(t, err)
+
+	_, err = simulator.Run(newConfig(sdk.NewDecWithPrec(150, 2)))
+	require.Error(t, err)
+
+	cfg := newConfig(sdk.NewDecWithPrec(50, 2))
+	cfg.BlocksPerEpoch = 0
+	_, err = simulator.Run(cfg)
+	require.Error(t, err)
+}
+
+func TestSyntheticGasTrace(t *testing.T) {
+	min, max := sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(80, 2)
+
+	trace, err := simulator.SyntheticGasTrace(simulator.PatternConstant, 3, min, max, 0)
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Dec{min, min, min}, trace)
+
+	trace, err = simulator.SyntheticGasTrace(simulator.PatternRamp, 4, min, max, 0)
+	require.NoError(t, err)
+	require.Equal(t, min, trace[0])
+	require.Equal(t, sdk.NewDecWithPrec(40, 2), trace[1])
+	require.Equal(t, max, trace[3])
+
+	trace, err = simulator.SyntheticGasTrace(simulator.PatternSine, 4, min, max, 4)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(50, 2), trace[0])
+	require.Equal(t, max, trace[1])
+	require.Equal(t, min, trace[3])
+
+	_, err = simulator.SyntheticGasTrace(simulator.PatternSine, 4, min, max, 0)
+	require.Error(t, err)
+	_, err = simulator.SyntheticGasTrace("square", 4, min, max, 0)
+	require.Error(t, err)
+}
+
+func TestLoadGasTrace(t *testing.T) {
+	dir := t.TempDir()
+	expected := []sdk.Dec{sdk.NewDecWithPrec(25, 2), sdk.NewDecWithPrec(5, 1)}
+
+	csvPath := filepath.Join(dir, "trace.csv")
+	require.NoError(t, os.WriteFile(csvPath, []byte("epoch,utilization\n1,0.25\n2, 0.5\n"), 0o600))
+	trace, err := simulator.LoadGasTrace(csvPath)
+	require.NoError(t, err)
+	require.Equal(t, expected, trace)
+
+	jsonPath := filepath.Join(dir, "trace.json")
+	require.NoError(t, os.WriteFile(jsonPath, []byte(`[0.25, "0.5"]`), 0o600))
+	trace, err = simulator.LoadGasTrace(jsonPath)
+	require.NoError(t, err)
+	require.Equal(t, expected, trace)
+
+	badPath := filepath.Join(dir, "bad.csv")
+	require.NoError(t, os.WriteFile(badPath, []byte("0.25\nhigh\n"), 0o600))
+	_, err = simulator.LoadGasTrace(badPath)
+	require.Error(t, err)
+}
+
+func TestWriteResults(t *testing.T) {
+	results, err := simulator.Run(newConfig(sdk.NewDecWithPrec(60, 2)))
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, simulator.WriteCSV(&buf, results))
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, len(results)+1)
+	require.True(t, strings.HasPrefix(lines[0], "epoch,gas_utilization,burn_rate"))
+
+	buf.Reset()
+	require.NoError(t, simulator.WriteJSON(&buf, results))
+	var decoded []simulator.EpochResult
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	require.Len(t, decoded, len(results))
+	require.Equal(t, results[0].TotalSupply, decoded[0].TotalSupply)
+}
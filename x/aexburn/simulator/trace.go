@@ -0,0 +1,125 @@
+package simulator
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Synthetic gas trace patterns
+const (
+	PatternConstant = "constant"
+	PatternRamp     = "ramp"
+	PatternSine     = "sine"
+)
+
+// SyntheticGasTrace generates a per-epoch gas utilization trace:
+// - constant: min every epoch
+// - ramp: linear from min in the first epoch to max in the last epoch
+// - sine: oscillates between min and max with the given period in epochs
+func SyntheticGasTrace(pattern string, epochs uint64, min, max sdk.Dec, period uint64) ([]sdk.Dec, error) {
+	if epochs == 0 {
+		return nil, fmt.Errorf("epochs must be positive")
+	}
+
+	trace := make([]sdk.Dec, epochs)
+	switch pattern {
+	case PatternConstant:
+		for i := range trace {
+			trace[i] = min
+		}
+	case PatternRamp:
+		for i := range trace {
+			if epochs == 1 {
+				trace[i] = min
+				continue
+			}
+			trace[i] = min.Add(max.Sub(min).MulInt64(int64(i)).QuoInt64(int64(epochs - 1)))
+		}
+	case PatternSine:
+		if period == 0 {
+			return nil, fmt.Errorf("period must be positive")
+		}
+		for i := range trace {
+			phase := (1 + math.Sin(2*math.Pi*float64(i)/float64(period))) / 2
+			trace[i] = min.Add(max.Sub(min).Mul(sdk.MustNewDecFromStr(strconv.FormatFloat(phase, 'f', 6, 64))))
+		}
+	default:
+		return nil, fmt.Errorf("unknown gas trace pattern %q, expected one of %s, %s, %s", pattern, PatternConstant, PatternRamp, PatternSine)
+	}
+	return trace, nil
+}
+
+// LoadGasTrace reads a recorded per-epoch gas utilization trace. JSON files hold
+// an array of utilizations, any other file is read as CSV whose last column is
+// the utilization of one epoch per row (a header row is skipped)
+func LoadGasTrace(path string) ([]sdk.Dec, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		return parseJSONTrace(bz)
+	}
+	return parseCSVTrace(bz)
+}
+
+func parseJSONTrace(bz []byte) ([]sdk.Dec, error) {
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.UseNumber()
+
+	var values []interface{}
+	if err := decoder.Decode(&values); err != nil {
+		return nil, err
+	}
+
+	trace := make([]sdk.Dec, 0, len(values))
+	for i, value := range values {
+		utilization, err := sdk.NewDecFromStr(strings.TrimSpace(fmt.Sprint(value)))
+		if err != nil {
+			return nil, fmt.Errorf("invalid gas utilization at index %d: %w", i, err)
+		}
+		trace = append(trace, utilization)
+	}
+	return trace, nil
+}
+
+func parseCSVTrace(bz []byte) ([]sdk.Dec, error) {
+	reader := csv.NewReader(bytes.NewReader(bz))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var trace []sdk.Dec
+	for row := 1; ; row++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(record) == 0 {
+			continue
+		}
+
+		utilization, err := sdk.NewDecFromStr(strings.TrimSpace(record[len(record)-1]))
+		if err != nil {
+			if row == 1 {
+				// Header row
+				continue
+			}
+			return nil, fmt.Errorf("invalid gas utilization in row %d: %w", row, err)
+		}
+		trace = append(trace, utilization)
+	}
+	return trace, nil
+}
@@ -6,17 +6,22 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -34,6 +39,13 @@ type BurnStats struct {
 	LastEpochNumber uint64 `protobuf:"varint,3,opt,name=last_epoch_number,json=lastEpochNumber,proto3" json:"last_epoch_number,omitempty" yaml:"last_epoch_number"`
 	// last_block_height is the block height when the last burn occurred
 	LastBlockHeight int64 `protobuf:"varint,4,opt,name=last_block_height,json=lastBlockHeight,proto3" json:"last_block_height,omitempty" yaml:"last_block_height"`
+	// total_burned_other_denoms is the total amount of non-AEX fee denoms burned
+	TotalBurnedOtherDenoms github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=total_burned_other_denoms,json=totalBurnedOtherDenoms,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_burned_other_denoms" yaml:"total_burned_other_denoms"`
+	// total_routed is the total amount of non-AEX fees routed to a treasury or the community pool
+	TotalRouted github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=total_routed,json=totalRouted,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_routed" yaml:"total_routed"`
+	// total_aex_equivalent_burned is the AEX value at oracle prices of the non-AEX fees burned
+	// under DENOM_BURN_ACTION_ORACLE (accounting only, not part of total_burned)
+	TotalAexEquivalentBurned github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=total_aex_equivalent_burned,json=totalAexEquivalentBurned,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_aex_equivalent_burned" yaml:"total_aex_equivalent_burned"`
 }
 
 func (m *BurnStats) Reset()         { *m = BurnStats{} }
@@ -83,9 +95,23 @@ func (m *BurnStats) GetLastBlockHeight() int64 {
 	return 0
 }
 
-// BurnRecord represents a single burn event
+func (m *BurnStats) GetTotalBurnedOtherDenoms() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalBurnedOtherDenoms
+	}
+	return nil
+}
+
+func (m *BurnStats) GetTotalRouted() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalRouted
+	}
+	return nil
+}
+
+// BurnRecord represents the fee burn of a single block
 type BurnRecord struct {
-	// epoch_number is the epoch when the burn occurred
+	// epoch_number is the epoch in which the burn occurred
 	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty" yaml:"epoch_number"`
 	// block_height is the block height when the burn occurred
 	BlockHeight int64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty" yaml:"block_height"`
@@ -97,6 +123,17 @@ type BurnRecord struct {
 	GasUsageRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=gas_usage_rate,json=gasUsageRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"gas_usage_rate" yaml:"gas_usage_rate"`
 	// total_fees is the total fees collected before burn
 	TotalFees github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=total_fees,json=totalFees,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_fees" yaml:"total_fees"`
+	// smoothing_adjustment is the change the income smoother made to the fees before burn
+	// (negative when contributed to the buffer, positive when released from it)
+	SmoothingAdjustment github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=smoothing_adjustment,json=smoothingAdjustment,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"smoothing_adjustment" yaml:"smoothing_adjustment"`
+	// brake_reduction is the amount the reverse brake reduced the burn rate by
+	BrakeReduction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=brake_reduction,json=brakeReduction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"brake_reduction" yaml:"brake_reduction"`
+	// burned_other_denoms is the amount of non-AEX fee denoms burned in this block
+	BurnedOtherDenoms github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=burned_other_denoms,json=burnedOtherDenoms,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned_other_denoms" yaml:"burned_other_denoms"`
+	// routed is the amount of non-AEX fees routed to a treasury or the community pool in this block
+	Routed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=routed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"routed" yaml:"routed"`
+	// aex_equivalent_burned is the AEX value at oracle prices of the oracle-priced fees burned in this block
+	AexEquivalentBurned github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=aex_equivalent_burned,json=aexEquivalentBurned,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"aex_equivalent_burned" yaml:"aex_equivalent_burned"`
 }
 
 func (m *BurnRecord) Reset()         { *m = BurnRecord{} }
@@ -146,9 +183,23 @@ func (m *BurnRecord) GetBlockHeight() int64 {
 	return 0
 }
 
-// MonthlyBurnData contains burn data for a specific month (for net supply calculation)
+func (m *BurnRecord) GetBurnedOtherDenoms() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BurnedOtherDenoms
+	}
+	return nil
+}
+
+func (m *BurnRecord) GetRouted() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Routed
+	}
+	return nil
+}
+
+// MonthlyBurnData contains burn data for a calendar month (for net supply calculation)
 type MonthlyBurnData struct {
-	// month_index is the index in the rolling 12-month window (0-11)
+	// month_index is the UTC calendar month of the block time, counted as year * 12 + month - 1
 	MonthIndex uint32 `protobuf:"varint,1,opt,name=month_index,json=monthIndex,proto3" json:"month_index,omitempty" yaml:"month_index"`
 	// burned_amount is the total amount burned in this month
 	BurnedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=burned_amount,json=burnedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"burned_amount" yaml:"burned_amount"`
@@ -236,9 +287,10 @@ func (m *MonthlyBurnData) GetEndEpoch() uint64 {
 type InflationStats struct {
 	// total_minted is the total amount of tokens minted through inflation (in base units)
 	TotalMinted github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=total_minted,json=totalMinted,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_minted" yaml:"total_minted"`
-	// annual_minted is the amount minted in the current year (resets annually)
+	// annual_minted is the amount minted in the rolling 365-day window as of the last mint
 	AnnualMinted github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=annual_minted,json=annualMinted,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"annual_minted" yaml:"annual_minted"`
-	// last_annual_reset_epoch is the epoch when annual_minted was last reset
+	// Deprecated: last_annual_reset_epoch is no longer updated since the annual window
+	// became a rolling 365 days of mint records
 	LastAnnualResetEpoch uint64 `protobuf:"varint,3,opt,name=last_annual_reset_epoch,json=lastAnnualResetEpoch,proto3" json:"last_annual_reset_epoch,omitempty" yaml:"last_annual_reset_epoch"`
 	// last_mint_epoch is the epoch when the last inflation occurred
 	LastMintEpoch uint64 `protobuf:"varint,4,opt,name=last_mint_epoch,json=lastMintEpoch,proto3" json:"last_mint_epoch,omitempty" yaml:"last_mint_epoch"`
@@ -312,6 +364,8 @@ type MintRecord struct {
 	TriggerReason string `protobuf:"bytes,4,opt,name=trigger_reason,json=triggerReason,proto3" json:"trigger_reason,omitempty" yaml:"trigger_reason"`
 	// gas_usage_rate is the gas usage rate at the time of mint
 	GasUsageRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=gas_usage_rate,json=gasUsageRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"gas_usage_rate" yaml:"gas_usage_rate"`
+	// block_time is the block time of the mint, used for the rolling annual inflation window
+	BlockTime time.Time `protobuf:"bytes,6,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time" yaml:"block_time"`
 }
 
 func (m *MintRecord) Reset()         { *m = MintRecord{} }
@@ -368,6 +422,13 @@ func (m *MintRecord) GetTriggerReason() string {
 	return ""
 }
 
+func (m *MintRecord) GetBlockTime() time.Time {
+	if m != nil {
+		return m.BlockTime
+	}
+	return time.Time{}
+}
+
 // ReverseBrakeState tracks the reverse brake mechanism state
 type ReverseBrakeState struct {
 	// consecutive_negative_periods is the count of consecutive periods with negative net supply
@@ -499,6 +560,291 @@ func (m *IncomeBuffer) GetLastReleaseBlock() int64 {
 	return 0
 }
 
+// BlockGasUsage records the gas consumed by a single block against its gas limit
+type BlockGasUsage struct {
+	// block_height is the height of the block
+	BlockHeight int64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty" yaml:"block_height"`
+	// gas_used is the gas consumed by the block
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty" yaml:"gas_used"`
+	// gas_limit is the block gas limit (consensus max gas) the usage is measured against
+	GasLimit uint64 `protobuf:"varint,3,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty" yaml:"gas_limit"`
+}
+
+func (m *BlockGasUsage) Reset()         { *m = BlockGasUsage{} }
+func (m *BlockGasUsage) String() string { return proto.CompactTextString(m) }
+func (*BlockGasUsage) ProtoMessage()    {}
+func (*BlockGasUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_806573cb0cb9804e, []int{7}
+}
+func (m *BlockGasUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockGasUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockGasUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockGasUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockGasUsage.Merge(m, src)
+}
+func (m *BlockGasUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockGasUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockGasUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockGasUsage proto.InternalMessageInfo
+
+func (m *BlockGasUsage) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *BlockGasUsage) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *BlockGasUsage) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+// GasUsageWindow aggregates the block gas usage samples in the rolling window
+type GasUsageWindow struct {
+	// total_gas_used is the sum of gas used by the blocks in the window
+	TotalGasUsed uint64 `protobuf:"varint,1,opt,name=total_gas_used,json=totalGasUsed,proto3" json:"total_gas_used,omitempty" yaml:"total_gas_used"`
+	// total_gas_limit is the sum of gas limits of the blocks in the window
+	TotalGasLimit uint64 `protobuf:"varint,2,opt,name=total_gas_limit,json=totalGasLimit,proto3" json:"total_gas_limit,omitempty" yaml:"total_gas_limit"`
+	// block_count is the number of blocks currently in the window
+	BlockCount uint64 `protobuf:"varint,3,opt,name=block_count,json=blockCount,proto3" json:"block_count,omitempty" yaml:"block_count"`
+}
+
+func (m *GasUsageWindow) Reset()         { *m = GasUsageWindow{} }
+func (m *GasUsageWindow) String() string { return proto.CompactTextString(m) }
+func (*GasUsageWindow) ProtoMessage()    {}
+func (*GasUsageWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_806573cb0cb9804e, []int{8}
+}
+func (m *GasUsageWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasUsageWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasUsageWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasUsageWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasUsageWindow.Merge(m, src)
+}
+func (m *GasUsageWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasUsageWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasUsageWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasUsageWindow proto.InternalMessageInfo
+
+func (m *GasUsageWindow) GetTotalGasUsed() uint64 {
+	if m != nil {
+		return m.TotalGasUsed
+	}
+	return 0
+}
+
+func (m *GasUsageWindow) GetTotalGasLimit() uint64 {
+	if m != nil {
+		return m.TotalGasLimit
+	}
+	return 0
+}
+
+func (m *GasUsageWindow) GetBlockCount() uint64 {
+	if m != nil {
+		return m.BlockCount
+	}
+	return 0
+}
+
+// EpochGasUsage accumulates the gas usage of every block in an epoch
+type EpochGasUsage struct {
+	// epoch_number is the epoch the usage is accumulated for
+	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty" yaml:"epoch_number"`
+	// total_gas_used is the sum of gas used by the blocks in the epoch
+	TotalGasUsed uint64 `protobuf:"varint,2,opt,name=total_gas_used,json=totalGasUsed,proto3" json:"total_gas_used,omitempty" yaml:"total_gas_used"`
+	// total_gas_limit is the sum of gas limits of the blocks in the epoch
+	TotalGasLimit uint64 `protobuf:"varint,3,opt,name=total_gas_limit,json=totalGasLimit,proto3" json:"total_gas_limit,omitempty" yaml:"total_gas_limit"`
+	// block_count is the number of blocks accumulated in the epoch
+	BlockCount uint64 `protobuf:"varint,4,opt,name=block_count,json=blockCount,proto3" json:"block_count,omitempty" yaml:"block_count"`
+	// peak_usage_rate is the highest single-block gas usage rate in the epoch
+	PeakUsageRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=peak_usage_rate,json=peakUsageRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"peak_usage_rate" yaml:"peak_usage_rate"`
+	// start_height is the first block height accumulated in the epoch
+	StartHeight int64 `protobuf:"varint,6,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty" yaml:"start_height"`
+	// end_height is the last block height accumulated in the epoch
+	EndHeight int64 `protobuf:"varint,7,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty" yaml:"end_height"`
+}
+
+func (m *EpochGasUsage) Reset()         { *m = EpochGasUsage{} }
+func (m *EpochGasUsage) String() string { return proto.CompactTextString(m) }
+func (*EpochGasUsage) ProtoMessage()    {}
+func (*EpochGasUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_806573cb0cb9804e, []int{9}
+}
+func (m *EpochGasUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochGasUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochGasUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochGasUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochGasUsage.Merge(m, src)
+}
+func (m *EpochGasUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochGasUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochGasUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochGasUsage proto.InternalMessageInfo
+
+func (m *EpochGasUsage) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *EpochGasUsage) GetTotalGasUsed() uint64 {
+	if m != nil {
+		return m.TotalGasUsed
+	}
+	return 0
+}
+
+func (m *EpochGasUsage) GetTotalGasLimit() uint64 {
+	if m != nil {
+		return m.TotalGasLimit
+	}
+	return 0
+}
+
+func (m *EpochGasUsage) GetBlockCount() uint64 {
+	if m != nil {
+		return m.BlockCount
+	}
+	return 0
+}
+
+func (m *EpochGasUsage) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *EpochGasUsage) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+// EmergencyState tracks the governance emergency controls of the module
+type EmergencyState struct {
+	// burn_paused halts fee burning and income smoothing regardless of params
+	BurnPaused bool `protobuf:"varint,1,opt,name=burn_paused,json=burnPaused,proto3" json:"burn_paused,omitempty" yaml:"burn_paused"`
+	// mint_paused halts inflation minting regardless of params
+	MintPaused bool `protobuf:"varint,2,opt,name=mint_paused,json=mintPaused,proto3" json:"mint_paused,omitempty" yaml:"mint_paused"`
+	// last_updated_height is the block height when the emergency state last changed
+	LastUpdatedHeight int64 `protobuf:"varint,3,opt,name=last_updated_height,json=lastUpdatedHeight,proto3" json:"last_updated_height,omitempty" yaml:"last_updated_height"`
+}
+
+func (m *EmergencyState) Reset()         { *m = EmergencyState{} }
+func (m *EmergencyState) String() string { return proto.CompactTextString(m) }
+func (*EmergencyState) ProtoMessage()    {}
+func (*EmergencyState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_806573cb0cb9804e, []int{10}
+}
+func (m *EmergencyState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmergencyState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmergencyState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmergencyState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmergencyState.Merge(m, src)
+}
+func (m *EmergencyState) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmergencyState) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmergencyState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmergencyState proto.InternalMessageInfo
+
+func (m *EmergencyState) GetBurnPaused() bool {
+	if m != nil {
+		return m.BurnPaused
+	}
+	return false
+}
+
+func (m *EmergencyState) GetMintPaused() bool {
+	if m != nil {
+		return m.MintPaused
+	}
+	return false
+}
+
+func (m *EmergencyState) GetLastUpdatedHeight() int64 {
+	if m != nil {
+		return m.LastUpdatedHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*BurnStats)(nil), "seiprotocol.seichain.aexburn.BurnStats")
 	proto.RegisterType((*BurnRecord)(nil), "seiprotocol.seichain.aexburn.BurnRecord")
@@ -507,86 +853,129 @@ func init() {
 	proto.RegisterType((*MintRecord)(nil), "seiprotocol.seichain.aexburn.MintRecord")
 	proto.RegisterType((*ReverseBrakeState)(nil), "seiprotocol.seichain.aexburn.ReverseBrakeState")
 	proto.RegisterType((*IncomeBuffer)(nil), "seiprotocol.seichain.aexburn.IncomeBuffer")
+	proto.RegisterType((*BlockGasUsage)(nil), "seiprotocol.seichain.aexburn.BlockGasUsage")
+	proto.RegisterType((*GasUsageWindow)(nil), "seiprotocol.seichain.aexburn.GasUsageWindow")
+	proto.RegisterType((*EpochGasUsage)(nil), "seiprotocol.seichain.aexburn.EpochGasUsage")
+	proto.RegisterType((*EmergencyState)(nil), "seiprotocol.seichain.aexburn.EmergencyState")
 }
 
 func init() { proto.RegisterFile("aexburn/burn.proto", fileDescriptor_806573cb0cb9804e) }
 
 var fileDescriptor_806573cb0cb9804e = []byte{
-	// 1169 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x4d, 0x6f, 0xdb, 0x46,
-	0x13, 0xb6, 0x6c, 0xe5, 0x43, 0x6b, 0x49, 0xb1, 0x19, 0x3b, 0x51, 0xfc, 0x3a, 0xa2, 0xdf, 0x2d,
-	0xd0, 0xfa, 0x12, 0x0b, 0x41, 0x0b, 0x04, 0xf0, 0x29, 0xa6, 0xe3, 0xc4, 0xce, 0x87, 0x51, 0x6c,
-	0xd0, 0x02, 0xf5, 0x85, 0x58, 0x51, 0x63, 0x89, 0x10, 0xb5, 0x14, 0xc8, 0xa5, 0x6b, 0x03, 0x3d,
-	0xf5, 0x17, 0xf4, 0x37, 0x14, 0xe8, 0x7f, 0xc9, 0x31, 0xc7, 0xa2, 0x07, 0xa2, 0xb0, 0xff, 0x81,
-	0xd0, 0x43, 0xd1, 0x53, 0xb1, 0xb3, 0x2b, 0x73, 0x69, 0x03, 0x05, 0x84, 0xa4, 0xe9, 0xc5, 0xe6,
-	0x7c, 0xec, 0xf3, 0x0c, 0x76, 0x66, 0x1e, 0x8a, 0xc4, 0xe1, 0x70, 0xda, 0xcd, 0x12, 0xd1, 0x51,
-	0x7f, 0xb6, 0xc6, 0x49, 0x2c, 0x63, 0x67, 0x3d, 0x85, 0x10, 0x9f, 0x82, 0x38, 0xda, 0x4a, 0x21,
-	0x0c, 0x06, 0x3c, 0x14, 0x5b, 0x26, 0x71, 0x6d, 0xa5, 0x1f, 0xf7, 0x63, 0x0c, 0x77, 0xd4, 0x93,
-	0x3e, 0x43, 0xff, 0x9c, 0x27, 0x35, 0x2f, 0x4b, 0xc4, 0x5b, 0xc9, 0x65, 0xea, 0x0c, 0x48, 0x5d,
-	0xc6, 0x92, 0x47, 0xbe, 0x3a, 0x01, 0xbd, 0x56, 0x65, 0xa3, 0xb2, 0x59, 0xf3, 0xf6, 0xde, 0xe5,
-	0xee, 0xdc, 0x6f, 0xb9, 0xfb, 0x79, 0x3f, 0x94, 0x83, 0xac, 0xbb, 0x15, 0xc4, 0xa3, 0x4e, 0x10,
-	0xa7, 0xa3, 0x38, 0x35, 0xff, 0x1e, 0xa5, 0xbd, 0x61, 0x47, 0x9e, 0x8d, 0x21, 0xdd, 0x3a, 0x10,
-	0x72, 0x92, 0xbb, 0x77, 0xcf, 0xf8, 0x28, 0xda, 0xa6, 0x36, 0x16, 0x65, 0x8b, 0x68, 0x7a, 0x68,
-	0x39, 0x23, 0xd2, 0x8c, 0x78, 0x2a, 0x31, 0xe8, 0x27, 0x5c, 0x42, 0x6b, 0x1e, 0xb9, 0x5e, 0xcc,
-	0xc0, 0xf5, 0x0c, 0x82, 0x49, 0xee, 0xae, 0x6a, 0xae, 0x32, 0x1a, 0x65, 0x75, 0xe5, 0x50, 0x64,
-	0x8c, 0x4b, 0x70, 0xf6, 0xc9, 0x32, 0x26, 0xc0, 0x38, 0x0e, 0x06, 0xbe, 0xc8, 0x46, 0x5d, 0x48,
-	0x5a, 0x0b, 0x1b, 0x95, 0xcd, 0xaa, 0xb7, 0x3e, 0xc9, 0xdd, 0x96, 0x85, 0x61, 0xa7, 0x50, 0x76,
-	0x47, 0xf9, 0xf6, 0x94, 0xeb, 0x10, 0x3d, 0x97, 0x48, 0xdd, 0x28, 0x0e, 0x86, 0xfe, 0x00, 0xc2,
-	0xfe, 0x40, 0xb6, 0xaa, 0x1b, 0x95, 0xcd, 0x85, 0x6b, 0x48, 0x76, 0x8a, 0x41, 0xf2, 0x94, 0x6b,
-	0x5f, 0x7b, 0x7e, 0xa9, 0x12, 0x82, 0x05, 0x42, 0x10, 0x27, 0x3d, 0x67, 0x9b, 0xd4, 0x4b, 0xd5,
-	0x55, 0xb0, 0xba, 0xfb, 0xc5, 0x6d, 0x96, 0x0b, 0x5b, 0x04, 0xab, 0xa8, 0x6d, 0x52, 0x2f, 0xd5,
-	0x33, 0x8f, 0xf5, 0x58, 0x67, 0xcb, 0xa5, 0x2c, 0x76, 0x8b, 0x32, 0x9c, 0x21, 0x69, 0xe8, 0x0e,
-	0xf9, 0x7c, 0x14, 0x67, 0x42, 0xe2, 0xb5, 0xd4, 0xbc, 0xe7, 0x33, 0x37, 0x7d, 0xc5, 0x50, 0xd9,
-	0x60, 0x94, 0xd5, 0xb5, 0xbd, 0x83, 0xa6, 0xe3, 0x93, 0x5a, 0xd1, 0xf1, 0x2a, 0x12, 0x79, 0x33,
-	0x77, 0x7c, 0xa9, 0x20, 0x32, 0xcd, 0xbe, 0xdd, 0x9d, 0x36, 0x7a, 0x44, 0x9a, 0x7d, 0x9e, 0xfa,
-	0x59, 0xca, 0xfb, 0xa0, 0x59, 0x6e, 0x7c, 0xd8, 0x5c, 0x95, 0xd1, 0x28, 0xab, 0xf7, 0x79, 0xfa,
-	0x8d, 0xb2, 0x91, 0xae, 0x4b, 0x88, 0x1e, 0xf2, 0x63, 0x80, 0xb4, 0x75, 0x13, 0xa9, 0x76, 0x67,
-	0xbe, 0xb9, 0x65, 0x7b, 0x5d, 0x14, 0x12, 0x65, 0x35, 0x34, 0x9e, 0xab, 0xe7, 0x1f, 0xab, 0xe4,
-	0xce, 0x9b, 0x58, 0xc8, 0x41, 0x74, 0xa6, 0xc6, 0xe5, 0x19, 0x97, 0xdc, 0x79, 0x42, 0x16, 0x47,
-	0xca, 0xe5, 0x87, 0xa2, 0x07, 0xa7, 0x38, 0x2b, 0x0d, 0xef, 0xde, 0x24, 0x77, 0x1d, 0x0d, 0x65,
-	0x05, 0x29, 0x23, 0x68, 0x1d, 0x28, 0xe3, 0x7a, 0xb7, 0xe7, 0xff, 0xc5, 0x6e, 0x0f, 0x49, 0x63,
-	0x14, 0x0a, 0xf9, 0xd1, 0x46, 0xab, 0x04, 0x46, 0x59, 0x5d, 0xdb, 0x86, 0x6c, 0x9b, 0xd4, 0x53,
-	0xc9, 0x13, 0x59, 0xde, 0x49, 0x6b, 0x07, 0xec, 0x28, 0x65, 0x8b, 0x68, 0x9a, 0x1d, 0xf8, 0x8a,
-	0x10, 0x10, 0xbd, 0xe9, 0xc9, 0x1b, 0x78, 0x72, 0xb5, 0x68, 0x4c, 0x11, 0xa3, 0xac, 0x06, 0xa2,
-	0x67, 0x4e, 0x3d, 0x21, 0x1a, 0x44, 0x4b, 0x06, 0x76, 0xbf, 0x6a, 0x37, 0xc1, 0x0a, 0x52, 0x46,
-	0xd0, 0x42, 0x25, 0x71, 0x1e, 0x13, 0x85, 0x62, 0x8e, 0xdd, 0xc2, 0x63, 0x2b, 0xc5, 0x5c, 0x5f,
-	0x86, 0x28, 0xbb, 0x0d, 0xa2, 0x87, 0x47, 0x68, 0xbe, 0x40, 0x9a, 0x07, 0xe2, 0x38, 0xe2, 0x32,
-	0x8c, 0xaf, 0x8a, 0xb5, 0xbe, 0x86, 0x8f, 0x23, 0xd6, 0x1a, 0x6b, 0x2a, 0xd6, 0x6f, 0xd0, 0x52,
-	0x7d, 0xe4, 0x42, 0x64, 0x05, 0xd5, 0x07, 0x0e, 0x4d, 0x09, 0x8c, 0xb2, 0xba, 0xb6, 0x0d, 0xd9,
-	0x77, 0xe4, 0x3e, 0xaa, 0xa7, 0x49, 0x4a, 0x20, 0x85, 0xe9, 0x0d, 0x6b, 0xc1, 0xa6, 0x93, 0xdc,
-	0x6d, 0x5b, 0x32, 0x7b, 0x3d, 0x91, 0xb2, 0x15, 0x15, 0xd9, 0xc1, 0x00, 0x53, 0x7e, 0x7d, 0xef,
-	0x1e, 0x41, 0x11, 0x46, 0x62, 0x03, 0x59, 0x45, 0xc8, 0xb5, 0x49, 0xee, 0xde, 0xb3, 0x20, 0x8b,
-	0x04, 0xca, 0x1a, 0xca, 0xa3, 0x6a, 0xd3, 0x18, 0xdf, 0x92, 0x7b, 0x45, 0x4a, 0x49, 0x74, 0xf5,
-	0xd8, 0xfc, 0x7f, 0x92, 0xbb, 0x0f, 0xaf, 0x42, 0x95, 0xe5, 0xf7, 0xee, 0x14, 0xd1, 0x7e, 0x1b,
-	0xfc, 0xbc, 0x40, 0x88, 0xf2, 0xfd, 0xf7, 0x6f, 0x83, 0x4f, 0xb7, 0xb2, 0x4f, 0x49, 0x53, 0x26,
-	0x61, 0xbf, 0x0f, 0x89, 0x9f, 0x00, 0x4f, 0x63, 0x61, 0x5e, 0x09, 0x0f, 0x0a, 0xf9, 0x2d, 0xc7,
-	0x29, 0x6b, 0x18, 0x07, 0x43, 0xfb, 0x13, 0xcb, 0x3d, 0xfd, 0x63, 0x81, 0x2c, 0x33, 0x38, 0x81,
-	0x24, 0x05, 0x2f, 0xe1, 0x43, 0x50, 0x8b, 0x08, 0x4e, 0x48, 0xd6, 0x83, 0x58, 0xa4, 0x10, 0x64,
-	0x32, 0x3c, 0x01, 0x5f, 0x40, 0x9f, 0xe3, 0xc3, 0x18, 0x92, 0x30, 0xee, 0xa5, 0x46, 0x9d, 0xbf,
-	0x98, 0xe4, 0xee, 0x67, 0x9a, 0xe4, 0x9f, 0xb2, 0x29, 0x5b, 0xb3, 0xc2, 0x87, 0x26, 0xfa, 0xb5,
-	0x0e, 0xaa, 0x09, 0x0e, 0x53, 0xbf, 0xab, 0xb8, 0x7d, 0x1e, 0xa8, 0x08, 0x76, 0xf7, 0xb6, 0x3d,
-	0xc1, 0x57, 0x12, 0x28, 0x6b, 0x84, 0x29, 0x56, 0xbb, 0x83, 0xb6, 0xf3, 0x3d, 0x59, 0x0e, 0xb2,
-	0x24, 0x01, 0x21, 0xfd, 0x04, 0x7a, 0x59, 0xa0, 0x14, 0xc5, 0xb4, 0xf9, 0xe5, 0xcc, 0xd7, 0x66,
-	0x7e, 0xef, 0x5c, 0x03, 0xa4, 0x6c, 0xc9, 0xf8, 0xd8, 0xd4, 0xe5, 0xec, 0x91, 0x25, 0x5c, 0x89,
-	0x60, 0x00, 0xc1, 0xb0, 0xb4, 0x7f, 0xff, 0x9b, 0xe4, 0xee, 0x7d, 0x6b, 0x69, 0xac, 0x0c, 0xca,
-	0xf0, 0x87, 0xe2, 0xae, 0xf2, 0xe8, 0x0d, 0x1c, 0x9b, 0x2d, 0x16, 0x20, 0xfd, 0x34, 0x1b, 0x8f,
-	0xa3, 0x33, 0xd3, 0xf4, 0xfd, 0x99, 0x87, 0xd4, 0xde, 0xf9, 0x02, 0xce, 0xec, 0xfc, 0x21, 0xc8,
-	0xb7, 0xda, 0xfe, 0xab, 0x4a, 0xea, 0x07, 0x22, 0x88, 0x47, 0xe0, 0x65, 0xc7, 0xc7, 0x90, 0x38,
-	0x47, 0xe4, 0x56, 0x97, 0x47, 0x5c, 0x04, 0x60, 0x54, 0xf7, 0xe9, 0xcc, 0xd4, 0x4d, 0xb3, 0x8a,
-	0x1a, 0x86, 0xb2, 0x29, 0xa0, 0x6a, 0x8f, 0x96, 0xe2, 0x20, 0x16, 0x32, 0x09, 0xbb, 0x59, 0x21,
-	0xb8, 0x2f, 0x67, 0x66, 0x69, 0xd9, 0xda, 0x6e, 0x01, 0x52, 0xb6, 0x84, 0xbe, 0xdd, 0xc2, 0xe5,
-	0x08, 0xd2, 0xd4, 0x79, 0x09, 0x44, 0xc0, 0x53, 0xe8, 0x99, 0xa1, 0x78, 0x31, 0x33, 0xeb, 0xaa,
-	0xcd, 0x3a, 0x45, 0x53, 0xbb, 0xab, 0x1c, 0xcc, 0xd8, 0xce, 0x91, 0x11, 0xfa, 0xcb, 0xb2, 0xc2,
-	0x58, 0x68, 0xa5, 0x34, 0xef, 0xee, 0xab, 0x42, 0x7f, 0x3d, 0x91, 0xb2, 0x55, 0x1c, 0x0e, 0x2b,
-	0x80, 0x9a, 0xea, 0xbc, 0x22, 0x0e, 0x1e, 0x31, 0xe4, 0x06, 0x56, 0x2b, 0xf4, 0xc3, 0x49, 0xee,
-	0x3e, 0xb0, 0x60, 0x4b, 0x39, 0x94, 0xe1, 0x8c, 0x9a, 0x22, 0x35, 0xd8, 0x0f, 0xe4, 0xae, 0x7e,
-	0xd1, 0xa8, 0xfd, 0x09, 0xe5, 0x99, 0x1f, 0xc1, 0x09, 0x44, 0xe6, 0xd7, 0xde, 0xeb, 0x99, 0x57,
-	0x66, 0xcd, 0x7e, 0x77, 0x95, 0x20, 0x29, 0xc3, 0x6f, 0x8b, 0x1d, 0xe3, 0x7c, 0xad, 0x7c, 0xde,
-	0xab, 0x77, 0xe7, 0xed, 0xca, 0xfb, 0xf3, 0x76, 0xe5, 0xf7, 0xf3, 0x76, 0xe5, 0xa7, 0x8b, 0xf6,
-	0xdc, 0xfb, 0x8b, 0xf6, 0xdc, 0xaf, 0x17, 0xed, 0xb9, 0xa3, 0xc7, 0x16, 0x65, 0x0a, 0xe1, 0xa3,
-	0xe9, 0xb7, 0x1f, 0x1a, 0xf8, 0xf1, 0xd7, 0x39, 0xed, 0x4c, 0xbf, 0x13, 0xb1, 0x82, 0xee, 0x4d,
-	0xcc, 0xf9, 0xf2, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x87, 0xb5, 0x82, 0xf9, 0x3f, 0x0e, 0x00,
-	0x00,
+	// 1801 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x8a, 0xb4, 0x24, 0x0e, 0x3f, 0x64, 0xad, 0x24, 0x9b, 0x92, 0x6d, 0x52, 0x9d, 0x02,
+	0xad, 0x2e, 0x21, 0xe1, 0xb4, 0x40, 0x00, 0x5f, 0x1a, 0xad, 0xac, 0xc8, 0x4e, 0x6c, 0x35, 0x98,
+	0x38, 0xfd, 0xf0, 0x65, 0x31, 0x5c, 0x8e, 0xc8, 0xad, 0xb8, 0xb3, 0xcc, 0xee, 0xac, 0x22, 0x01,
+	0x3d, 0x14, 0x01, 0x7a, 0x0f, 0xd0, 0x53, 0xff, 0x85, 0x1c, 0x7a, 0xe8, 0xbf, 0xd0, 0x4b, 0x4e,
+	0x45, 0xd0, 0x53, 0xd1, 0x03, 0x53, 0xd8, 0xe7, 0x5e, 0x88, 0x9e, 0x8a, 0x1e, 0x8a, 0x79, 0x33,
+	0xcb, 0x9d, 0x25, 0xd5, 0xda, 0x2b, 0xa5, 0xc9, 0xc5, 0xe6, 0xfb, 0x98, 0xdf, 0x7b, 0x7a, 0xf3,
+	0xe6, 0x37, 0x6f, 0x16, 0xd9, 0x94, 0x9d, 0xf7, 0x92, 0x88, 0x77, 0xe5, 0x3f, 0x9d, 0x71, 0x14,
+	0x8a, 0xd0, 0xbe, 0x17, 0x33, 0x1f, 0x7e, 0x79, 0xe1, 0xa8, 0x13, 0x33, 0xdf, 0x1b, 0x52, 0x9f,
+	0x77, 0xb4, 0xe3, 0xce, 0xe6, 0x20, 0x1c, 0x84, 0x60, 0xee, 0xca, 0x5f, 0x6a, 0xcd, 0x4e, 0x7b,
+	0x10, 0x86, 0x83, 0x11, 0xeb, 0x82, 0xd4, 0x4b, 0x4e, 0xba, 0xc2, 0x0f, 0x58, 0x2c, 0x68, 0x30,
+	0xd6, 0x0e, 0x2d, 0x2f, 0x8c, 0x83, 0x30, 0xee, 0xf6, 0x68, 0xcc, 0xba, 0x67, 0x0f, 0x7a, 0x4c,
+	0xd0, 0x07, 0x5d, 0x2f, 0xf4, 0x75, 0x50, 0xfc, 0xa7, 0x65, 0x54, 0x71, 0x92, 0x88, 0x7f, 0x24,
+	0xa8, 0x88, 0xed, 0x21, 0xaa, 0x89, 0x50, 0xd0, 0x91, 0x2b, 0x43, 0xb2, 0x7e, 0xd3, 0xda, 0xb5,
+	0xf6, 0x2a, 0xce, 0xe1, 0x97, 0x93, 0xf6, 0x8d, 0xbf, 0x4d, 0xda, 0x3f, 0x18, 0xf8, 0x62, 0x98,
+	0xf4, 0x3a, 0x5e, 0x18, 0x74, 0x35, 0xac, 0xfa, 0xef, 0xad, 0xb8, 0x7f, 0xda, 0x15, 0x17, 0x63,
+	0x16, 0x77, 0x9e, 0x70, 0x31, 0x9d, 0xb4, 0x37, 0x2e, 0x68, 0x30, 0x7a, 0x88, 0x4d, 0x2c, 0x4c,
+	0xaa, 0x20, 0x3a, 0x20, 0xd9, 0x01, 0x6a, 0x8c, 0x68, 0x2c, 0xc0, 0xe8, 0x46, 0x54, 0xb0, 0xe6,
+	0x12, 0xc4, 0x3a, 0x2a, 0x10, 0xeb, 0x11, 0xf3, 0xa6, 0x93, 0xf6, 0x96, 0x8a, 0x95, 0x47, 0xc3,
+	0xa4, 0x26, 0x15, 0x32, 0x18, 0xa1, 0x82, 0xd9, 0x8f, 0xd1, 0x3a, 0x38, 0xb0, 0x71, 0xe8, 0x0d,
+	0x5d, 0x9e, 0x04, 0x3d, 0x16, 0x35, 0x4b, 0xbb, 0xd6, 0x5e, 0xd9, 0xb9, 0x37, 0x9d, 0xb4, 0x9b,
+	0x06, 0x86, 0xe9, 0x82, 0xc9, 0x9a, 0xd4, 0x1d, 0x4a, 0xd5, 0x31, 0x68, 0x66, 0x48, 0xbd, 0x51,
+	0xe8, 0x9d, 0xba, 0x43, 0xe6, 0x0f, 0x86, 0xa2, 0x59, 0xde, 0xb5, 0xf6, 0x4a, 0x0b, 0x48, 0xa6,
+	0x8b, 0x46, 0x72, 0xa4, 0xea, 0x31, 0x68, 0xec, 0x2f, 0x2c, 0xb4, 0x6d, 0x56, 0xc8, 0x0d, 0xc5,
+	0x90, 0x45, 0x6e, 0x9f, 0xf1, 0x30, 0x88, 0x9b, 0x37, 0x77, 0x4b, 0x7b, 0xd5, 0xb7, 0xb7, 0x3b,
+	0xea, 0xaf, 0xee, 0xc8, 0xfd, 0xeb, 0xe8, 0xfd, 0xeb, 0x1c, 0x84, 0x3e, 0x77, 0x9e, 0xcb, 0x4a,
+	0x4d, 0x27, 0xed, 0xdd, 0xc5, 0x5a, 0xe7, 0x90, 0xf0, 0x17, 0x5f, 0xb7, 0xf7, 0xde, 0xa0, 0x9a,
+	0x12, 0x34, 0x26, 0xb7, 0x8d, 0x4d, 0xfa, 0xa9, 0x44, 0x79, 0x04, 0x20, 0xf6, 0x6f, 0xad, 0xb4,
+	0x35, 0xa2, 0x30, 0x11, 0xac, 0xdf, 0x5c, 0x7e, 0x5d, 0x7e, 0x47, 0x3a, 0xbf, 0x5c, 0x2f, 0xa8,
+	0xc5, 0xc5, 0x52, 0x52, 0x7d, 0x43, 0x60, 0xa5, 0xfd, 0x3b, 0x0b, 0xdd, 0x55, 0x50, 0x94, 0x9d,
+	0xbb, 0xec, 0x93, 0xc4, 0x3f, 0xa3, 0x23, 0xc6, 0x45, 0xda, 0xb1, 0x2b, 0xd0, 0x45, 0xcf, 0x0b,
+	0x77, 0x2c, 0x36, 0xb3, 0xbc, 0x14, 0x1a, 0x93, 0x26, 0x58, 0xf7, 0xd9, 0xf9, 0xe1, 0xcc, 0xa6,
+	0x0a, 0x85, 0xff, 0x58, 0x41, 0x48, 0xfe, 0x24, 0xcc, 0x0b, 0xa3, 0xbe, 0xfd, 0x10, 0xd5, 0x72,
+	0x8d, 0x66, 0x41, 0xa3, 0xdd, 0xc9, 0x8a, 0x91, 0xef, 0xb1, 0x2a, 0x33, 0xfa, 0xeb, 0x21, 0xaa,
+	0xe5, 0x5a, 0x6b, 0x09, 0x5a, 0xcb, 0x58, 0x9b, 0xef, 0xaa, 0x6a, 0xcf, 0xe8, 0xa8, 0x53, 0x54,
+	0xd7, 0x0d, 0x40, 0x83, 0x30, 0xe1, 0x02, 0x3a, 0xbc, 0xe2, 0xbc, 0x57, 0xb8, 0x1a, 0x9b, 0x3a,
+	0x94, 0x09, 0x86, 0x49, 0x4d, 0xc9, 0xfb, 0x20, 0xda, 0x2e, 0xaa, 0x64, 0x87, 0xb7, 0x0c, 0x81,
+	0x9c, 0xc2, 0x87, 0xf7, 0x56, 0x16, 0x48, 0x9f, 0xdb, 0xd5, 0x5e, 0x7a, 0x66, 0x03, 0xd4, 0x18,
+	0xd0, 0xd8, 0x4d, 0x62, 0x3a, 0x60, 0x2a, 0xca, 0xcd, 0xeb, 0x51, 0x44, 0x1e, 0x0d, 0x93, 0xda,
+	0x80, 0xc6, 0x1f, 0x4b, 0x19, 0xc2, 0xf5, 0x10, 0x52, 0xbb, 0x7f, 0xc2, 0x58, 0xdc, 0x5c, 0x86,
+	0x50, 0x07, 0x85, 0x2b, 0xb7, 0x6e, 0xf6, 0x91, 0x44, 0xc2, 0xa4, 0x02, 0xc2, 0x7b, 0x8c, 0xc5,
+	0xf6, 0x6f, 0x2c, 0xb4, 0x19, 0x07, 0x61, 0x28, 0x86, 0x3e, 0x1f, 0xb8, 0xb4, 0xff, 0xab, 0x24,
+	0x16, 0x01, 0xe3, 0x42, 0xb7, 0xed, 0xb3, 0xc2, 0xe1, 0xee, 0xaa, 0x70, 0x97, 0x61, 0x62, 0xb2,
+	0x31, 0x53, 0xef, 0xcf, 0xb4, 0xf6, 0x27, 0x68, 0xad, 0x17, 0xd1, 0x53, 0xe6, 0x46, 0xac, 0x9f,
+	0x78, 0xc2, 0x0f, 0x79, 0x73, 0x15, 0x82, 0x3f, 0x2e, 0x5c, 0xd6, 0xdb, 0x7a, 0xf3, 0xf2, 0x70,
+	0x98, 0x34, 0x40, 0x43, 0x52, 0x85, 0xfd, 0x7b, 0x0b, 0x6d, 0x5c, 0x46, 0x71, 0x95, 0xd7, 0x51,
+	0xc8, 0xb1, 0xa6, 0x90, 0x9d, 0x5c, 0x3b, 0x5e, 0x9d, 0xdc, 0xd6, 0x7b, 0x0b, 0xbc, 0x26, 0xd0,
+	0xb2, 0x26, 0x34, 0xf4, 0xba, 0x6c, 0xf6, 0x75, 0x36, 0x75, 0x95, 0xcd, 0x55, 0xa8, 0x4c, 0xc7,
+	0xb2, 0x3f, 0xb3, 0xd0, 0xd6, 0xe5, 0xfc, 0x55, 0x85, 0xbd, 0x38, 0x2e, 0xdc, 0x08, 0xf7, 0x54,
+	0x52, 0xff, 0x85, 0xb9, 0x36, 0xe8, 0x25, 0xa4, 0xf5, 0x59, 0x19, 0xad, 0x3d, 0x0b, 0xb9, 0x18,
+	0x8e, 0x2e, 0xa4, 0xe6, 0x11, 0x15, 0xd4, 0x7e, 0x07, 0x55, 0x03, 0xa9, 0x72, 0x7d, 0xde, 0x67,
+	0xe7, 0x40, 0x5c, 0x75, 0xe7, 0xf6, 0x74, 0xd2, 0xb6, 0x15, 0xbe, 0x61, 0xc4, 0x04, 0x81, 0xf4,
+	0x44, 0x0a, 0x8b, 0xd4, 0xb3, 0xf4, 0x7f, 0xa4, 0x9e, 0x53, 0x54, 0x0f, 0x7c, 0x2e, 0xbe, 0x31,
+	0x9e, 0xcb, 0x81, 0x61, 0x52, 0x53, 0xb2, 0x0e, 0xf6, 0x10, 0xd5, 0x62, 0x41, 0x23, 0x91, 0xbf,
+	0xeb, 0x0d, 0x42, 0x36, 0xad, 0x98, 0x54, 0x41, 0xd4, 0x84, 0xfc, 0x63, 0x84, 0x18, 0xef, 0xa7,
+	0x2b, 0x6f, 0xc2, 0xca, 0xad, 0x8c, 0x25, 0x32, 0x1b, 0x26, 0x15, 0xc6, 0xfb, 0x7a, 0xd5, 0x3b,
+	0x48, 0x81, 0xa8, 0x51, 0x04, 0xa8, 0xa8, 0x6c, 0x6e, 0x82, 0x61, 0xc4, 0x04, 0x81, 0x04, 0x13,
+	0x8a, 0xfd, 0x00, 0x49, 0x14, 0xbd, 0x6c, 0x05, 0x96, 0x6d, 0x66, 0x24, 0x3b, 0x33, 0x61, 0xb2,
+	0xca, 0x78, 0x1f, 0x96, 0xe0, 0x49, 0x09, 0x35, 0x9e, 0xf0, 0x93, 0x11, 0x95, 0x27, 0x75, 0x6e,
+	0x08, 0x54, 0x65, 0xf8, 0x66, 0x86, 0x40, 0x85, 0x95, 0x0e, 0x81, 0xcf, 0x40, 0x92, 0xfb, 0x48,
+	0x39, 0x4f, 0xb2, 0x50, 0xd7, 0x6c, 0x9a, 0x1c, 0x18, 0x26, 0x35, 0x25, 0xeb, 0x60, 0xbf, 0x44,
+	0x77, 0x60, 0x2a, 0xd3, 0x4e, 0x11, 0x8b, 0x59, 0x5a, 0x61, 0x35, 0x08, 0xe2, 0xe9, 0xa4, 0xdd,
+	0x32, 0xc6, 0xb7, 0x45, 0x47, 0x4c, 0x36, 0xa5, 0x65, 0x1f, 0x0c, 0x44, 0xea, 0x55, 0xdd, 0x1d,
+	0x04, 0xc3, 0x1d, 0x04, 0xd6, 0x90, 0x65, 0x80, 0xdc, 0xc9, 0x58, 0x72, 0xce, 0x01, 0x93, 0xba,
+	0xd4, 0xc8, 0xdc, 0x14, 0xc6, 0xcf, 0xd0, 0xed, 0xcc, 0x25, 0x37, 0x01, 0xa8, 0xb6, 0xf9, 0xde,
+	0x74, 0xd2, 0xbe, 0x3f, 0x0f, 0x95, 0x9f, 0x05, 0x36, 0x52, 0x44, 0x63, 0xca, 0xc4, 0xff, 0x2e,
+	0x21, 0x24, 0x75, 0xdf, 0xfd, 0x68, 0xf2, 0xed, 0x1d, 0xd9, 0x77, 0x51, 0x43, 0x44, 0xfe, 0x60,
+	0xc0, 0x22, 0x37, 0x62, 0x34, 0x0e, 0xb9, 0x9e, 0x4f, 0xb6, 0xb3, 0x59, 0x20, 0x6f, 0xc7, 0xa4,
+	0xae, 0x15, 0x04, 0xe4, 0x6f, 0x7b, 0xf6, 0xf8, 0x05, 0x42, 0xaa, 0x76, 0xf2, 0xf9, 0x06, 0x07,
+	0xbe, 0xfa, 0xf6, 0x4e, 0x47, 0xbd, 0xed, 0x3a, 0xe9, 0xdb, 0xae, 0xf3, 0x3c, 0x7d, 0xdb, 0x39,
+	0xf7, 0xf5, 0x55, 0xb4, 0x6e, 0xd6, 0x5d, 0xae, 0xc5, 0x9f, 0x7f, 0xdd, 0xb6, 0x48, 0x05, 0x14,
+	0xd2, 0x1d, 0xff, 0xb3, 0x84, 0xd6, 0x09, 0x3b, 0x63, 0x51, 0xcc, 0x1c, 0x79, 0x2b, 0xcb, 0x23,
+	0xce, 0x6c, 0x1f, 0xdd, 0xf3, 0x42, 0x1e, 0x33, 0x2f, 0x11, 0xfe, 0x19, 0x73, 0x39, 0x1b, 0x50,
+	0xf8, 0x31, 0x66, 0x91, 0x1f, 0xf6, 0x63, 0xcd, 0xfb, 0x3f, 0x9c, 0x4e, 0xda, 0xdf, 0x57, 0x11,
+	0xfe, 0x97, 0x37, 0x26, 0x3b, 0x86, 0xf9, 0x58, 0x5b, 0x3f, 0x54, 0x46, 0x79, 0x36, 0xfc, 0xd8,
+	0x55, 0x33, 0x02, 0xf5, 0xa4, 0x05, 0xfa, 0x66, 0xd5, 0x3c, 0x1b, 0x73, 0x0e, 0x98, 0xd4, 0xfd,
+	0x18, 0xb2, 0xdd, 0x07, 0xd9, 0xfe, 0x14, 0xad, 0x7b, 0x49, 0x14, 0xc9, 0x1b, 0x2d, 0x9b, 0x5a,
+	0x54, 0x03, 0xbd, 0x5f, 0x78, 0x43, 0xf4, 0x0b, 0x6d, 0x01, 0x10, 0x93, 0x5b, 0x5a, 0x97, 0x4d,
+	0x2e, 0x87, 0xe8, 0x16, 0x1c, 0x36, 0x6f, 0xc8, 0xbc, 0xd3, 0xdc, 0xc9, 0xbe, 0x3b, 0x9d, 0xb4,
+	0xef, 0x18, 0xc7, 0xd1, 0xf0, 0xc0, 0x04, 0x9e, 0xb6, 0x07, 0x52, 0xa3, 0xce, 0xf6, 0x58, 0xf3,
+	0x03, 0x67, 0xc2, 0x8d, 0x93, 0xf1, 0x78, 0x74, 0xd1, 0xbc, 0x59, 0x78, 0xe6, 0x52, 0xed, 0x6f,
+	0xb2, 0x49, 0x06, 0xa7, 0xd9, 0xe4, 0x98, 0x89, 0x8f, 0x94, 0xfc, 0xaf, 0x32, 0xaa, 0x3d, 0xe1,
+	0x5e, 0x18, 0x30, 0x27, 0x39, 0x39, 0x61, 0x91, 0xfd, 0x02, 0xad, 0xf4, 0xe8, 0x88, 0x72, 0x8f,
+	0x69, 0x3e, 0x7f, 0xb7, 0x70, 0xe8, 0x86, 0x6e, 0x36, 0x05, 0x83, 0x49, 0x0a, 0x28, 0xb7, 0x47,
+	0x91, 0xbc, 0x17, 0x72, 0x11, 0xf9, 0xbd, 0x24, 0xa3, 0xf2, 0xf7, 0x0b, 0x47, 0x69, 0x9a, 0xb7,
+	0x86, 0x01, 0x88, 0xc9, 0x2d, 0xd0, 0x1d, 0x64, 0x2a, 0x9b, 0xa3, 0x86, 0xf2, 0x8b, 0xd8, 0x88,
+	0xd1, 0x98, 0xf5, 0x9b, 0xa5, 0xc2, 0xa7, 0x54, 0x45, 0xdd, 0xca, 0x3d, 0x52, 0x35, 0x9a, 0x64,
+	0x05, 0xa9, 0x20, 0x5a, 0xb6, 0x5f, 0xe8, 0x2b, 0x64, 0x96, 0x96, 0x1f, 0x72, 0xc5, 0xc1, 0x7a,
+	0x2a, 0x98, 0xbf, 0x42, 0x16, 0x1d, 0x31, 0xd9, 0x82, 0xe6, 0x30, 0x0c, 0xc0, 0xd6, 0xf6, 0x07,
+	0xc8, 0x86, 0x25, 0x3a, 0xb8, 0x86, 0x55, 0xdc, 0x7f, 0x7f, 0x3a, 0x69, 0x6f, 0x1b, 0xb0, 0x39,
+	0x1f, 0x4c, 0xa0, 0x47, 0x75, 0x92, 0x0a, 0xec, 0xd7, 0x08, 0xee, 0x02, 0x75, 0x9e, 0x7c, 0x71,
+	0xe1, 0x8e, 0xd8, 0x19, 0x1b, 0xe9, 0x47, 0xcd, 0xd3, 0xc2, 0x47, 0x66, 0xc7, 0x88, 0x9d, 0x87,
+	0xc4, 0x04, 0xbe, 0x86, 0xec, 0x6b, 0xe5, 0x53, 0xd0, 0xfd, 0xc1, 0x42, 0x75, 0xc8, 0xe3, 0x48,
+	0x73, 0xdc, 0xc2, 0xcd, 0x61, 0x15, 0xb8, 0x39, 0x3a, 0x68, 0x55, 0x91, 0xa7, 0x6e, 0xaa, 0xb2,
+	0xb3, 0x31, 0x9d, 0xb4, 0xd7, 0x4c, 0x5a, 0x95, 0x5b, 0xb5, 0x02, 0x84, 0xca, 0xfa, 0x72, 0x08,
+	0x92, 0xda, 0x91, 0x1f, 0xf8, 0xa2, 0x59, 0x9a, 0x1f, 0x82, 0x66, 0x26, 0x4c, 0x24, 0xec, 0x53,
+	0xf8, 0xf9, 0x67, 0x0b, 0x35, 0xd2, 0x5c, 0x7f, 0xee, 0xf3, 0x7e, 0xf8, 0xa9, 0xfd, 0x93, 0xb4,
+	0xb5, 0x66, 0xb1, 0xd5, 0x4d, 0xb9, 0x3d, 0xdf, 0x2c, 0x59, 0x06, 0x6a, 0x6a, 0x3a, 0xd2, 0x69,
+	0x38, 0x68, 0x2d, 0x73, 0x50, 0xc9, 0x2c, 0xcd, 0xcf, 0x04, 0x73, 0x0e, 0x69, 0xbf, 0x1d, 0xe9,
+	0xbc, 0xe4, 0x20, 0xa8, 0x0a, 0xe3, 0xcd, 0xae, 0xcc, 0xdc, 0x20, 0x68, 0x18, 0x31, 0x51, 0x37,
+	0xc8, 0x01, 0x08, 0xff, 0x28, 0xa1, 0x3a, 0x50, 0x8f, 0xb9, 0x03, 0x57, 0xbe, 0xf7, 0x17, 0x6b,
+	0xb1, 0x74, 0xed, 0x5a, 0x94, 0xae, 0x59, 0x8b, 0xf2, 0x9b, 0xd6, 0x42, 0x92, 0xef, 0x98, 0xd1,
+	0xd3, 0xc5, 0xbb, 0xfc, 0xca, 0x0f, 0xde, 0x39, 0x38, 0x4c, 0xea, 0x52, 0x93, 0xdd, 0xe6, 0xf3,
+	0x2f, 0x86, 0xe5, 0x2b, 0xbf, 0x18, 0x56, 0xde, 0xec, 0xc5, 0x80, 0xff, 0x62, 0xa1, 0xc6, 0x61,
+	0xc0, 0xa2, 0x01, 0xe3, 0xde, 0x85, 0xba, 0xe2, 0x65, 0xbd, 0xe4, 0x57, 0x95, 0x31, 0x9d, 0x75,
+	0xef, 0x6a, 0xae, 0x5e, 0x99, 0x51, 0xd6, 0x2b, 0x89, 0xf8, 0x87, 0x20, 0xc8, 0x85, 0x30, 0x5b,
+	0x8e, 0xe9, 0x6c, 0xab, 0x73, 0x0b, 0x0d, 0xa3, 0x7c, 0x02, 0xfa, 0x5c, 0xe8, 0x85, 0xc7, 0x9a,
+	0x74, 0x92, 0x71, 0x9f, 0x0a, 0x96, 0xe6, 0x09, 0x3b, 0x5d, 0x72, 0x5a, 0x73, 0x34, 0x92, 0x77,
+	0xd2, 0x34, 0xf2, 0xb1, 0x52, 0xaa, 0x3f, 0xca, 0xf9, 0xe0, 0xcb, 0x97, 0x2d, 0xeb, 0xab, 0x97,
+	0x2d, 0xeb, 0xef, 0x2f, 0x5b, 0xd6, 0xe7, 0xaf, 0x5a, 0x37, 0xbe, 0x7a, 0xd5, 0xba, 0xf1, 0xd7,
+	0x57, 0xad, 0x1b, 0x2f, 0x1e, 0x18, 0x3b, 0x16, 0x33, 0xff, 0xad, 0xf4, 0xab, 0x39, 0x08, 0xf0,
+	0xd9, 0xbc, 0x7b, 0xde, 0x4d, 0xbf, 0xb0, 0xc3, 0x06, 0xf6, 0x96, 0xc1, 0xe7, 0x47, 0xff, 0x19,
+	0x00, 0x48, 0xee, 0xd1, 0x20, 0x79, 0x17, 0x00, 0x00,
 }
 
 func (m *BurnStats) Marshal() (dAtA []byte, err error) {
@@ -609,6 +998,44 @@ func (m *BurnStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.TotalAexEquivalentBurned.Size()
+		i -= size
+		if _, err := m.TotalAexEquivalentBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBurn(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.TotalRouted) > 0 {
+		for iNdEx := len(m.TotalRouted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalRouted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBurn(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.TotalBurnedOtherDenoms) > 0 {
+		for iNdEx := len(m.TotalBurnedOtherDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalBurnedOtherDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBurn(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.LastBlockHeight != 0 {
 		i = encodeVarintBurn(dAtA, i, uint64(m.LastBlockHeight))
 		i--
@@ -662,6 +1089,64 @@ func (m *BurnRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.AexEquivalentBurned.Size()
+		i -= size
+		if _, err := m.AexEquivalentBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBurn(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.Routed) > 0 {
+		for iNdEx := len(m.Routed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBurn(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.BurnedOtherDenoms) > 0 {
+		for iNdEx := len(m.BurnedOtherDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnedOtherDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBurn(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size := m.BrakeReduction.Size()
+		i -= size
+		if _, err := m.BrakeReduction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBurn(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.SmoothingAdjustment.Size()
+		i -= size
+		if _, err := m.SmoothingAdjustment.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBurn(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	{
 		size := m.TotalFees.Size()
 		i -= size
@@ -861,6 +1346,14 @@ func (m *MintRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintBurn(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
 	{
 		size := m.GasUsageRate.Size()
 		i -= size
@@ -1037,23 +1530,210 @@ func (m *IncomeBuffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintBurn(dAtA []byte, offset int, v uint64) int {
-	offset -= sovBurn(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *BlockGasUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *BurnStats) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+
+func (m *BlockGasUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockGasUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintBurn(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintBurn(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintBurn(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasUsageWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasUsageWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasUsageWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockCount != 0 {
+		i = encodeVarintBurn(dAtA, i, uint64(m.BlockCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalGasLimit != 0 {
+		i = encodeVarintBurn(dAtA, i, uint64(m.TotalGasLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TotalGasUsed != 0 {
+		i = encodeVarintBurn(dAtA, i, uint64(m.TotalGasUsed))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochGasUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochGasUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochGasUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintBurn(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintBurn(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.PeakUsageRate.Size()
+		i -= size
+		if _, err := m.PeakUsageRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBurn(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.BlockCount != 0 {
+		i = encodeVarintBurn(dAtA, i, uint64(m.BlockCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalGasLimit != 0 {
+		i = encodeVarintBurn(dAtA, i, uint64(m.TotalGasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalGasUsed != 0 {
+		i = encodeVarintBurn(dAtA, i, uint64(m.TotalGasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintBurn(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmergencyState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmergencyState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmergencyState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastUpdatedHeight != 0 {
+		i = encodeVarintBurn(dAtA, i, uint64(m.LastUpdatedHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MintPaused {
+		i--
+		if m.MintPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BurnPaused {
+		i--
+		if m.BurnPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBurn(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBurn(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BurnStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	l = m.TotalBurned.Size()
 	n += 1 + l + sovBurn(uint64(l))
 	l = m.LastBurnRate.Size()
@@ -1064,6 +1744,20 @@ func (m *BurnStats) Size() (n int) {
 	if m.LastBlockHeight != 0 {
 		n += 1 + sovBurn(uint64(m.LastBlockHeight))
 	}
+	if len(m.TotalBurnedOtherDenoms) > 0 {
+		for _, e := range m.TotalBurnedOtherDenoms {
+			l = e.Size()
+			n += 1 + l + sovBurn(uint64(l))
+		}
+	}
+	if len(m.TotalRouted) > 0 {
+		for _, e := range m.TotalRouted {
+			l = e.Size()
+			n += 1 + l + sovBurn(uint64(l))
+		}
+	}
+	l = m.TotalAexEquivalentBurned.Size()
+	n += 1 + l + sovBurn(uint64(l))
 	return n
 }
 
@@ -1087,6 +1781,24 @@ func (m *BurnRecord) Size() (n int) {
 	n += 1 + l + sovBurn(uint64(l))
 	l = m.TotalFees.Size()
 	n += 1 + l + sovBurn(uint64(l))
+	l = m.SmoothingAdjustment.Size()
+	n += 1 + l + sovBurn(uint64(l))
+	l = m.BrakeReduction.Size()
+	n += 1 + l + sovBurn(uint64(l))
+	if len(m.BurnedOtherDenoms) > 0 {
+		for _, e := range m.BurnedOtherDenoms {
+			l = e.Size()
+			n += 1 + l + sovBurn(uint64(l))
+		}
+	}
+	if len(m.Routed) > 0 {
+		for _, e := range m.Routed {
+			l = e.Size()
+			n += 1 + l + sovBurn(uint64(l))
+		}
+	}
+	l = m.AexEquivalentBurned.Size()
+	n += 1 + l + sovBurn(uint64(l))
 	return n
 }
 
@@ -1160,6 +1872,8 @@ func (m *MintRecord) Size() (n int) {
 	}
 	l = m.GasUsageRate.Size()
 	n += 1 + l + sovBurn(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovBurn(uint64(l))
 	return n
 }
 
@@ -1208,6 +1922,89 @@ func (m *IncomeBuffer) Size() (n int) {
 	return n
 }
 
+func (m *BlockGasUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovBurn(uint64(m.BlockHeight))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovBurn(uint64(m.GasUsed))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovBurn(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *GasUsageWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalGasUsed != 0 {
+		n += 1 + sovBurn(uint64(m.TotalGasUsed))
+	}
+	if m.TotalGasLimit != 0 {
+		n += 1 + sovBurn(uint64(m.TotalGasLimit))
+	}
+	if m.BlockCount != 0 {
+		n += 1 + sovBurn(uint64(m.BlockCount))
+	}
+	return n
+}
+
+func (m *EpochGasUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovBurn(uint64(m.EpochNumber))
+	}
+	if m.TotalGasUsed != 0 {
+		n += 1 + sovBurn(uint64(m.TotalGasUsed))
+	}
+	if m.TotalGasLimit != 0 {
+		n += 1 + sovBurn(uint64(m.TotalGasLimit))
+	}
+	if m.BlockCount != 0 {
+		n += 1 + sovBurn(uint64(m.BlockCount))
+	}
+	l = m.PeakUsageRate.Size()
+	n += 1 + l + sovBurn(uint64(l))
+	if m.StartHeight != 0 {
+		n += 1 + sovBurn(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovBurn(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *EmergencyState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BurnPaused {
+		n += 2
+	}
+	if m.MintPaused {
+		n += 2
+	}
+	if m.LastUpdatedHeight != 0 {
+		n += 1 + sovBurn(uint64(m.LastUpdatedHeight))
+	}
+	return n
+}
+
 func sovBurn(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1349,17 +2146,119 @@ func (m *BurnStats) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBurn(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurnedOtherDenoms", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthBurn
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBurn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalBurnedOtherDenoms = append(m.TotalBurnedOtherDenoms, types.Coin{})
+			if err := m.TotalBurnedOtherDenoms[len(m.TotalBurnedOtherDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRouted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBurn
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBurn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalRouted = append(m.TotalRouted, types.Coin{})
+			if err := m.TotalRouted[len(m.TotalRouted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAexEquivalentBurned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBurn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBurn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalAexEquivalentBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBurn(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBurn
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -1573,6 +2472,176 @@ func (m *BurnRecord) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SmoothingAdjustment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBurn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBurn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SmoothingAdjustment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BrakeReduction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBurn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBurn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BrakeReduction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedOtherDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBurn
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBurn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnedOtherDenoms = append(m.BurnedOtherDenoms, types.Coin{})
+			if err := m.BurnedOtherDenoms[len(m.BurnedOtherDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBurn
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBurn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routed = append(m.Routed, types.Coin{})
+			if err := m.Routed[len(m.Routed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AexEquivalentBurned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBurn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBurn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AexEquivalentBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBurn(dAtA[iNdEx:])
@@ -2149,6 +3218,39 @@ func (m *MintRecord) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBurn
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBurn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBurn(dAtA[iNdEx:])
@@ -2570,6 +3672,527 @@ func (m *IncomeBuffer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BlockGasUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBurn
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockGasUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockGasUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBurn(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBurn
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasUsageWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBurn
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasUsageWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasUsageWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalGasUsed", wireType)
+			}
+			m.TotalGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalGasLimit", wireType)
+			}
+			m.TotalGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockCount", wireType)
+			}
+			m.BlockCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBurn(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBurn
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochGasUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBurn
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochGasUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochGasUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalGasUsed", wireType)
+			}
+			m.TotalGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalGasLimit", wireType)
+			}
+			m.TotalGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockCount", wireType)
+			}
+			m.BlockCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeakUsageRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBurn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBurn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PeakUsageRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBurn(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBurn
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmergencyState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBurn
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmergencyState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmergencyState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnPaused = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MintPaused = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedHeight", wireType)
+			}
+			m.LastUpdatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBurn(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBurn
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBurn(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,19 @@
+package types
+
+import "time"
+
+const (
+	// AnnualInflationWindow is the rolling window the annual inflation cap applies to
+	AnnualInflationWindow = 365 * 24 * time.Hour
+
+	// NetSupplyWindowMonths is the number of calendar months, including the current
+	// one, the net supply cap applies to
+	NetSupplyWindowMonths = 12
+)
+
+// CalendarMonthIndex returns the UTC calendar month of t as year * 12 + month - 1,
+// so that consecutive months have consecutive indexes across years
+func CalendarMonthIndex(t time.Time) uint32 {
+	t = t.UTC()
+	return uint32(t.Year()*12 + int(t.Month()) - 1)
+}
@@ -3,22 +3,42 @@ package types
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
-	// Register any legacy amino types here if needed
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "aexburn/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgSetEmergencyPause{}, "aexburn/MsgSetEmergencyPause", nil)
+	cdc.RegisterConcrete(&MsgDrainIncomeBuffer{}, "aexburn/MsgDrainIncomeBuffer", nil)
+	cdc.RegisterConcrete(&MsgResetIncomeBuffer{}, "aexburn/MsgResetIncomeBuffer", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
-	// Register any interface types here if needed
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&UpdateParamsProposal{},
+		&SetEmergencyPauseProposal{},
+		&DrainIncomeBufferProposal{},
+		&ResetIncomeBufferProposal{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdateParams{},
+		&MsgSetEmergencyPause{},
+		&MsgDrainIncomeBuffer{},
+		&MsgResetIncomeBuffer{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
 var (
 	Amino     = codec.NewLegacyAmino()
-	ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+	ModuleCdc = codec.NewAminoCodec(Amino)
 )
 
 func init() {
 	RegisterCodec(Amino)
+	sdk.RegisterLegacyAminoCodec(Amino)
+	Amino.Seal()
 }
-
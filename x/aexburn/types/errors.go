@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/aexburn module sentinel errors
+var (
+	ErrInvalidAuthority  = sdkerrors.Register(ModuleName, 2, "invalid authority")
+	ErrInvalidParams     = sdkerrors.Register(ModuleName, 3, "invalid params")
+	ErrEmptyIncomeBuffer = sdkerrors.Register(ModuleName, 4, "income buffer is empty")
+)
@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CalculateUsageRate returns gasUsed / gasLimit as a decimal capped at 1.0
+func CalculateUsageRate(gasUsed uint64, gasLimit uint64) sdk.Dec {
+	if gasLimit == 0 {
+		return sdk.ZeroDec()
+	}
+
+	usageRate := sdk.NewDecFromInt(sdk.NewIntFromUint64(gasUsed)).Quo(
+		sdk.NewDecFromInt(sdk.NewIntFromUint64(gasLimit)),
+	)
+
+	// Cap at 1.0
+	if usageRate.GT(sdk.OneDec()) {
+		usageRate = sdk.OneDec()
+	}
+
+	return usageRate
+}
+
+// UsageRate returns the gas usage rate of the block
+func (u BlockGasUsage) UsageRate() sdk.Dec {
+	return CalculateUsageRate(u.GasUsed, u.GasLimit)
+}
+
+// UsageRate returns the average gas usage rate over the window
+func (w GasUsageWindow) UsageRate() sdk.Dec {
+	return CalculateUsageRate(w.TotalGasUsed, w.TotalGasLimit)
+}
+
+// NewEpochGasUsage returns an empty epoch gas usage accumulator
+func NewEpochGasUsage() EpochGasUsage {
+	return EpochGasUsage{
+		PeakUsageRate: sdk.ZeroDec(),
+	}
+}
+
+// AverageUsageRate returns the average gas usage rate over the blocks of the epoch
+func (u EpochGasUsage) AverageUsageRate() sdk.Dec {
+	return CalculateUsageRate(u.TotalGasUsed, u.TotalGasLimit)
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -9,10 +11,11 @@ func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		Params: DefaultParams(),
 		BurnStats: BurnStats{
-			TotalBurned:     sdk.ZeroInt(),
-			LastBurnRate:    sdk.ZeroDec(),
-			LastEpochNumber: 0,
-			LastBlockHeight: 0,
+			TotalBurned:              sdk.ZeroInt(),
+			LastBurnRate:             sdk.ZeroDec(),
+			LastEpochNumber:          0,
+			LastBlockHeight:          0,
+			TotalAexEquivalentBurned: sdk.ZeroInt(),
 		},
 		InflationStats: InflationStats{
 			TotalMinted:          sdk.ZeroInt(),
@@ -21,7 +24,13 @@ func DefaultGenesis() *GenesisState {
 			LastMintEpoch:        0,
 			LastMintBlockHeight:  0,
 		},
-		MonthlyBurnData: make([]MonthlyBurnData, 0),
+		MonthlyBurnData:      make([]MonthlyBurnData, 0),
+		BlockGasUsages:       make([]BlockGasUsage, 0),
+		CurrentEpochGasUsage: NewEpochGasUsage(),
+		EpochGasUsages:       make([]EpochGasUsage, 0),
+		EmergencyState:       EmergencyState{},
+		BurnRecords:          make([]BurnRecord, 0),
+		MintRecords:          make([]MintRecord, 0),
 	}
 }
 
@@ -30,6 +39,45 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+
+	seenHeights := make(map[int64]bool, len(gs.BlockGasUsages))
+	for _, usage := range gs.BlockGasUsages {
+		if seenHeights[usage.BlockHeight] {
+			return fmt.Errorf("duplicate block gas usage for height %d", usage.BlockHeight)
+		}
+		seenHeights[usage.BlockHeight] = true
+	}
+
+	seenEpochs := make(map[uint64]bool, len(gs.EpochGasUsages))
+	for _, usage := range gs.EpochGasUsages {
+		if seenEpochs[usage.EpochNumber] {
+			return fmt.Errorf("duplicate epoch gas usage for epoch %d", usage.EpochNumber)
+		}
+		seenEpochs[usage.EpochNumber] = true
+	}
+
+	seenBurnHeights := make(map[int64]bool, len(gs.BurnRecords))
+	for _, record := range gs.BurnRecords {
+		if seenBurnHeights[record.BlockHeight] {
+			return fmt.Errorf("duplicate burn record for height %d", record.BlockHeight)
+		}
+		seenBurnHeights[record.BlockHeight] = true
+	}
+
+	seenMonths := make(map[uint32]bool, len(gs.MonthlyBurnData))
+	for _, data := range gs.MonthlyBurnData {
+		if seenMonths[data.MonthIndex] {
+			return fmt.Errorf("duplicate monthly burn data for month %d", data.MonthIndex)
+		}
+		seenMonths[data.MonthIndex] = true
+	}
+
+	seenMintEpochs := make(map[uint64]bool, len(gs.MintRecords))
+	for _, record := range gs.MintRecords {
+		if seenMintEpochs[record.EpochNumber] {
+			return fmt.Errorf("duplicate mint record for epoch %d", record.EpochNumber)
+		}
+		seenMintEpochs[record.EpochNumber] = true
+	}
 	return nil
 }
-
@@ -31,12 +31,25 @@ type GenesisState struct {
 	BurnStats BurnStats `protobuf:"bytes,2,opt,name=burn_stats,json=burnStats,proto3" json:"burn_stats"`
 	// inflation_stats contains the cumulative inflation statistics
 	InflationStats InflationStats `protobuf:"bytes,3,opt,name=inflation_stats,json=inflationStats,proto3" json:"inflation_stats"`
-	// monthly_burn_data contains the 12-month rolling window supply data (burn + mint)
+	// monthly_burn_data contains the calendar month supply data (burn + mint) of the 12-month window
 	MonthlyBurnData []MonthlyBurnData `protobuf:"bytes,4,rep,name=monthly_burn_data,json=monthlyBurnData,proto3" json:"monthly_burn_data"`
 	// reverse_brake_state contains the reverse brake mechanism state
 	ReverseBrakeState ReverseBrakeState `protobuf:"bytes,5,opt,name=reverse_brake_state,json=reverseBrakeState,proto3" json:"reverse_brake_state"`
 	// income_buffer contains the validator income smoothing buffer state
 	IncomeBuffer IncomeBuffer `protobuf:"bytes,6,opt,name=income_buffer,json=incomeBuffer,proto3" json:"income_buffer"`
+	// block_gas_usages contains the block gas usage samples in the rolling window
+	BlockGasUsages []BlockGasUsage `protobuf:"bytes,7,rep,name=block_gas_usages,json=blockGasUsages,proto3" json:"block_gas_usages"`
+	// current_epoch_gas_usage contains the gas usage accumulated so far in the current epoch
+	CurrentEpochGasUsage EpochGasUsage `protobuf:"bytes,8,opt,name=current_epoch_gas_usage,json=currentEpochGasUsage,proto3" json:"current_epoch_gas_usage"`
+	// epoch_gas_usages contains the gas usage of completed epochs
+	EpochGasUsages []EpochGasUsage `protobuf:"bytes,9,rep,name=epoch_gas_usages,json=epochGasUsages,proto3" json:"epoch_gas_usages"`
+	// emergency_state contains the governance emergency controls
+	EmergencyState EmergencyState `protobuf:"bytes,10,opt,name=emergency_state,json=emergencyState,proto3" json:"emergency_state"`
+	// burn_records contains the per-block burn records within the retention window
+	BurnRecords []BurnRecord `protobuf:"bytes,11,rep,name=burn_records,json=burnRecords,proto3" json:"burn_records"`
+	// mint_records contains the inflation mint history, which the rolling annual
+	// inflation cap is computed from
+	MintRecords []MintRecord `protobuf:"bytes,12,rep,name=mint_records,json=mintRecords,proto3" json:"mint_records"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -114,6 +127,48 @@ func (m *GenesisState) GetIncomeBuffer() IncomeBuffer {
 	return IncomeBuffer{}
 }
 
+func (m *GenesisState) GetBlockGasUsages() []BlockGasUsage {
+	if m != nil {
+		return m.BlockGasUsages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCurrentEpochGasUsage() EpochGasUsage {
+	if m != nil {
+		return m.CurrentEpochGasUsage
+	}
+	return EpochGasUsage{}
+}
+
+func (m *GenesisState) GetEpochGasUsages() []EpochGasUsage {
+	if m != nil {
+		return m.EpochGasUsages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEmergencyState() EmergencyState {
+	if m != nil {
+		return m.EmergencyState
+	}
+	return EmergencyState{}
+}
+
+func (m *GenesisState) GetBurnRecords() []BurnRecord {
+	if m != nil {
+		return m.BurnRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMintRecords() []MintRecord {
+	if m != nil {
+		return m.MintRecords
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "seiprotocol.seichain.aexburn.GenesisState")
 }
@@ -121,31 +176,40 @@ func init() {
 func init() { proto.RegisterFile("aexburn/genesis.proto", fileDescriptor_d84f32a34bde1e20) }
 
 var fileDescriptor_d84f32a34bde1e20 = []byte{
-	// 381 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xc1, 0x4a, 0xeb, 0x40,
-	0x14, 0x86, 0x93, 0xdb, 0xde, 0xc2, 0x9d, 0xf6, 0x5a, 0x3a, 0x56, 0x08, 0x45, 0x62, 0x11, 0xc1,
-	0x22, 0x36, 0xc1, 0xfa, 0x06, 0x41, 0x10, 0x51, 0x41, 0x2a, 0x6e, 0x74, 0x11, 0x26, 0xf1, 0x34,
-	0x1d, 0x6c, 0x32, 0x65, 0x66, 0x22, 0xed, 0x5b, 0xf8, 0x00, 0x3e, 0x50, 0x97, 0x5d, 0xba, 0x12,
-	0x69, 0x5f, 0x44, 0x32, 0xc9, 0x40, 0xeb, 0x22, 0x6e, 0xc2, 0xe4, 0x3f, 0xe7, 0xff, 0xfe, 0x73,
-	0x92, 0x41, 0x7b, 0x04, 0x66, 0x41, 0xca, 0x13, 0x37, 0x82, 0x04, 0x04, 0x15, 0xce, 0x94, 0x33,
-	0xc9, 0xf0, 0xbe, 0x00, 0xaa, 0x4e, 0x21, 0x9b, 0x38, 0x02, 0x68, 0x38, 0x26, 0x34, 0x71, 0x8a,
-	0xde, 0x4e, 0x3b, 0x62, 0x11, 0x53, 0x65, 0x37, 0x3b, 0xe5, 0x9e, 0x4e, 0x5b, 0xa3, 0xa6, 0x84,
-	0x93, 0xb8, 0x20, 0x75, 0xb0, 0x56, 0xb3, 0x47, 0xae, 0x1d, 0xbe, 0x57, 0x51, 0xe3, 0x32, 0xcf,
-	0xbb, 0x97, 0x44, 0x02, 0xf6, 0x50, 0x2d, 0x37, 0x59, 0x66, 0xd7, 0xec, 0xd5, 0x07, 0x47, 0x4e,
-	0x59, 0xbe, 0x73, 0xa7, 0x7a, 0xbd, 0xea, 0xe2, 0xf3, 0xc0, 0x18, 0x16, 0x4e, 0x7c, 0x83, 0x50,
-	0x56, 0xf4, 0x85, 0x24, 0x52, 0x58, 0x7f, 0x14, 0xe7, 0xb8, 0x9c, 0xe3, 0xa5, 0x3c, 0xc9, 0x06,
-	0xd0, 0xa8, 0x7f, 0x81, 0x16, 0xf0, 0x13, 0x6a, 0xd2, 0x64, 0x34, 0x21, 0x92, 0x32, 0x8d, 0xac,
-	0x28, 0xe4, 0x69, 0x39, 0xf2, 0x4a, 0x9b, 0x36, 0xb9, 0x3b, 0x74, 0x4b, 0xc5, 0x3e, 0x6a, 0xc5,
-	0x2c, 0x91, 0xe3, 0xc9, 0xdc, 0x57, 0x23, 0x3f, 0x13, 0x49, 0xac, 0x6a, 0xb7, 0xd2, 0xab, 0x0f,
-	0xfa, 0xe5, 0xf8, 0xdb, 0xdc, 0x96, 0x0d, 0x7e, 0x41, 0x24, 0x29, 0xf8, 0xcd, 0x78, 0x5b, 0xc6,
-	0x80, 0x76, 0x39, 0xbc, 0x02, 0x17, 0xe0, 0x07, 0x9c, 0xbc, 0x80, 0xda, 0x00, 0xac, 0xbf, 0x6a,
-	0x03, 0xb7, 0x3c, 0x62, 0x98, 0x1b, 0xbd, 0xcc, 0xa7, 0xfe, 0x4e, 0x11, 0xd2, 0xe2, 0x3f, 0x0b,
-	0xf8, 0x01, 0xfd, 0xa7, 0x49, 0xc8, 0x62, 0xf0, 0x83, 0x74, 0x34, 0x02, 0x6e, 0xd5, 0x54, 0xc0,
-	0xc9, 0x6f, 0x9f, 0x28, 0xb3, 0x78, 0xca, 0x51, 0xb0, 0x1b, 0x74, 0x53, 0xbb, 0x5e, 0xac, 0x6c,
-	0x73, 0xb9, 0xb2, 0xcd, 0xaf, 0x95, 0x6d, 0xbe, 0xad, 0x6d, 0x63, 0xb9, 0xb6, 0x8d, 0x8f, 0xb5,
-	0x6d, 0x3c, 0x9e, 0x45, 0x54, 0x8e, 0xd3, 0xc0, 0x09, 0x59, 0xec, 0x0a, 0xa0, 0x7d, 0x1d, 0xa2,
-	0x5e, 0x54, 0x8a, 0x3b, 0x73, 0xf5, 0x85, 0x93, 0xf3, 0x29, 0x88, 0xa0, 0xa6, 0x7a, 0xce, 0xbf,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0xa1, 0xcc, 0xd2, 0x8b, 0xe9, 0x02, 0x00, 0x00,
+	// 526 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xd1, 0x6e, 0xd3, 0x30,
+	0x14, 0x86, 0x1b, 0x36, 0xca, 0xe6, 0x96, 0x8e, 0x99, 0x22, 0xa2, 0x0a, 0x85, 0x0a, 0x21, 0x51,
+	0x01, 0x4b, 0xc4, 0x78, 0x83, 0x88, 0x69, 0x42, 0x30, 0x09, 0x8a, 0x76, 0xc3, 0x2e, 0x22, 0x27,
+	0x3b, 0x4d, 0xad, 0x35, 0x76, 0x65, 0x3b, 0x68, 0x7d, 0x0b, 0xde, 0x89, 0x9b, 0x5d, 0xee, 0x92,
+	0x2b, 0x84, 0xda, 0x17, 0x41, 0x76, 0xe2, 0xd2, 0x54, 0x53, 0xda, 0x9b, 0xca, 0xfd, 0xed, 0xff,
+	0xfb, 0xcf, 0xf1, 0x71, 0x8b, 0x9e, 0x10, 0xb8, 0x8e, 0x73, 0xc1, 0x82, 0x14, 0x18, 0x48, 0x2a,
+	0xfd, 0xa9, 0xe0, 0x8a, 0xe3, 0x67, 0x12, 0xa8, 0x59, 0x25, 0x7c, 0xe2, 0x4b, 0xa0, 0xc9, 0x98,
+	0x50, 0xe6, 0x97, 0x67, 0x7b, 0xdd, 0x94, 0xa7, 0xdc, 0x6c, 0x07, 0x7a, 0x55, 0x78, 0x7a, 0x5d,
+	0x8b, 0x9a, 0x12, 0x41, 0xb2, 0x92, 0xd4, 0xc3, 0x56, 0xd5, 0x1f, 0x85, 0xf6, 0xe2, 0xd7, 0x1e,
+	0x6a, 0x9f, 0x16, 0x79, 0xdf, 0x14, 0x51, 0x80, 0x43, 0xd4, 0x2c, 0x4c, 0xae, 0xd3, 0x77, 0x06,
+	0xad, 0xe3, 0x97, 0x7e, 0x5d, 0xbe, 0xff, 0xc5, 0x9c, 0x0d, 0x77, 0x6f, 0xfe, 0x3c, 0x6f, 0x0c,
+	0x4b, 0x27, 0xfe, 0x8c, 0x90, 0xde, 0x8c, 0xa4, 0x22, 0x4a, 0xba, 0xf7, 0x0c, 0xe7, 0x55, 0x3d,
+	0x27, 0xcc, 0x05, 0xd3, 0x05, 0x58, 0xd4, 0x7e, 0x6c, 0x05, 0x7c, 0x81, 0x0e, 0x28, 0x1b, 0x4d,
+	0x88, 0xa2, 0xdc, 0x22, 0x77, 0x0c, 0xf2, 0x6d, 0x3d, 0xf2, 0xa3, 0x35, 0xad, 0x72, 0x3b, 0xb4,
+	0xa2, 0xe2, 0x08, 0x1d, 0x66, 0x9c, 0xa9, 0xf1, 0x64, 0x16, 0x99, 0x92, 0x2f, 0x89, 0x22, 0xee,
+	0x6e, 0x7f, 0x67, 0xd0, 0x3a, 0x3e, 0xaa, 0xc7, 0x9f, 0x15, 0x36, 0x5d, 0xf8, 0x07, 0xa2, 0x48,
+	0xc9, 0x3f, 0xc8, 0xaa, 0x32, 0x06, 0xf4, 0x58, 0xc0, 0x0f, 0x10, 0x12, 0xa2, 0x58, 0x90, 0x2b,
+	0x30, 0x1d, 0x80, 0x7b, 0xdf, 0x74, 0x10, 0xd4, 0x47, 0x0c, 0x0b, 0x63, 0xa8, 0x7d, 0x66, 0x3a,
+	0x65, 0xc8, 0xa1, 0x58, 0xdf, 0xc0, 0xe7, 0xe8, 0x21, 0x65, 0x09, 0xcf, 0x20, 0x8a, 0xf3, 0xd1,
+	0x08, 0x84, 0xdb, 0x34, 0x01, 0xaf, 0x37, 0x5d, 0x91, 0xb6, 0x84, 0xc6, 0x51, 0xb2, 0xdb, 0x74,
+	0x45, 0xc3, 0x17, 0xe8, 0x51, 0x3c, 0xe1, 0xc9, 0x55, 0x94, 0x12, 0x19, 0xe5, 0x92, 0xa4, 0x20,
+	0xdd, 0x07, 0xe6, 0x76, 0xde, 0x6c, 0x98, 0xa7, 0x76, 0x9d, 0x12, 0x79, 0xae, 0x3d, 0xf6, 0xee,
+	0xe3, 0x55, 0x51, 0xe2, 0x31, 0x7a, 0x9a, 0xe4, 0x42, 0x00, 0x53, 0x11, 0x4c, 0x79, 0x32, 0xfe,
+	0x1f, 0xe2, 0xee, 0xf5, 0x9d, 0xcd, 0x19, 0x27, 0xda, 0xb4, 0x96, 0xd1, 0x2d, 0x89, 0x95, 0x3d,
+	0xdd, 0xc6, 0x5a, 0x82, 0x74, 0xf7, 0xb7, 0x69, 0xe3, 0xae, 0x88, 0x0e, 0xac, 0x8a, 0xe6, 0x7d,
+	0x42, 0x06, 0x22, 0x05, 0x96, 0xcc, 0xca, 0xe9, 0xa2, 0x6d, 0xde, 0xe7, 0x89, 0x35, 0xad, 0x8e,
+	0xb6, 0x03, 0x15, 0x15, 0x7f, 0x45, 0x6d, 0xf3, 0x2e, 0x05, 0x24, 0x5c, 0x5c, 0x4a, 0xb7, 0x65,
+	0xaa, 0x1e, 0x6c, 0xfe, 0x31, 0x0d, 0x8d, 0xa1, 0xa4, 0xb6, 0xe2, 0xa5, 0x22, 0x35, 0x32, 0xa3,
+	0x4c, 0x2d, 0x91, 0xed, 0x6d, 0x90, 0x67, 0x94, 0xa9, 0x2a, 0x32, 0x5b, 0x2a, 0x32, 0xfc, 0x74,
+	0x33, 0xf7, 0x9c, 0xdb, 0xb9, 0xe7, 0xfc, 0x9d, 0x7b, 0xce, 0xcf, 0x85, 0xd7, 0xb8, 0x5d, 0x78,
+	0x8d, 0xdf, 0x0b, 0xaf, 0xf1, 0xfd, 0x5d, 0x4a, 0xd5, 0x38, 0x8f, 0xfd, 0x84, 0x67, 0x81, 0x04,
+	0x7a, 0x64, 0x13, 0xcc, 0x17, 0x13, 0x11, 0x5c, 0x07, 0xf6, 0x7f, 0x49, 0xcd, 0xa6, 0x20, 0xe3,
+	0xa6, 0x39, 0xf3, 0xfe, 0xdf, 0x00, 0x66, 0x5f, 0x83, 0x70, 0x10, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -168,6 +232,82 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MintRecords) > 0 {
+		for iNdEx := len(m.MintRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.BurnRecords) > 0 {
+		for iNdEx := len(m.BurnRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	{
+		size, err := m.EmergencyState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.EpochGasUsages) > 0 {
+		for iNdEx := len(m.EpochGasUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochGasUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size, err := m.CurrentEpochGasUsage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.BlockGasUsages) > 0 {
+		for iNdEx := len(m.BlockGasUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockGasUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	{
 		size, err := m.IncomeBuffer.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -268,6 +408,34 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.IncomeBuffer.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.BlockGasUsages) > 0 {
+		for _, e := range m.BlockGasUsages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.CurrentEpochGasUsage.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.EpochGasUsages) > 0 {
+		for _, e := range m.EpochGasUsages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.EmergencyState.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.BurnRecords) > 0 {
+		for _, e := range m.BurnRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MintRecords) > 0 {
+		for _, e := range m.MintRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -505,6 +673,208 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockGasUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockGasUsages = append(m.BlockGasUsages, BlockGasUsage{})
+			if err := m.BlockGasUsages[len(m.BlockGasUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochGasUsage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentEpochGasUsage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochGasUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochGasUsages = append(m.EpochGasUsages, EpochGasUsage{})
+			if err := m.EpochGasUsages[len(m.EpochGasUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EmergencyState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnRecords = append(m.BurnRecords, BurnRecord{})
+			if err := m.BurnRecords[len(m.BurnRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintRecords = append(m.MintRecords, MintRecord{})
+			if err := m.MintRecords[len(m.MintRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,148 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeUpdateParams      = "AexburnUpdateParams"
+	ProposalTypeSetEmergencyPause = "AexburnSetEmergencyPause"
+	ProposalTypeDrainIncomeBuffer = "AexburnDrainIncomeBuffer"
+	ProposalTypeResetIncomeBuffer = "AexburnResetIncomeBuffer"
+)
+
+func init() {
+	// for routing
+	govtypes.RegisterProposalType(ProposalTypeUpdateParams)
+	govtypes.RegisterProposalType(ProposalTypeSetEmergencyPause)
+	govtypes.RegisterProposalType(ProposalTypeDrainIncomeBuffer)
+	govtypes.RegisterProposalType(ProposalTypeResetIncomeBuffer)
+
+	// for marshal and unmarshal
+	govtypes.RegisterProposalTypeCodec(&UpdateParamsProposal{}, "aexburn/UpdateParamsProposal")
+	govtypes.RegisterProposalTypeCodec(&SetEmergencyPauseProposal{}, "aexburn/SetEmergencyPauseProposal")
+	govtypes.RegisterProposalTypeCodec(&DrainIncomeBufferProposal{}, "aexburn/DrainIncomeBufferProposal")
+	govtypes.RegisterProposalTypeCodec(&ResetIncomeBufferProposal{}, "aexburn/ResetIncomeBufferProposal")
+}
+
+func (p *UpdateParamsProposal) GetTitle() string { return p.Title }
+
+func (p *UpdateParamsProposal) GetDescription() string { return p.Description }
+
+func (p *UpdateParamsProposal) ProposalRoute() string { return RouterKey }
+
+func (p *UpdateParamsProposal) ProposalType() string {
+	return ProposalTypeUpdateParams
+}
+
+func (p *UpdateParamsProposal) ValidateBasic() error {
+	if err := p.Params.Validate(); err != nil {
+		return err
+	}
+	return govtypes.ValidateAbstract(p)
+}
+
+func (p UpdateParamsProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Update Aexburn Params Proposal:
+  Title:       %s
+  Description: %s
+  Params:
+%s
+`, p.Title, p.Description, p.Params.String()))
+	return b.String()
+}
+
+func NewUpdateParamsProposal(title, description string, params Params) *UpdateParamsProposal {
+	return &UpdateParamsProposal{title, description, params}
+}
+
+func (p *SetEmergencyPauseProposal) GetTitle() string { return p.Title }
+
+func (p *SetEmergencyPauseProposal) GetDescription() string { return p.Description }
+
+func (p *SetEmergencyPauseProposal) ProposalRoute() string { return RouterKey }
+
+func (p *SetEmergencyPauseProposal) ProposalType() string {
+	return ProposalTypeSetEmergencyPause
+}
+
+func (p *SetEmergencyPauseProposal) ValidateBasic() error {
+	return govtypes.ValidateAbstract(p)
+}
+
+func (p SetEmergencyPauseProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Aexburn Emergency Pause Proposal:
+  Title:       %s
+  Description: %s
+  Burn Paused: %t
+  Mint Paused: %t
+`, p.Title, p.Description, p.BurnPaused, p.MintPaused))
+	return b.String()
+}
+
+func NewSetEmergencyPauseProposal(title, description string, burnPaused, mintPaused bool) *SetEmergencyPauseProposal {
+	return &SetEmergencyPauseProposal{title, description, burnPaused, mintPaused}
+}
+
+func (p *DrainIncomeBufferProposal) GetTitle() string { return p.Title }
+
+func (p *DrainIncomeBufferProposal) GetDescription() string { return p.Description }
+
+func (p *DrainIncomeBufferProposal) ProposalRoute() string { return RouterKey }
+
+func (p *DrainIncomeBufferProposal) ProposalType() string {
+	return ProposalTypeDrainIncomeBuffer
+}
+
+func (p *DrainIncomeBufferProposal) ValidateBasic() error {
+	if err := validateRecipient(p.Recipient); err != nil {
+		return err
+	}
+	return govtypes.ValidateAbstract(p)
+}
+
+func (p DrainIncomeBufferProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Drain Aexburn Income Buffer Proposal:
+  Title:       %s
+  Description: %s
+  Recipient:   %s
+`, p.Title, p.Description, p.Recipient))
+	return b.String()
+}
+
+func NewDrainIncomeBufferProposal(title, description, recipient string) *DrainIncomeBufferProposal {
+	return &DrainIncomeBufferProposal{title, description, recipient}
+}
+
+func (p *ResetIncomeBufferProposal) GetTitle() string { return p.Title }
+
+func (p *ResetIncomeBufferProposal) GetDescription() string { return p.Description }
+
+func (p *ResetIncomeBufferProposal) ProposalRoute() string { return RouterKey }
+
+func (p *ResetIncomeBufferProposal) ProposalType() string {
+	return ProposalTypeResetIncomeBuffer
+}
+
+func (p *ResetIncomeBufferProposal) ValidateBasic() error {
+	return govtypes.ValidateAbstract(p)
+}
+
+func (p ResetIncomeBufferProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Reset Aexburn Income Buffer Proposal:
+  Title:       %s
+  Description: %s
+`, p.Title, p.Description))
+	return b.String()
+}
+
+func NewResetIncomeBufferProposal(title, description string) *ResetIncomeBufferProposal {
+	return &ResetIncomeBufferProposal{title, description}
+}
@@ -22,7 +22,9 @@ var (
 	// BurnStatsKey is the key for storing burn statistics
 	BurnStatsKey = []byte{0x02}
 
-	// MonthlyBurnDataPrefix is the prefix for storing monthly burn data
+	// MonthlyBurnDataPrefix is the prefix for storing monthly burn data, keyed by
+	// big-endian calendar month index. Before the calendar windows it was keyed by a
+	// single byte epoch-derived index, see Migrate4to5
 	MonthlyBurnDataPrefix = []byte{0x03}
 
 	// BurnRecordPrefix is the prefix for storing burn records
@@ -39,16 +41,35 @@ var (
 
 	// IncomeBufferKey is the key for storing income buffer state
 	IncomeBufferKey = []byte{0x08}
+
+	// BlockGasUsagePrefix is the prefix for storing per-block gas usage samples
+	BlockGasUsagePrefix = []byte{0x09}
+
+	// GasUsageWindowKey is the key for storing the rolling gas usage window aggregate
+	GasUsageWindowKey = []byte{0x0A}
+
+	// CurrentEpochGasUsageKey is the key for storing the current epoch's gas usage accumulator
+	CurrentEpochGasUsageKey = []byte{0x0B}
+
+	// EpochGasUsagePrefix is the prefix for storing the gas usage of completed epochs
+	EpochGasUsagePrefix = []byte{0x0C}
+
+	// EmergencyStateKey is the key for storing the governance emergency controls
+	EmergencyStateKey = []byte{0x0D}
+
+	// SupplyBaselineKey is the key for storing the AEX supply issued outside of aexburn,
+	// i.e. the genesis supply plus x/mint releases
+	SupplyBaselineKey = []byte{0x0E}
 )
 
-// GetMonthlyBurnDataKey returns the key for a specific month's burn data
+// GetMonthlyBurnDataKey returns the key for a specific calendar month's burn data
 func GetMonthlyBurnDataKey(monthIndex uint32) []byte {
-	return append(MonthlyBurnDataPrefix, byte(monthIndex))
+	return append(MonthlyBurnDataPrefix, monthToBytes(monthIndex)...)
 }
 
-// GetBurnRecordKey returns the key for a burn record at a specific epoch
-func GetBurnRecordKey(epochNumber uint64) []byte {
-	return append(BurnRecordPrefix, epochToBytes(epochNumber)...)
+// GetBurnRecordKey returns the key for the burn record of a specific block
+func GetBurnRecordKey(blockHeight int64) []byte {
+	return append(BurnRecordPrefix, epochToBytes(uint64(blockHeight))...)
 }
 
 // GetMintRecordKey returns the key for a mint record at a specific epoch
@@ -56,6 +77,16 @@ func GetMintRecordKey(epochNumber uint64) []byte {
 	return append(MintRecordPrefix, epochToBytes(epochNumber)...)
 }
 
+// GetBlockGasUsageKey returns the key for the gas usage sample of a specific block
+func GetBlockGasUsageKey(blockHeight int64) []byte {
+	return append(BlockGasUsagePrefix, epochToBytes(uint64(blockHeight))...)
+}
+
+// GetEpochGasUsageKey returns the key for the gas usage of a specific epoch
+func GetEpochGasUsageKey(epochNumber uint64) []byte {
+	return append(EpochGasUsagePrefix, epochToBytes(epochNumber)...)
+}
+
 // epochToBytes converts epoch number to bytes
 func epochToBytes(epochNumber uint64) []byte {
 	bz := make([]byte, 8)
@@ -69,3 +100,13 @@ func epochToBytes(epochNumber uint64) []byte {
 	bz[7] = byte(epochNumber)
 	return bz
 }
+
+// monthToBytes converts a calendar month index to bytes
+func monthToBytes(monthIndex uint32) []byte {
+	bz := make([]byte, 4)
+	bz[0] = byte(monthIndex >> 24)
+	bz[1] = byte(monthIndex >> 16)
+	bz[2] = byte(monthIndex >> 8)
+	bz[3] = byte(monthIndex)
+	return bz
+}
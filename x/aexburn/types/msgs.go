@@ -0,0 +1,144 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// constants
+const (
+	TypeMsgUpdateParams      = "update_params"
+	TypeMsgSetEmergencyPause = "set_emergency_pause"
+	TypeMsgDrainIncomeBuffer = "drain_income_buffer"
+	TypeMsgResetIncomeBuffer = "reset_income_buffer"
+)
+
+var _ sdk.Msg = &MsgUpdateParams{}
+
+// NewMsgUpdateParams creates a msg to replace the module parameters
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+func (m MsgUpdateParams) Route() string { return RouterKey }
+func (m MsgUpdateParams) Type() string  { return TypeMsgUpdateParams }
+func (m MsgUpdateParams) ValidateBasic() error {
+	if err := validateAuthority(m.Authority); err != nil {
+		return err
+	}
+
+	if err := m.Params.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidParams, err.Error())
+	}
+
+	return nil
+}
+
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+var _ sdk.Msg = &MsgSetEmergencyPause{}
+
+// NewMsgSetEmergencyPause creates a msg to pause or resume burning and minting
+func NewMsgSetEmergencyPause(authority string, burnPaused, mintPaused bool) *MsgSetEmergencyPause {
+	return &MsgSetEmergencyPause{
+		Authority:  authority,
+		BurnPaused: burnPaused,
+		MintPaused: mintPaused,
+	}
+}
+
+func (m MsgSetEmergencyPause) Route() string { return RouterKey }
+func (m MsgSetEmergencyPause) Type() string  { return TypeMsgSetEmergencyPause }
+func (m MsgSetEmergencyPause) ValidateBasic() error {
+	return validateAuthority(m.Authority)
+}
+
+func (m MsgSetEmergencyPause) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetEmergencyPause) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+var _ sdk.Msg = &MsgDrainIncomeBuffer{}
+
+// NewMsgDrainIncomeBuffer creates a msg to drain the income buffer to a recipient
+func NewMsgDrainIncomeBuffer(authority, recipient string) *MsgDrainIncomeBuffer {
+	return &MsgDrainIncomeBuffer{
+		Authority: authority,
+		Recipient: recipient,
+	}
+}
+
+func (m MsgDrainIncomeBuffer) Route() string { return RouterKey }
+func (m MsgDrainIncomeBuffer) Type() string  { return TypeMsgDrainIncomeBuffer }
+func (m MsgDrainIncomeBuffer) ValidateBasic() error {
+	if err := validateAuthority(m.Authority); err != nil {
+		return err
+	}
+
+	return validateRecipient(m.Recipient)
+}
+
+func (m MsgDrainIncomeBuffer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgDrainIncomeBuffer) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+var _ sdk.Msg = &MsgResetIncomeBuffer{}
+
+// NewMsgResetIncomeBuffer creates a msg to reset the income buffer accounting
+func NewMsgResetIncomeBuffer(authority string) *MsgResetIncomeBuffer {
+	return &MsgResetIncomeBuffer{
+		Authority: authority,
+	}
+}
+
+func (m MsgResetIncomeBuffer) Route() string { return RouterKey }
+func (m MsgResetIncomeBuffer) Type() string  { return TypeMsgResetIncomeBuffer }
+func (m MsgResetIncomeBuffer) ValidateBasic() error {
+	return validateAuthority(m.Authority)
+}
+
+func (m MsgResetIncomeBuffer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgResetIncomeBuffer) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+func validateAuthority(authority string) error {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	return nil
+}
+
+// validateRecipient accepts an empty recipient, which means the fee collector
+func validateRecipient(recipient string) error {
+	if recipient == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+	}
+	return nil
+}
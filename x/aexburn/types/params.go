@@ -5,6 +5,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	appparams "github.com/sei-protocol/sei-chain/app/params"
 )
 
 var (
@@ -15,14 +17,15 @@ var (
 	KeyTargetBurnRate   = []byte("TargetBurnRate")
 	KeyLowGasThreshold  = []byte("LowGasThreshold")
 	KeyHighGasThreshold = []byte("HighGasThreshold")
+	KeyGasUsageWindow   = []byte("GasUsageWindow")
 
 	// Inflation parameters
-	KeyInflationEnabled          = []byte("InflationEnabled")
-	KeyMaxAnnualInflationRate    = []byte("MaxAnnualInflationRate")
-	KeyMaxNetSupplyRatePerYear   = []byte("MaxNetSupplyRatePerYear")
-	KeyInitialSupply             = []byte("InitialSupply")
-	KeyMinGasUsageForInflation   = []byte("MinGasUsageForInflation")
-	KeyEpochsPerYear             = []byte("EpochsPerYear")
+	KeyInflationEnabled        = []byte("InflationEnabled")
+	KeyMaxAnnualInflationRate  = []byte("MaxAnnualInflationRate")
+	KeyMaxNetSupplyRatePerYear = []byte("MaxNetSupplyRatePerYear")
+	KeyInitialSupply           = []byte("InitialSupply")
+	KeyMinGasUsageForInflation = []byte("MinGasUsageForInflation")
+	KeyEpochsPerYear           = []byte("EpochsPerYear")
 
 	// Reverse brake parameters
 	KeyReverseBrakeEnabled       = []byte("ReverseBrakeEnabled")
@@ -30,22 +33,30 @@ var (
 	KeyReverseBrakeReductionRate = []byte("ReverseBrakeReductionRate")
 
 	// Income smoother parameters
-	KeyIncomeSmootherEnabled   = []byte("IncomeSmootherEnabled")
-	KeyBufferContributionRate  = []byte("BufferContributionRate")
-	KeyBufferReleaseRate       = []byte("BufferReleaseRate")
-	KeyHighActivityThreshold   = []byte("HighActivityThreshold")
-	KeyLowActivityThreshold    = []byte("LowActivityThreshold")
-	KeyMaxBufferSize           = []byte("MaxBufferSize")
+	KeyIncomeSmootherEnabled  = []byte("IncomeSmootherEnabled")
+	KeyBufferContributionRate = []byte("BufferContributionRate")
+	KeyBufferReleaseRate      = []byte("BufferReleaseRate")
+	KeyHighActivityThreshold  = []byte("HighActivityThreshold")
+	KeyLowActivityThreshold   = []byte("LowActivityThreshold")
+	KeyMaxBufferSize          = []byte("MaxBufferSize")
+
+	// Burn history parameters
+	KeyBurnRecordRetention = []byte("BurnRecordRetention")
+
+	// Multi-denom parameters
+	KeyDenomBurnPolicies = []byte("DenomBurnPolicies")
 )
 
 var (
 	// Burn defaults
 	DefaultBurnEnabled      = true
-	DefaultMinBurnRate      = sdk.NewDecWithPrec(30, 2)  // 30%
-	DefaultMaxBurnRate      = sdk.NewDecWithPrec(60, 2)  // 60%
-	DefaultTargetBurnRate   = sdk.NewDecWithPrec(50, 2)  // 50%
-	DefaultLowGasThreshold  = sdk.NewDecWithPrec(30, 2)  // 30%
-	DefaultHighGasThreshold = sdk.NewDecWithPrec(70, 2)  // 70%
+	DefaultMinBurnRate      = sdk.NewDecWithPrec(30, 2) // 30%
+	DefaultMaxBurnRate      = sdk.NewDecWithPrec(60, 2) // 60%
+	DefaultTargetBurnRate   = sdk.NewDecWithPrec(50, 2) // 50%
+	DefaultLowGasThreshold  = sdk.NewDecWithPrec(30, 2) // 30%
+	DefaultHighGasThreshold = sdk.NewDecWithPrec(70, 2) // 70%
+	DefaultGasUsageWindow   = uint64(100)               // last 100 blocks
+	DefaultGasUsageRate     = sdk.NewDecWithPrec(50, 2) // 50% until gas usage has been recorded
 
 	// Inflation defaults
 	DefaultInflationEnabled        = true
@@ -61,12 +72,18 @@ var (
 	DefaultReverseBrakeReductionRate = sdk.NewDecWithPrec(10, 2) // 10% reduction
 
 	// Income smoother defaults (disabled by default)
-	DefaultIncomeSmootherEnabled   = false                       // Disabled by default
-	DefaultBufferContributionRate  = sdk.NewDecWithPrec(10, 2)   // 10% contribution during high activity
-	DefaultBufferReleaseRate       = sdk.NewDecWithPrec(5, 2)    // 5% release during low activity
-	DefaultHighActivityThreshold   = sdk.NewDecWithPrec(70, 2)   // 70% gas usage = high activity
-	DefaultLowActivityThreshold    = sdk.NewDecWithPrec(30, 2)   // 30% gas usage = low activity
-	DefaultMaxBufferSize           = sdk.NewDecWithPrec(1, 2)    // 1% of initial supply
+	DefaultIncomeSmootherEnabled  = false                     // Disabled by default
+	DefaultBufferContributionRate = sdk.NewDecWithPrec(10, 2) // 10% contribution during high activity
+	DefaultBufferReleaseRate      = sdk.NewDecWithPrec(5, 2)  // 5% release during low activity
+	DefaultHighActivityThreshold  = sdk.NewDecWithPrec(70, 2) // 70% gas usage = high activity
+	DefaultLowActivityThreshold   = sdk.NewDecWithPrec(30, 2) // 30% gas usage = low activity
+	DefaultMaxBufferSize          = sdk.NewDecWithPrec(1, 2)  // 1% of initial supply
+
+	// Burn history defaults
+	DefaultBurnRecordRetention = uint64(2_592_000) // ~30 days of 1s blocks
+
+	// Multi-denom defaults (non-AEX fees are left to validators)
+	DefaultDenomBurnPolicies = []DenomBurnPolicy{}
 )
 
 // ParamKeyTable returns the parameter key table
@@ -84,6 +101,7 @@ func DefaultParams() Params {
 		TargetBurnRate:   DefaultTargetBurnRate,
 		LowGasThreshold:  DefaultLowGasThreshold,
 		HighGasThreshold: DefaultHighGasThreshold,
+		GasUsageWindow:   DefaultGasUsageWindow,
 		// Inflation params
 		InflationEnabled:        DefaultInflationEnabled,
 		MaxAnnualInflationRate:  DefaultMaxAnnualInflationRate,
@@ -102,6 +120,10 @@ func DefaultParams() Params {
 		HighActivityThreshold:  DefaultHighActivityThreshold,
 		LowActivityThreshold:   DefaultLowActivityThreshold,
 		MaxBufferSize:          DefaultMaxBufferSize,
+		// Burn history params
+		BurnRecordRetention: DefaultBurnRecordRetention,
+		// Multi-denom params
+		DenomBurnPolicies: DefaultDenomBurnPolicies,
 	}
 }
 
@@ -115,6 +137,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyTargetBurnRate, &p.TargetBurnRate, validateBurnRate),
 		paramtypes.NewParamSetPair(KeyLowGasThreshold, &p.LowGasThreshold, validateThreshold),
 		paramtypes.NewParamSetPair(KeyHighGasThreshold, &p.HighGasThreshold, validateThreshold),
+		paramtypes.NewParamSetPair(KeyGasUsageWindow, &p.GasUsageWindow, validateGasUsageWindow),
 		// Inflation params
 		paramtypes.NewParamSetPair(KeyInflationEnabled, &p.InflationEnabled, validateBurnEnabled),
 		paramtypes.NewParamSetPair(KeyMaxAnnualInflationRate, &p.MaxAnnualInflationRate, validateInflationRate),
@@ -133,6 +156,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyHighActivityThreshold, &p.HighActivityThreshold, validateThreshold),
 		paramtypes.NewParamSetPair(KeyLowActivityThreshold, &p.LowActivityThreshold, validateThreshold),
 		paramtypes.NewParamSetPair(KeyMaxBufferSize, &p.MaxBufferSize, validateBurnRate),
+		// Burn history params
+		paramtypes.NewParamSetPair(KeyBurnRecordRetention, &p.BurnRecordRetention, validateBurnRecordRetention),
+		// Multi-denom params
+		paramtypes.NewParamSetPair(KeyDenomBurnPolicies, &p.DenomBurnPolicies, validateDenomBurnPolicies),
 	}
 }
 
@@ -154,9 +181,22 @@ func (p Params) Validate() error {
 	if p.TargetBurnRate.LT(p.MinBurnRate) || p.TargetBurnRate.GT(p.MaxBurnRate) {
 		return fmt.Errorf("target burn rate must be between min and max")
 	}
+	if err := validateThreshold(p.LowGasThreshold); err != nil {
+		return fmt.Errorf("invalid low gas threshold: %w", err)
+	}
+	if err := validateThreshold(p.HighGasThreshold); err != nil {
+		return fmt.Errorf("invalid high gas threshold: %w", err)
+	}
 	if p.LowGasThreshold.GTE(p.HighGasThreshold) {
 		return fmt.Errorf("low gas threshold must be less than high gas threshold")
 	}
+	// The burn rate interpolates over (HighGasThreshold, 1], which must not be empty
+	if p.HighGasThreshold.GTE(sdk.OneDec()) {
+		return fmt.Errorf("high gas threshold must be less than 1")
+	}
+	if p.GasUsageWindow == 0 {
+		return fmt.Errorf("gas usage window must be positive")
+	}
 
 	// Validate inflation params
 	if err := validateInflationRate(p.MaxAnnualInflationRate); err != nil {
@@ -171,6 +211,13 @@ func (p Params) Validate() error {
 	if p.EpochsPerYear == 0 {
 		return fmt.Errorf("epochs per year must be positive")
 	}
+	if err := validateThreshold(p.MinGasUsageForInflation); err != nil {
+		return fmt.Errorf("invalid min gas usage for inflation: %w", err)
+	}
+	// Inflation scales over [MinGasUsageForInflation, 1], which must not be empty
+	if p.MinGasUsageForInflation.GTE(sdk.OneDec()) {
+		return fmt.Errorf("min gas usage for inflation must be less than 1")
+	}
 
 	// Validate reverse brake params
 	if p.ReverseBrakeTriggerCount == 0 {
@@ -200,6 +247,11 @@ func (p Params) Validate() error {
 		return fmt.Errorf("invalid max buffer size: %w", err)
 	}
 
+	// Validate multi-denom params
+	if err := validateDenomBurnPolicies(p.DenomBurnPolicies); err != nil {
+		return fmt.Errorf("invalid denom burn policies: %w", err)
+	}
+
 	return nil
 }
 
@@ -213,6 +265,7 @@ func (p Params) String() string {
   Target Burn Rate:   %s
   Low Gas Threshold:  %s
   High Gas Threshold: %s
+  Gas Usage Window:   %d
   === Inflation ===
   Inflation Enabled:        %t
   Max Annual Inflation:     %s
@@ -230,13 +283,18 @@ func (p Params) String() string {
   Buffer Release Rate:         %s
   High Activity Threshold:     %s
   Low Activity Threshold:      %s
-  Max Buffer Size:             %s`,
+  Max Buffer Size:             %s
+  === Burn History ===
+  Burn Record Retention:       %d
+  === Multi-Denom ===
+  Denom Burn Policies:         %v`,
 		p.BurnEnabled,
 		p.MinBurnRate,
 		p.MaxBurnRate,
 		p.TargetBurnRate,
 		p.LowGasThreshold,
 		p.HighGasThreshold,
+		p.GasUsageWindow,
 		p.InflationEnabled,
 		p.MaxAnnualInflationRate,
 		p.MaxNetSupplyRatePerYear,
@@ -252,9 +310,21 @@ func (p Params) String() string {
 		p.HighActivityThreshold,
 		p.LowActivityThreshold,
 		p.MaxBufferSize,
+		p.BurnRecordRetention,
+		p.DenomBurnPolicies,
 	)
 }
 
+// GetDenomBurnPolicy returns the burn policy of a non-AEX fee denom, if any
+func (p Params) GetDenomBurnPolicy(denom string) (DenomBurnPolicy, bool) {
+	for _, policy := range p.DenomBurnPolicies {
+		if policy.Denom == denom {
+			return policy, true
+		}
+	}
+	return DenomBurnPolicy{}, false
+}
+
 func validateBurnEnabled(i interface{}) error {
 	_, ok := i.(bool)
 	if !ok {
@@ -268,6 +338,9 @@ func validateBurnRate(i interface{}) error {
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
+	if v.IsNil() {
+		return fmt.Errorf("burn rate cannot be nil")
+	}
 	if v.IsNegative() {
 		return fmt.Errorf("burn rate cannot be negative: %s", v)
 	}
@@ -282,6 +355,9 @@ func validateInflationRate(i interface{}) error {
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
+	if v.IsNil() {
+		return fmt.Errorf("inflation rate cannot be nil")
+	}
 	if v.IsNegative() {
 		return fmt.Errorf("inflation rate cannot be negative: %s", v)
 	}
@@ -296,6 +372,9 @@ func validateInitialSupply(i interface{}) error {
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
+	if v.IsNil() {
+		return fmt.Errorf("initial supply cannot be nil")
+	}
 	if v.IsNegative() || v.IsZero() {
 		return fmt.Errorf("initial supply must be positive: %s", v)
 	}
@@ -313,11 +392,77 @@ func validateEpochsPerYear(i interface{}) error {
 	return nil
 }
 
+func validateGasUsageWindow(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("gas usage window must be positive")
+	}
+	return nil
+}
+
+func validateBurnRecordRetention(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateDenomBurnPolicies(i interface{}) error {
+	policies, ok := i.([]DenomBurnPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(policies))
+	for _, policy := range policies {
+		if err := sdk.ValidateDenom(policy.Denom); err != nil {
+			return err
+		}
+		if policy.Denom == appparams.BaseCoinUnit {
+			return fmt.Errorf("%s fees are always burned natively", appparams.BaseCoinUnit)
+		}
+		if seen[policy.Denom] {
+			return fmt.Errorf("duplicate policy for denom %s", policy.Denom)
+		}
+		seen[policy.Denom] = true
+
+		switch policy.Action {
+		case DenomBurnActionBurn, DenomBurnActionRoute, DenomBurnActionOracle:
+		default:
+			return fmt.Errorf("invalid action %s for denom %s", policy.Action, policy.Denom)
+		}
+
+		if policy.Recipient != "" {
+			if policy.Action != DenomBurnActionRoute {
+				return fmt.Errorf("recipient is only allowed with %s for denom %s", DenomBurnActionRoute, policy.Denom)
+			}
+			if _, err := sdk.AccAddressFromBech32(policy.Recipient); err != nil {
+				return fmt.Errorf("invalid recipient for denom %s: %w", policy.Denom, err)
+			}
+		}
+		if policy.OracleDenom != "" {
+			if policy.Action != DenomBurnActionOracle {
+				return fmt.Errorf("oracle denom is only allowed with %s for denom %s", DenomBurnActionOracle, policy.Denom)
+			}
+			if err := sdk.ValidateDenom(policy.OracleDenom); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func validateThreshold(i interface{}) error {
 	v, ok := i.(sdk.Dec)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
+	if v.IsNil() {
+		return fmt.Errorf("threshold cannot be nil")
+	}
 	if v.IsNegative() || v.GT(sdk.OneDec()) {
 		return fmt.Errorf("threshold must be between 0 and 1: %s", v)
 	}
@@ -24,6 +24,42 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DenomBurnAction defines what happens to the burn portion of a non-AEX fee denom
+type DenomBurnAction int32
+
+const (
+	// DENOM_BURN_ACTION_UNSPECIFIED is invalid in a policy
+	DenomBurnActionUnspecified DenomBurnAction = 0
+	// DENOM_BURN_ACTION_BURN burns the coins natively
+	DenomBurnActionBurn DenomBurnAction = 1
+	// DENOM_BURN_ACTION_ROUTE sends the coins to a treasury address or the community pool
+	DenomBurnActionRoute DenomBurnAction = 2
+	// DENOM_BURN_ACTION_ORACLE burns the coins and accounts their AEX value at oracle prices
+	DenomBurnActionOracle DenomBurnAction = 3
+)
+
+var DenomBurnAction_name = map[int32]string{
+	0: "DENOM_BURN_ACTION_UNSPECIFIED",
+	1: "DENOM_BURN_ACTION_BURN",
+	2: "DENOM_BURN_ACTION_ROUTE",
+	3: "DENOM_BURN_ACTION_ORACLE",
+}
+
+var DenomBurnAction_value = map[string]int32{
+	"DENOM_BURN_ACTION_UNSPECIFIED": 0,
+	"DENOM_BURN_ACTION_BURN":        1,
+	"DENOM_BURN_ACTION_ROUTE":       2,
+	"DENOM_BURN_ACTION_ORACLE":      3,
+}
+
+func (x DenomBurnAction) String() string {
+	return proto.EnumName(DenomBurnAction_name, int32(x))
+}
+
+func (DenomBurnAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_eb3cdf38b257635a, []int{0}
+}
+
 // Params defines the parameters for the aexburn module.
 type Params struct {
 	// burn_enabled determines whether fee burning is enabled
@@ -38,6 +74,8 @@ type Params struct {
 	LowGasThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=low_gas_threshold,json=lowGasThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"low_gas_threshold" yaml:"low_gas_threshold"`
 	// high_gas_threshold is the gas usage rate above which burn rate increases (0.70 = 70%)
 	HighGasThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=high_gas_threshold,json=highGasThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"high_gas_threshold" yaml:"high_gas_threshold"`
+	// gas_usage_window is the number of recent blocks averaged to measure gas usage (100 = last 100 blocks)
+	GasUsageWindow uint64 `protobuf:"varint,7,opt,name=gas_usage_window,json=gasUsageWindow,proto3" json:"gas_usage_window,omitempty" yaml:"gas_usage_window"`
 	// inflation_enabled determines whether inflation is enabled
 	InflationEnabled bool `protobuf:"varint,10,opt,name=inflation_enabled,json=inflationEnabled,proto3" json:"inflation_enabled,omitempty" yaml:"inflation_enabled"`
 	// max_annual_inflation_rate is the maximum annual inflation rate (0.03 = 3%)
@@ -68,6 +106,11 @@ type Params struct {
 	LowActivityThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,34,opt,name=low_activity_threshold,json=lowActivityThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"low_activity_threshold" yaml:"low_activity_threshold"`
 	// max_buffer_size is the maximum size of the income buffer (as a fraction of initial supply, 0.01 = 1%)
 	MaxBufferSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,35,opt,name=max_buffer_size,json=maxBufferSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_buffer_size" yaml:"max_buffer_size"`
+	// burn_record_retention is the number of most recent blocks whose burn records are kept (0 = disabled)
+	BurnRecordRetention uint64 `protobuf:"varint,40,opt,name=burn_record_retention,json=burnRecordRetention,proto3" json:"burn_record_retention,omitempty" yaml:"burn_record_retention"`
+	// denom_burn_policies defines how the burn portion of non-AEX fee denoms is handled.
+	// Fees in denoms without a policy are left to validators
+	DenomBurnPolicies []DenomBurnPolicy `protobuf:"bytes,41,rep,name=denom_burn_policies,json=denomBurnPolicies,proto3" json:"denom_burn_policies" yaml:"denom_burn_policies"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -109,6 +152,13 @@ func (m *Params) GetBurnEnabled() bool {
 	return false
 }
 
+func (m *Params) GetGasUsageWindow() uint64 {
+	if m != nil {
+		return m.GasUsageWindow
+	}
+	return 0
+}
+
 func (m *Params) GetInflationEnabled() bool {
 	if m != nil {
 		return m.InflationEnabled
@@ -144,72 +194,184 @@ func (m *Params) GetIncomeSmootherEnabled() bool {
 	return false
 }
 
+func (m *Params) GetBurnRecordRetention() uint64 {
+	if m != nil {
+		return m.BurnRecordRetention
+	}
+	return 0
+}
+
+func (m *Params) GetDenomBurnPolicies() []DenomBurnPolicy {
+	if m != nil {
+		return m.DenomBurnPolicies
+	}
+	return nil
+}
+
+// DenomBurnPolicy defines the fee burn policy of a single non-AEX denom
+type DenomBurnPolicy struct {
+	// denom is the fee denom the policy applies to
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// action is what happens to the burn portion of the fees in this denom
+	Action DenomBurnAction `protobuf:"varint,2,opt,name=action,proto3,enum=seiprotocol.seichain.aexburn.DenomBurnAction" json:"action,omitempty" yaml:"action"`
+	// recipient is the treasury address for DENOM_BURN_ACTION_ROUTE (empty = community pool)
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty" yaml:"recipient"`
+	// oracle_denom is the oracle price denom for DENOM_BURN_ACTION_ORACLE (empty = denom),
+	// e.g. uatom for an IBC denom
+	OracleDenom string `protobuf:"bytes,4,opt,name=oracle_denom,json=oracleDenom,proto3" json:"oracle_denom,omitempty" yaml:"oracle_denom"`
+}
+
+func (m *DenomBurnPolicy) Reset()         { *m = DenomBurnPolicy{} }
+func (m *DenomBurnPolicy) String() string { return proto.CompactTextString(m) }
+func (*DenomBurnPolicy) ProtoMessage()    {}
+func (*DenomBurnPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb3cdf38b257635a, []int{1}
+}
+func (m *DenomBurnPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomBurnPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomBurnPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomBurnPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomBurnPolicy.Merge(m, src)
+}
+func (m *DenomBurnPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomBurnPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomBurnPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomBurnPolicy proto.InternalMessageInfo
+
+func (m *DenomBurnPolicy) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomBurnPolicy) GetAction() DenomBurnAction {
+	if m != nil {
+		return m.Action
+	}
+	return DenomBurnActionUnspecified
+}
+
+func (m *DenomBurnPolicy) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *DenomBurnPolicy) GetOracleDenom() string {
+	if m != nil {
+		return m.OracleDenom
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("seiprotocol.seichain.aexburn.DenomBurnAction", DenomBurnAction_name, DenomBurnAction_value)
 	proto.RegisterType((*Params)(nil), "seiprotocol.seichain.aexburn.Params")
+	proto.RegisterType((*DenomBurnPolicy)(nil), "seiprotocol.seichain.aexburn.DenomBurnPolicy")
 }
 
 func init() { proto.RegisterFile("aexburn/params.proto", fileDescriptor_eb3cdf38b257635a) }
 
 var fileDescriptor_eb3cdf38b257635a = []byte{
-	// 926 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x6f, 0xdb, 0x36,
-	0x14, 0xb6, 0xb6, 0x2c, 0xd8, 0x98, 0x3a, 0x3f, 0x1c, 0xff, 0x50, 0x52, 0xcf, 0xf2, 0x58, 0xa0,
-	0xc8, 0xa5, 0x36, 0x86, 0xdd, 0x72, 0xab, 0xbb, 0x35, 0xcb, 0x3a, 0x6c, 0x19, 0x93, 0x1e, 0xd6,
-	0x8b, 0x40, 0x2b, 0x8c, 0xcc, 0x55, 0x12, 0x0d, 0x92, 0x4a, 0xec, 0x62, 0xd7, 0x01, 0x05, 0x86,
-	0x01, 0xc3, 0x30, 0x0c, 0x3b, 0xee, 0xcf, 0xe9, 0xb1, 0xc7, 0x61, 0x07, 0x63, 0x48, 0xfe, 0x03,
-	0xff, 0x05, 0x85, 0x1e, 0x2d, 0x4b, 0x8e, 0xdd, 0x02, 0x42, 0x4f, 0xb6, 0xde, 0xf7, 0xf8, 0x7d,
-	0xdf, 0x7b, 0x7c, 0x12, 0x89, 0xaa, 0x94, 0x8d, 0xfa, 0xb1, 0x8c, 0xba, 0x43, 0x2a, 0x69, 0xa8,
-	0x3a, 0x43, 0x29, 0xb4, 0xa8, 0x34, 0x15, 0xe3, 0xf0, 0xcf, 0x13, 0x41, 0x47, 0x31, 0xee, 0x0d,
-	0x28, 0x8f, 0x3a, 0xb3, 0xd4, 0xfd, 0xaa, 0x2f, 0x7c, 0x01, 0x70, 0x37, 0xf9, 0x67, 0xd6, 0xe0,
-	0x97, 0xbb, 0x68, 0xfd, 0x04, 0x48, 0x2a, 0x87, 0xe8, 0x4e, 0x92, 0xe8, 0xb2, 0x88, 0xf6, 0x03,
-	0x76, 0x6e, 0x5b, 0x6d, 0xeb, 0xe0, 0xe3, 0x5e, 0x63, 0x3a, 0x71, 0x76, 0xc7, 0x34, 0x0c, 0x0e,
-	0x71, 0x1e, 0xc5, 0x64, 0x23, 0x79, 0xfc, 0xca, 0x3c, 0x55, 0x7e, 0x42, 0xe5, 0x90, 0x47, 0x2e,
-	0x64, 0x48, 0xaa, 0x99, 0xfd, 0x41, 0xdb, 0x3a, 0xf8, 0xa4, 0xf7, 0xf8, 0xd5, 0xc4, 0x29, 0xfd,
-	0x37, 0x71, 0xee, 0xfb, 0x5c, 0x0f, 0xe2, 0x7e, 0xc7, 0x13, 0x61, 0xd7, 0x13, 0x2a, 0x14, 0x6a,
-	0xf6, 0xf3, 0x40, 0x9d, 0x3f, 0xef, 0xea, 0xf1, 0x90, 0xa9, 0xce, 0x97, 0xcc, 0x9b, 0x4e, 0x9c,
-	0xaa, 0x91, 0x5a, 0x20, 0xc3, 0x64, 0x23, 0xe4, 0x51, 0x2f, 0x96, 0x11, 0xa1, 0x9a, 0x81, 0x16,
-	0x1d, 0xe5, 0xb4, 0x3e, 0x7c, 0x4f, 0xad, 0x3c, 0x59, 0xa2, 0x45, 0x47, 0x73, 0x2d, 0x85, 0xb6,
-	0x35, 0x95, 0x3e, 0xd3, 0x39, 0xb9, 0x35, 0x90, 0x3b, 0x2e, 0x2c, 0xd7, 0x30, 0x72, 0xb7, 0xf9,
-	0x30, 0xd9, 0x34, 0xa1, 0xb9, 0xe8, 0x25, 0xda, 0x09, 0xc4, 0x95, 0xeb, 0x53, 0xe5, 0xea, 0x81,
-	0x64, 0x6a, 0x20, 0x82, 0x73, 0xfb, 0x23, 0x50, 0xfd, 0xa6, 0xb0, 0xaa, 0x6d, 0x54, 0x97, 0x08,
-	0x31, 0xd9, 0x0a, 0xc4, 0xd5, 0x11, 0x55, 0x67, 0x69, 0xa4, 0x32, 0x46, 0x95, 0x01, 0xf7, 0x07,
-	0xb7, 0x84, 0xd7, 0x41, 0xf8, 0x49, 0x61, 0xe1, 0x3d, 0x23, 0xbc, 0xcc, 0x88, 0xc9, 0x76, 0x12,
-	0x5c, 0x90, 0x3e, 0x46, 0x3b, 0x3c, 0xba, 0x08, 0xa8, 0xe6, 0x22, 0x1b, 0x40, 0x04, 0x03, 0xd8,
-	0xcc, 0x8a, 0x58, 0x4a, 0xc1, 0x64, 0x7b, 0x1e, 0x4b, 0x47, 0xf1, 0x37, 0x0b, 0xed, 0x25, 0x5b,
-	0x4a, 0xa3, 0x28, 0xa6, 0x81, 0x9b, 0xad, 0x81, 0xcd, 0xdb, 0x80, 0x6a, 0x48, 0xe1, 0x6a, 0xda,
-	0xd9, 0xac, 0xac, 0x24, 0xc6, 0xa4, 0x1e, 0xd2, 0xd1, 0x43, 0x80, 0x8e, 0x53, 0x04, 0x76, 0xf3,
-	0x4f, 0x0b, 0x35, 0x93, 0x65, 0x11, 0xd3, 0xae, 0x8a, 0x87, 0xc3, 0x60, 0x0c, 0x0b, 0xdc, 0x21,
-	0x93, 0xee, 0x98, 0x51, 0x69, 0xdf, 0x01, 0x4b, 0x4f, 0x0b, 0x5b, 0xba, 0x97, 0x59, 0x7a, 0x1b,
-	0x37, 0x26, 0x8d, 0x90, 0x8e, 0xbe, 0x63, 0xfa, 0x14, 0xc0, 0xc4, 0xcf, 0x09, 0x93, 0x3f, 0x32,
-	0x2a, 0x2b, 0x11, 0xda, 0xe4, 0x11, 0xd7, 0x9c, 0x06, 0xb3, 0x95, 0x76, 0x19, 0x7c, 0x1c, 0x15,
-	0xf0, 0x71, 0x1c, 0xe9, 0xe9, 0xc4, 0xa9, 0xa5, 0x9b, 0x93, 0x67, 0xc3, 0xa4, 0x3c, 0x0b, 0x18,
-	0xe9, 0xca, 0x1f, 0x16, 0xba, 0x9b, 0xbc, 0xd5, 0xc9, 0x28, 0xc4, 0x8a, 0xfa, 0xcc, 0xbd, 0x10,
-	0x32, 0x6b, 0xa2, 0xbd, 0x09, 0xea, 0x67, 0x85, 0xbb, 0x80, 0xb3, 0x0f, 0xc6, 0x5b, 0xa8, 0x93,
-	0x26, 0xf0, 0xe8, 0x88, 0xaa, 0xa7, 0x09, 0xf6, 0x58, 0xc8, 0xf9, 0xfe, 0x54, 0x7a, 0x68, 0x8b,
-	0x0d, 0x85, 0x37, 0x50, 0xd9, 0x6e, 0x6c, 0xb5, 0xad, 0x83, 0xb5, 0xde, 0xfe, 0x74, 0xe2, 0xd4,
-	0x0d, 0xf3, 0xad, 0x04, 0x4c, 0xca, 0x26, 0x92, 0x36, 0xf2, 0x0c, 0xd5, 0x24, 0xbb, 0x64, 0x52,
-	0x31, 0xb7, 0x2f, 0xe9, 0x73, 0x36, 0x1f, 0xdf, 0x2a, 0x8c, 0x6f, 0x7b, 0x3a, 0x71, 0x9a, 0x86,
-	0x69, 0x65, 0x1a, 0x26, 0xbb, 0xb3, 0x78, 0x2f, 0x09, 0xa7, 0x53, 0xcc, 0xd0, 0xdd, 0xc5, 0x74,
-	0x2d, 0xb9, 0xef, 0x33, 0xe9, 0x7a, 0x22, 0x8e, 0xb4, 0x5d, 0x6b, 0x5b, 0x07, 0xe5, 0xde, 0xfd,
-	0xac, 0xfe, 0x77, 0x24, 0x63, 0x62, 0xe7, 0x15, 0xce, 0x0c, 0xf6, 0x28, 0x81, 0x2a, 0x7f, 0x59,
-	0xa8, 0xb9, 0xb8, 0x54, 0xb2, 0xf3, 0xd8, 0xcb, 0xde, 0x97, 0xfa, 0xfb, 0x0d, 0xe7, 0xbb, 0xb8,
-	0x31, 0xd9, 0xcb, 0xfb, 0x22, 0x29, 0x08, 0x6f, 0xcd, 0x33, 0xd4, 0xe0, 0x91, 0x27, 0x42, 0xe6,
-	0xaa, 0x50, 0x08, 0x3d, 0x60, 0x72, 0xde, 0xd7, 0x16, 0xf4, 0x15, 0x4f, 0x27, 0x4e, 0x2b, 0x9d,
-	0xbc, 0x95, 0x89, 0x98, 0xd4, 0x0c, 0x72, 0x3a, 0x03, 0xd2, 0xde, 0xfe, 0x6a, 0x21, 0xbb, 0x1f,
-	0x5f, 0x5c, 0x40, 0x83, 0x22, 0x2d, 0x79, 0x3f, 0xce, 0x0a, 0x76, 0xa0, 0xe0, 0x1f, 0x0a, 0x17,
-	0xec, 0xa4, 0x67, 0xe4, 0x6a, 0x5e, 0x4c, 0xea, 0x06, 0x7a, 0x94, 0x43, 0xa0, 0xd2, 0x9f, 0xd1,
-	0xee, 0x6c, 0x91, 0x64, 0x01, 0xa3, 0x8a, 0x19, 0x1f, 0x6d, 0xf0, 0xf1, 0x6d, 0x61, 0x1f, 0xfb,
-	0x0b, 0x3e, 0xf2, 0x94, 0x98, 0xec, 0x98, 0x28, 0x31, 0x41, 0x50, 0x7f, 0x69, 0xa1, 0x06, 0x7c,
-	0xa2, 0xa9, 0xa7, 0xf9, 0x25, 0xd7, 0xe3, 0xdc, 0x97, 0xff, 0x33, 0xb0, 0x70, 0x52, 0xd8, 0x42,
-	0x2b, 0xf7, 0xe5, 0x5f, 0xa6, 0xc5, 0xa4, 0x96, 0x20, 0x0f, 0x67, 0x40, 0x76, 0x06, 0xfc, 0x62,
-	0xa1, 0x7a, 0x72, 0x4c, 0xad, 0x70, 0x82, 0xc1, 0xc9, 0xf7, 0x85, 0x9d, 0x7c, 0x9a, 0x1d, 0x7e,
-	0xab, 0x8c, 0x54, 0x03, 0x71, 0xb5, 0xec, 0x63, 0x88, 0xb6, 0xcc, 0x95, 0x00, 0x3a, 0xa8, 0xf8,
-	0x0b, 0x66, 0xdf, 0x03, 0xfd, 0xaf, 0x0b, 0xeb, 0xd7, 0xf3, 0x37, 0x8c, 0x39, 0x1d, 0x26, 0x65,
-	0xb8, 0x63, 0x24, 0x81, 0x53, 0xfe, 0x82, 0x1d, 0xae, 0xfd, 0xfd, 0x8f, 0x53, 0xea, 0x3d, 0x79,
-	0x75, 0xdd, 0xb2, 0x5e, 0x5f, 0xb7, 0xac, 0xff, 0xaf, 0x5b, 0xd6, 0xef, 0x37, 0xad, 0xd2, 0xeb,
-	0x9b, 0x56, 0xe9, 0xdf, 0x9b, 0x56, 0xe9, 0xd9, 0xe7, 0x39, 0x41, 0xc5, 0xf8, 0x83, 0xf4, 0x92,
-	0x07, 0x0f, 0x70, 0xcb, 0xeb, 0x8e, 0xba, 0xe9, 0x95, 0x10, 0xf4, 0xfb, 0xeb, 0x90, 0xf3, 0xc5,
-	0x9b, 0x00, 0x00, 0x00, 0xff, 0xff, 0x37, 0x14, 0xa7, 0xa2, 0x2a, 0x0a, 0x00, 0x00,
+	// 1273 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xf6, 0xb6, 0x69, 0xa0, 0x93, 0x3a, 0x71, 0x36, 0xfe, 0xd8, 0xb8, 0xa9, 0xd7, 0x4c, 0xa5,
+	0x2a, 0x20, 0xd5, 0x11, 0xad, 0x10, 0x52, 0x6f, 0x71, 0xe2, 0x16, 0xd3, 0x92, 0x84, 0x49, 0x22,
+	0xa0, 0x97, 0xd5, 0x78, 0x3d, 0xb1, 0x87, 0xae, 0x77, 0xac, 0xd9, 0x75, 0x63, 0x57, 0x5c, 0x10,
+	0x42, 0xaa, 0x82, 0x90, 0x10, 0x42, 0x88, 0x4b, 0x24, 0x24, 0x4e, 0xfc, 0x93, 0x1e, 0x7b, 0x44,
+	0x1c, 0x2c, 0xd4, 0xfe, 0x03, 0xff, 0x01, 0xd0, 0xbe, 0xe3, 0xf5, 0xae, 0x3f, 0x1a, 0x61, 0xf5,
+	0xe4, 0xdd, 0xf7, 0xe3, 0x79, 0x9e, 0x79, 0xe7, 0xdd, 0x99, 0xd7, 0x28, 0x4d, 0x59, 0xb7, 0xd6,
+	0x91, 0xee, 0x56, 0x9b, 0x4a, 0xda, 0xf2, 0x4a, 0x6d, 0x29, 0x7c, 0xa1, 0x6f, 0x78, 0x8c, 0xc3,
+	0x93, 0x2d, 0x9c, 0x92, 0xc7, 0xb8, 0xdd, 0xa4, 0xdc, 0x2d, 0x0d, 0x43, 0xf3, 0xe9, 0x86, 0x68,
+	0x08, 0x70, 0x6f, 0x05, 0x4f, 0x2a, 0x07, 0xff, 0x99, 0x41, 0x8b, 0x07, 0x00, 0xa2, 0xdf, 0x43,
+	0xd7, 0x82, 0x40, 0x8b, 0xb9, 0xb4, 0xe6, 0xb0, 0xba, 0xa1, 0x15, 0xb5, 0xcd, 0x77, 0xcb, 0xb9,
+	0x41, 0xdf, 0x5c, 0xeb, 0xd1, 0x96, 0x73, 0x0f, 0xc7, 0xbd, 0x98, 0x2c, 0x05, 0xaf, 0x15, 0xf5,
+	0xa6, 0x7f, 0x8d, 0x92, 0x2d, 0xee, 0x5a, 0x10, 0x21, 0xa9, 0xcf, 0x8c, 0x4b, 0x45, 0x6d, 0xf3,
+	0x6a, 0xf9, 0xfe, 0x8b, 0xbe, 0x99, 0xf8, 0xbb, 0x6f, 0xde, 0x6a, 0x70, 0xbf, 0xd9, 0xa9, 0x95,
+	0x6c, 0xd1, 0xda, 0xb2, 0x85, 0xd7, 0x12, 0xde, 0xf0, 0xe7, 0xb6, 0x57, 0x7f, 0xb2, 0xe5, 0xf7,
+	0xda, 0xcc, 0x2b, 0xed, 0x32, 0x7b, 0xd0, 0x37, 0xd3, 0x8a, 0x6a, 0x0c, 0x0c, 0x93, 0xa5, 0x16,
+	0x77, 0xcb, 0x1d, 0xe9, 0x12, 0xea, 0x33, 0xe0, 0xa2, 0xdd, 0x18, 0xd7, 0xe5, 0xb7, 0xe4, 0xa2,
+	0xdd, 0x71, 0x2e, 0xda, 0x1d, 0x71, 0x79, 0x28, 0xe5, 0x53, 0xd9, 0x60, 0x7e, 0x8c, 0x6e, 0x01,
+	0xe8, 0xaa, 0x73, 0xd3, 0xe5, 0x14, 0xdd, 0x24, 0x1e, 0x26, 0xcb, 0xca, 0x34, 0x22, 0x7d, 0x8a,
+	0x56, 0x1d, 0x71, 0x6a, 0x35, 0xa8, 0x67, 0xf9, 0x4d, 0xc9, 0xbc, 0xa6, 0x70, 0xea, 0xc6, 0x15,
+	0x60, 0xfd, 0x74, 0x6e, 0x56, 0x43, 0xb1, 0x4e, 0x01, 0x62, 0xb2, 0xe2, 0x88, 0xd3, 0x07, 0xd4,
+	0x3b, 0x0a, 0x2d, 0x7a, 0x0f, 0xe9, 0x4d, 0xde, 0x68, 0x4e, 0x10, 0x2f, 0x02, 0xf1, 0xc3, 0xb9,
+	0x89, 0xd7, 0x15, 0xf1, 0x34, 0x22, 0x26, 0xa9, 0xc0, 0x38, 0x46, 0x5d, 0x41, 0xa9, 0x20, 0xa6,
+	0xe3, 0xd1, 0x06, 0xb3, 0x4e, 0xb9, 0x5b, 0x17, 0xa7, 0xc6, 0x3b, 0x45, 0x6d, 0x73, 0xa1, 0x7c,
+	0x3d, 0xaa, 0xdc, 0x64, 0x04, 0x26, 0xcb, 0x0d, 0xea, 0x1d, 0x07, 0x96, 0x2f, 0xc0, 0xa0, 0x57,
+	0xd1, 0x2a, 0x77, 0x4f, 0x1c, 0xea, 0x73, 0x11, 0xf5, 0x31, 0x82, 0x3e, 0xde, 0x88, 0x6a, 0x31,
+	0x15, 0x82, 0x49, 0x6a, 0x64, 0x0b, 0x3b, 0xfa, 0x47, 0x0d, 0xad, 0x07, 0x9d, 0x41, 0x5d, 0xb7,
+	0x43, 0x1d, 0x2b, 0xca, 0x81, 0x1e, 0x58, 0x82, 0xa2, 0x90, 0xb9, 0x8b, 0x52, 0x8c, 0x5a, 0x6e,
+	0x26, 0x30, 0x26, 0xd9, 0x16, 0xed, 0x6e, 0x83, 0xab, 0x1a, 0x7a, 0xa0, 0x29, 0x7e, 0xd1, 0xd0,
+	0x46, 0x90, 0xe6, 0x32, 0xdf, 0xf2, 0x3a, 0xed, 0xb6, 0xd3, 0x83, 0x04, 0xab, 0xcd, 0xa4, 0xd5,
+	0x63, 0x54, 0x1a, 0xd7, 0x40, 0xd2, 0xf1, 0xdc, 0x92, 0x6e, 0x46, 0x92, 0xde, 0x84, 0x8d, 0x49,
+	0xae, 0x45, 0xbb, 0x7b, 0xcc, 0x3f, 0x04, 0x67, 0xa0, 0xe7, 0x80, 0xc9, 0xaf, 0x18, 0x95, 0xba,
+	0x8b, 0x96, 0xb9, 0xcb, 0x7d, 0x4e, 0x9d, 0x61, 0xa6, 0x91, 0x04, 0x1d, 0x0f, 0xe6, 0xd0, 0x51,
+	0x75, 0xfd, 0x41, 0xdf, 0xcc, 0x84, 0x9b, 0x13, 0x47, 0xc3, 0x24, 0x39, 0x34, 0x28, 0x6a, 0xfd,
+	0x67, 0x0d, 0x5d, 0x0f, 0x0e, 0x87, 0xa8, 0x17, 0x4e, 0x84, 0x8c, 0x8a, 0x68, 0x2c, 0x03, 0xfb,
+	0xd1, 0xdc, 0x55, 0xc0, 0xd1, 0xb9, 0xf3, 0x06, 0xe8, 0xa0, 0x08, 0xdc, 0x7d, 0x30, 0x6c, 0xb8,
+	0xfb, 0x42, 0x8e, 0xf6, 0x47, 0x2f, 0xa3, 0x15, 0xd6, 0x16, 0x76, 0xd3, 0x8b, 0x76, 0x63, 0x05,
+	0x9a, 0x37, 0x3f, 0xe8, 0x9b, 0x59, 0x85, 0x3c, 0x11, 0x80, 0x49, 0x52, 0x59, 0xc2, 0x42, 0x1e,
+	0xa1, 0x8c, 0x64, 0x4f, 0x99, 0xf4, 0x98, 0x55, 0x93, 0xf4, 0x09, 0x1b, 0xb5, 0x6f, 0x1a, 0xda,
+	0xb7, 0x38, 0xe8, 0x9b, 0x1b, 0x0a, 0x69, 0x66, 0x18, 0x26, 0x6b, 0x43, 0x7b, 0x39, 0x30, 0x87,
+	0x5d, 0xcc, 0xd0, 0xf5, 0xf1, 0x70, 0x5f, 0xf2, 0x46, 0x83, 0x49, 0xcb, 0x16, 0x1d, 0xd7, 0x37,
+	0x32, 0x45, 0x6d, 0x33, 0x59, 0xbe, 0x15, 0xad, 0xff, 0x82, 0x60, 0x4c, 0x8c, 0x38, 0xc3, 0x91,
+	0xf2, 0xed, 0x04, 0x2e, 0xfd, 0x57, 0x0d, 0x6d, 0x8c, 0xa7, 0x4a, 0x56, 0xef, 0xd8, 0xd1, 0xf7,
+	0x92, 0x7d, 0xbb, 0xe6, 0xbc, 0x08, 0x1b, 0x93, 0xf5, 0xb8, 0x2e, 0x12, 0x3a, 0xe1, 0xab, 0x79,
+	0x8c, 0x72, 0xdc, 0xb5, 0x45, 0x8b, 0x59, 0x5e, 0x4b, 0x08, 0xbf, 0xc9, 0xe4, 0xa8, 0xae, 0x05,
+	0xa8, 0x2b, 0x1e, 0xf4, 0xcd, 0x42, 0xd8, 0x79, 0x33, 0x03, 0x31, 0xc9, 0x28, 0xcf, 0xe1, 0xd0,
+	0x11, 0xd6, 0xf6, 0x07, 0x0d, 0x19, 0xb5, 0xce, 0xc9, 0x09, 0x14, 0xc8, 0xf5, 0x25, 0xaf, 0x75,
+	0xa2, 0x05, 0x9b, 0xb0, 0xe0, 0xcf, 0xe7, 0x5e, 0xb0, 0x19, 0x5e, 0xb5, 0xb3, 0x71, 0x31, 0xc9,
+	0x2a, 0xd7, 0x4e, 0xcc, 0x03, 0x2b, 0xfd, 0x06, 0xad, 0x0d, 0x93, 0x24, 0x73, 0x18, 0xf5, 0x98,
+	0xd2, 0x51, 0x04, 0x1d, 0x8f, 0xe6, 0xd6, 0x91, 0x1f, 0xd3, 0x11, 0x87, 0xc4, 0x64, 0x55, 0x59,
+	0x89, 0x32, 0x02, 0xfb, 0x73, 0x0d, 0xe5, 0xe0, 0xa4, 0xa7, 0xb6, 0xcf, 0x9f, 0x72, 0xbf, 0x17,
+	0xbb, 0x40, 0xde, 0x03, 0x09, 0x07, 0x73, 0x4b, 0x28, 0xc4, 0x2e, 0x90, 0x69, 0x58, 0x4c, 0x32,
+	0x81, 0x67, 0x7b, 0xe8, 0x88, 0xae, 0x92, 0xef, 0x35, 0x94, 0x0d, 0x6e, 0xbb, 0x19, 0x4a, 0x30,
+	0x28, 0xd9, 0x9f, 0x5b, 0xc9, 0x8d, 0xe8, 0x0e, 0x9d, 0x25, 0x24, 0xed, 0x88, 0xd3, 0x69, 0x1d,
+	0x6d, 0xb4, 0xa2, 0x26, 0x0b, 0xa8, 0xa0, 0xc7, 0x9f, 0x31, 0xe3, 0x26, 0xf0, 0x7f, 0x32, 0x37,
+	0x7f, 0x36, 0x3e, 0xa8, 0x8c, 0xe0, 0x30, 0x49, 0xc2, 0xa8, 0x12, 0x18, 0x0e, 0xf9, 0x33, 0x16,
+	0x1c, 0x21, 0x6a, 0xaa, 0x60, 0xb6, 0x90, 0x75, 0x4b, 0x32, 0x9f, 0xb9, 0x70, 0x28, 0x6e, 0xc2,
+	0x61, 0x14, 0x3b, 0x42, 0x66, 0x86, 0x61, 0xb2, 0x16, 0xd8, 0x09, 0x98, 0x49, 0x68, 0xd5, 0xbf,
+	0xd5, 0xd0, 0x5a, 0x9d, 0xb9, 0xa2, 0xa5, 0x46, 0x96, 0xb6, 0x70, 0xb8, 0xcd, 0x99, 0x67, 0xbc,
+	0x5f, 0xbc, 0xbc, 0xb9, 0x74, 0xe7, 0x76, 0xe9, 0xa2, 0xa1, 0xb3, 0xb4, 0x1b, 0x24, 0x06, 0x83,
+	0xcd, 0x41, 0x90, 0xd6, 0x2b, 0xe3, 0x60, 0xed, 0x51, 0x7b, 0xcd, 0xc0, 0xc5, 0x64, 0xb5, 0x3e,
+	0x96, 0xc4, 0x99, 0x77, 0x6f, 0xe1, 0xb7, 0xdf, 0xcd, 0x04, 0xfe, 0xee, 0x12, 0x5a, 0x99, 0x00,
+	0xd4, 0x6f, 0xa1, 0x2b, 0x10, 0x0e, 0xd3, 0xea, 0xd5, 0x72, 0x6a, 0xd0, 0x37, 0xaf, 0xc5, 0xb0,
+	0x31, 0x51, 0x6e, 0xfd, 0x4b, 0xb4, 0x48, 0xe1, 0x58, 0x80, 0xc9, 0x74, 0xf9, 0x7f, 0xeb, 0xde,
+	0x86, 0xa4, 0xf2, 0xea, 0xa0, 0x6f, 0x26, 0x15, 0xae, 0x82, 0xc1, 0x64, 0x88, 0xa7, 0xdf, 0x41,
+	0x57, 0x25, 0xb3, 0x79, 0x9b, 0x33, 0xd7, 0x1f, 0x8e, 0xa2, 0xe9, 0x41, 0xdf, 0x4c, 0x85, 0x27,
+	0xd7, 0xd0, 0x85, 0x49, 0x14, 0x16, 0x8c, 0xda, 0x42, 0x52, 0xdb, 0x61, 0x96, 0x12, 0xaf, 0x46,
+	0xca, 0xd8, 0xa8, 0x1d, 0xf7, 0x62, 0xb2, 0xa4, 0x5e, 0x41, 0xd4, 0x07, 0xff, 0x6a, 0x68, 0x65,
+	0x42, 0x9e, 0xbe, 0x8d, 0x6e, 0xec, 0x56, 0xf6, 0xf6, 0x3f, 0xb3, 0xca, 0xc7, 0x64, 0xcf, 0xda,
+	0xde, 0x39, 0xaa, 0xee, 0xef, 0x59, 0xc7, 0x7b, 0x87, 0x07, 0x95, 0x9d, 0xea, 0xfd, 0x6a, 0x65,
+	0x37, 0x95, 0xc8, 0x17, 0xce, 0xce, 0x8b, 0xf9, 0x89, 0xbc, 0x63, 0xd7, 0x6b, 0x33, 0x9b, 0x9f,
+	0x70, 0x56, 0xd7, 0xef, 0xa2, 0xec, 0x34, 0x44, 0xf0, 0x9c, 0xd2, 0xf2, 0xb9, 0xb3, 0xf3, 0xe2,
+	0xda, 0x64, 0x49, 0x3a, 0xd2, 0xd5, 0x3f, 0x42, 0xb9, 0xe9, 0x24, 0xb2, 0x7f, 0x7c, 0x54, 0x49,
+	0x5d, 0xca, 0x1b, 0x67, 0xe7, 0xc5, 0xf4, 0x44, 0x16, 0x11, 0x1d, 0x9f, 0xe9, 0x1f, 0x23, 0x63,
+	0x3a, 0x6d, 0x9f, 0x6c, 0xef, 0x3c, 0xaa, 0xa4, 0x2e, 0xe7, 0xd7, 0xcf, 0xce, 0x8b, 0x99, 0x89,
+	0xbc, 0x7d, 0x28, 0x40, 0x7e, 0xe1, 0xf9, 0x1f, 0x85, 0x44, 0xf9, 0xe1, 0x8b, 0x57, 0x05, 0xed,
+	0xe5, 0xab, 0x82, 0xf6, 0xcf, 0xab, 0x82, 0xf6, 0xd3, 0xeb, 0x42, 0xe2, 0xe5, 0xeb, 0x42, 0xe2,
+	0xaf, 0xd7, 0x85, 0xc4, 0xe3, 0x0f, 0x63, 0x9f, 0x94, 0xc7, 0xf8, 0xed, 0x70, 0x83, 0xe1, 0x05,
+	0x76, 0x78, 0xab, 0xbb, 0x15, 0xfe, 0x77, 0x82, 0x2f, 0xac, 0xb6, 0x08, 0x31, 0x77, 0xff, 0x1b,
+	0x00, 0x18, 0x37, 0x27, 0xae, 0x53, 0x0d, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -232,6 +394,29 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DenomBurnPolicies) > 0 {
+		for iNdEx := len(m.DenomBurnPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomBurnPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if m.BurnRecordRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BurnRecordRetention))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc0
+	}
 	{
 		size := m.MaxBufferSize.Size()
 		i -= size
@@ -390,6 +575,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x50
 	}
+	if m.GasUsageWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.GasUsageWindow))
+		i--
+		dAtA[i] = 0x38
+	}
 	{
 		size := m.HighGasThreshold.Size()
 		i -= size
@@ -453,6 +643,55 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomBurnPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomBurnPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomBurnPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OracleDenom) > 0 {
+		i -= len(m.OracleDenom)
+		copy(dAtA[i:], m.OracleDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.OracleDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Action != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -483,6 +722,9 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.HighGasThreshold.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.GasUsageWindow != 0 {
+		n += 1 + sovParams(uint64(m.GasUsageWindow))
+	}
 	if m.InflationEnabled {
 		n += 2
 	}
@@ -518,6 +760,39 @@ func (m *Params) Size() (n int) {
 	n += 2 + l + sovParams(uint64(l))
 	l = m.MaxBufferSize.Size()
 	n += 2 + l + sovParams(uint64(l))
+	if m.BurnRecordRetention != 0 {
+		n += 2 + sovParams(uint64(m.BurnRecordRetention))
+	}
+	if len(m.DenomBurnPolicies) > 0 {
+		for _, e := range m.DenomBurnPolicies {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomBurnPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovParams(uint64(m.Action))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.OracleDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -746,6 +1021,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsageWindow", wireType)
+			}
+			m.GasUsageWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsageWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 10:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field InflationEnabled", wireType)
@@ -1184,6 +1478,224 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 40:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRecordRetention", wireType)
+			}
+			m.BurnRecordRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BurnRecordRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomBurnPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomBurnPolicies = append(m.DenomBurnPolicies, DenomBurnPolicy{})
+			if err := m.DenomBurnPolicies[len(m.DenomBurnPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomBurnPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomBurnPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomBurnPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= DenomBurnAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
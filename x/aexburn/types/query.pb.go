@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -444,772 +445,3577 @@ func (m *QueryNetSupplyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryNetSupplyResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "seiprotocol.seichain.aexburn.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "seiprotocol.seichain.aexburn.QueryParamsResponse")
-	proto.RegisterType((*QueryBurnStatsRequest)(nil), "seiprotocol.seichain.aexburn.QueryBurnStatsRequest")
-	proto.RegisterType((*QueryBurnStatsResponse)(nil), "seiprotocol.seichain.aexburn.QueryBurnStatsResponse")
-	proto.RegisterType((*QueryInflationStatsRequest)(nil), "seiprotocol.seichain.aexburn.QueryInflationStatsRequest")
-	proto.RegisterType((*QueryInflationStatsResponse)(nil), "seiprotocol.seichain.aexburn.QueryInflationStatsResponse")
-	proto.RegisterType((*QueryMonthlyBurnDataRequest)(nil), "seiprotocol.seichain.aexburn.QueryMonthlyBurnDataRequest")
-	proto.RegisterType((*QueryMonthlyBurnDataResponse)(nil), "seiprotocol.seichain.aexburn.QueryMonthlyBurnDataResponse")
-	proto.RegisterType((*QueryNetSupplyRequest)(nil), "seiprotocol.seichain.aexburn.QueryNetSupplyRequest")
-	proto.RegisterType((*QueryNetSupplyResponse)(nil), "seiprotocol.seichain.aexburn.QueryNetSupplyResponse")
+// QueryEpochGasUsageRequest is the request type for the Query/EpochGasUsage RPC method.
+type QueryEpochGasUsageRequest struct {
+	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
 }
 
-func init() { proto.RegisterFile("aexburn/query.proto", fileDescriptor_8c657c48b078c0ed) }
-
-var fileDescriptor_8c657c48b078c0ed = []byte{
-	// 747 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0x9b, 0xfd, 0xa9, 0x34, 0x4f, 0xac, 0xcc, 0xfb, 0x57, 0xb2, 0xae, 0x9b, 0xc2, 0x04,
-	0x13, 0x62, 0x09, 0xed, 0x38, 0x00, 0x37, 0xba, 0x5d, 0x26, 0x18, 0x82, 0x4e, 0x42, 0x30, 0x0e,
-	0x91, 0x9b, 0x7a, 0x69, 0x44, 0x62, 0x67, 0xb1, 0x0b, 0x2d, 0x17, 0x24, 0x3e, 0x00, 0x42, 0xe2,
-	0xc8, 0x87, 0xe0, 0xc2, 0x95, 0xfb, 0x2e, 0x48, 0x93, 0xb8, 0x20, 0x0e, 0x13, 0xda, 0xf8, 0x20,
-	0x28, 0x8e, 0x93, 0x2e, 0x6d, 0x55, 0x75, 0xe5, 0xb2, 0xa5, 0xef, 0x1b, 0x3f, 0xcf, 0x2f, 0xf6,
-	0xeb, 0x07, 0xcc, 0x21, 0xdc, 0xaa, 0x35, 0x03, 0x62, 0x1c, 0x35, 0x71, 0xd0, 0xd6, 0xfd, 0x80,
-	0x72, 0x0a, 0x0b, 0x0c, 0x3b, 0xe2, 0xc9, 0xa2, 0xae, 0xce, 0xb0, 0x63, 0x35, 0x90, 0x43, 0x74,
-	0xf9, 0xa6, 0x3a, 0x6f, 0x53, 0x9b, 0x8a, 0xb6, 0x11, 0x3e, 0x45, 0x6b, 0xd4, 0x82, 0x4d, 0xa9,
-	0xed, 0x62, 0x03, 0xf9, 0x8e, 0x81, 0x08, 0xa1, 0x1c, 0x71, 0x87, 0x12, 0x26, 0xbb, 0xf3, 0xb1,
-	0x8d, 0x8f, 0x02, 0xe4, 0xc5, 0x55, 0x18, 0x57, 0xc3, 0x3f, 0x51, 0x4d, 0x9b, 0x07, 0xf0, 0x59,
-	0x88, 0xf2, 0x54, 0xbc, 0x58, 0xc5, 0x47, 0x4d, 0xcc, 0xb8, 0xf6, 0x12, 0xcc, 0xa5, 0xaa, 0xcc,
-	0xa7, 0x84, 0x61, 0x58, 0x01, 0xd9, 0x48, 0x30, 0xaf, 0xac, 0x29, 0x1b, 0xd3, 0xe5, 0x75, 0x7d,
-	0x10, 0xb9, 0x1e, 0xad, 0xae, 0x4c, 0x1c, 0x9f, 0xae, 0x66, 0xaa, 0x72, 0xa5, 0xb6, 0x04, 0x16,
-	0x84, 0x74, 0xa5, 0x19, 0x90, 0x7d, 0x8e, 0x78, 0xe2, 0x79, 0x08, 0x16, 0xbb, 0x1b, 0xd2, 0xf6,
-	0x31, 0x00, 0xa1, 0x9e, 0xc9, 0xc2, 0xaa, 0xb4, 0xbe, 0x39, 0xd8, 0x3a, 0x11, 0x91, 0xee, 0x53,
-	0xb5, 0xb8, 0xa0, 0x15, 0x80, 0x2a, 0x7c, 0x76, 0xc9, 0xa1, 0x2b, 0x36, 0x2d, 0x45, 0xf1, 0x0e,
-	0x2c, 0xf7, 0xed, 0x4a, 0x94, 0x57, 0x20, 0xe7, 0xc4, 0x9d, 0x14, 0xcf, 0xed, 0xc1, 0x3c, 0x69,
-	0x39, 0x09, 0x35, 0xe3, 0xa4, 0xaa, 0xda, 0x8a, 0xf4, 0xde, 0xa3, 0x84, 0x37, 0x5c, 0xb1, 0x11,
-	0x3b, 0x88, 0xa3, 0x18, 0xed, 0x3d, 0x28, 0xf4, 0x6f, 0x4b, 0x36, 0x13, 0xcc, 0x7a, 0x51, 0xcb,
-	0x14, 0xdb, 0x55, 0x47, 0x1c, 0xe5, 0x95, 0xb5, 0xf1, 0x8d, 0xe9, 0xf2, 0xe6, 0x60, 0xba, 0x2e,
-	0x45, 0x89, 0x97, 0xf3, 0xd2, 0xe5, 0xe4, 0xe8, 0x9e, 0x60, 0xbe, 0xdf, 0xf4, 0x7d, 0xb7, 0x1d,
-	0x93, 0xfd, 0x98, 0x90, 0x67, 0x77, 0xa1, 0x23, 0xa1, 0x5e, 0x80, 0xab, 0x9c, 0x72, 0xe4, 0x9a,
-	0x9e, 0x43, 0x38, 0xae, 0x9b, 0xa5, 0xb2, 0x27, 0x76, 0x6c, 0xaa, 0xa2, 0x87, 0x26, 0xbf, 0x4f,
-	0x57, 0x6f, 0xd8, 0x0e, 0x6f, 0x34, 0x6b, 0xba, 0x45, 0x3d, 0xc3, 0xa2, 0xcc, 0xa3, 0x4c, 0xfe,
-	0xdb, 0x64, 0xf5, 0xd7, 0x06, 0x6f, 0xfb, 0x98, 0xe9, 0xbb, 0x84, 0x57, 0x67, 0x84, 0xce, 0x9e,
-	0x90, 0x29, 0x95, 0xbd, 0x8e, 0x72, 0xf8, 0x09, 0x52, 0x79, 0xec, 0x3f, 0x94, 0x2b, 0x42, 0x26,
-	0x54, 0x3e, 0x00, 0xb3, 0x04, 0x73, 0x93, 0x89, 0x2f, 0x31, 0xad, 0x06, 0x22, 0x36, 0xce, 0x8f,
-	0x8f, 0x24, 0x9d, 0x23, 0xf1, 0x8e, 0x6c, 0x0b, 0x19, 0xf8, 0x1c, 0xe4, 0x2e, 0x68, 0x07, 0x88,
-	0xe3, 0xfc, 0xc4, 0xa5, 0x95, 0x77, 0xb0, 0x55, 0xbd, 0x92, 0x28, 0x57, 0x11, 0xc7, 0xd0, 0x02,
-	0x8b, 0x1e, 0x6a, 0x99, 0xc8, 0x75, 0xe9, 0x5b, 0x5c, 0x37, 0x3b, 0x1e, 0xf9, 0xc9, 0x91, 0xc0,
-	0xe7, 0x3c, 0xd4, 0x7a, 0x18, 0x89, 0x25, 0x87, 0x0a, 0x0f, 0xc1, 0x52, 0x80, 0x3d, 0xe4, 0x10,
-	0x87, 0xd8, 0xe2, 0x40, 0x4d, 0x0b, 0xf9, 0xc8, 0x72, 0x78, 0x3b, 0x9f, 0x1d, 0xc9, 0x65, 0x21,
-	0x91, 0x0b, 0xcf, 0x75, 0x5b, 0x8a, 0x95, 0xbf, 0x67, 0xc1, 0xa4, 0x98, 0x27, 0xf8, 0x51, 0x01,
-	0xd9, 0x28, 0x46, 0xe0, 0x9d, 0xc1, 0x33, 0xdc, 0x9b, 0x62, 0x6a, 0xe9, 0x12, 0x2b, 0xa2, 0x71,
-	0xd5, 0x56, 0x3f, 0xfc, 0xfc, 0xfb, 0x79, 0xec, 0x1a, 0x5c, 0x32, 0x10, 0x66, 0x96, 0x11, 0x07,
-	0xe6, 0x9b, 0x92, 0x4c, 0x52, 0xf8, 0x45, 0x01, 0x53, 0x49, 0xb8, 0xc0, 0xad, 0x21, 0x1c, 0xba,
-	0x83, 0x4e, 0xbd, 0x7b, 0xb9, 0x45, 0x92, 0xec, 0xba, 0x20, 0x5b, 0x81, 0xcb, 0x3d, 0x64, 0x9d,
-	0x6c, 0x84, 0x5f, 0x15, 0x30, 0x93, 0x8e, 0x1a, 0x78, 0x6f, 0x08, 0xb7, 0xbe, 0x51, 0xa8, 0xde,
-	0x1f, 0x61, 0xa5, 0x84, 0xdd, 0x10, 0xb0, 0x1a, 0x5c, 0xeb, 0x81, 0xed, 0x4a, 0x4f, 0xf8, 0x4d,
-	0x01, 0xb9, 0xae, 0xf8, 0x81, 0xc3, 0x18, 0xf7, 0xcf, 0x48, 0xf5, 0xc1, 0x28, 0x4b, 0x25, 0xf4,
-	0x2d, 0x01, 0xbd, 0x0e, 0xb5, 0x1e, 0xe8, 0x9e, 0x58, 0x15, 0x63, 0xd0, 0xb9, 0x17, 0xc3, 0x8c,
-	0x41, 0x77, 0x68, 0x0e, 0x35, 0x06, 0x3d, 0x79, 0x3a, 0x60, 0x0c, 0x3a, 0x57, 0xbe, 0xf2, 0xe8,
-	0xf8, 0xac, 0xa8, 0x9c, 0x9c, 0x15, 0x95, 0x3f, 0x67, 0x45, 0xe5, 0xd3, 0x79, 0x31, 0x73, 0x72,
-	0x5e, 0xcc, 0xfc, 0x3a, 0x2f, 0x66, 0x0e, 0x4a, 0x17, 0x2e, 0x26, 0xc3, 0xce, 0x66, 0xec, 0x2f,
-	0x7e, 0x08, 0x00, 0xa3, 0x95, 0x88, 0x8a, 0x7b, 0x5a, 0xcb, 0x8a, 0x77, 0xb6, 0xfe, 0x05, 0x00,
-	0x00, 0xff, 0xff, 0x48, 0x4f, 0xdc, 0x64, 0xbb, 0x08, 0x00, 0x00,
+func (m *QueryEpochGasUsageRequest) Reset()         { *m = QueryEpochGasUsageRequest{} }
+func (m *QueryEpochGasUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochGasUsageRequest) ProtoMessage()    {}
+func (*QueryEpochGasUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c657c48b078c0ed, []int{10}
+}
+func (m *QueryEpochGasUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochGasUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochGasUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochGasUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochGasUsageRequest.Merge(m, src)
+}
+func (m *QueryEpochGasUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochGasUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochGasUsageRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryEpochGasUsageRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params returns the module parameters
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// BurnStats returns the cumulative burn statistics
-	BurnStats(ctx context.Context, in *QueryBurnStatsRequest, opts ...grpc.CallOption) (*QueryBurnStatsResponse, error)
-	// InflationStats returns the cumulative inflation statistics
-	InflationStats(ctx context.Context, in *QueryInflationStatsRequest, opts ...grpc.CallOption) (*QueryInflationStatsResponse, error)
-	// MonthlyBurnData returns the 12-month rolling window supply data
-	MonthlyBurnData(ctx context.Context, in *QueryMonthlyBurnDataRequest, opts ...grpc.CallOption) (*QueryMonthlyBurnDataResponse, error)
-	// NetSupply returns the current net supply change in the 12-month window
-	NetSupply(ctx context.Context, in *QueryNetSupplyRequest, opts ...grpc.CallOption) (*QueryNetSupplyResponse, error)
+func (m *QueryEpochGasUsageRequest) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryEpochGasUsageResponse is the response type for the Query/EpochGasUsage RPC method.
+type QueryEpochGasUsageResponse struct {
+	EpochGasUsage EpochGasUsage `protobuf:"bytes,1,opt,name=epoch_gas_usage,json=epochGasUsage,proto3" json:"epoch_gas_usage"`
+	// average_usage_rate is the average gas usage rate of the epoch
+	AverageUsageRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=average_usage_rate,json=averageUsageRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"average_usage_rate"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryEpochGasUsageResponse) Reset()         { *m = QueryEpochGasUsageResponse{} }
+func (m *QueryEpochGasUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochGasUsageResponse) ProtoMessage()    {}
+func (*QueryEpochGasUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c657c48b078c0ed, []int{11}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.aexburn.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryEpochGasUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochGasUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochGasUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryEpochGasUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochGasUsageResponse.Merge(m, src)
+}
+func (m *QueryEpochGasUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochGasUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochGasUsageResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) BurnStats(ctx context.Context, in *QueryBurnStatsRequest, opts ...grpc.CallOption) (*QueryBurnStatsResponse, error) {
-	out := new(QueryBurnStatsResponse)
-	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.aexburn.Query/BurnStats", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEpochGasUsageResponse proto.InternalMessageInfo
+
+func (m *QueryEpochGasUsageResponse) GetEpochGasUsage() EpochGasUsage {
+	if m != nil {
+		return m.EpochGasUsage
 	}
-	return out, nil
+	return EpochGasUsage{}
 }
 
-func (c *queryClient) InflationStats(ctx context.Context, in *QueryInflationStatsRequest, opts ...grpc.CallOption) (*QueryInflationStatsResponse, error) {
-	out := new(QueryInflationStatsResponse)
-	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.aexburn.Query/InflationStats", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryCurrentEpochGasUsageRequest is the request type for the Query/CurrentEpochGasUsage RPC method.
+type QueryCurrentEpochGasUsageRequest struct {
 }
 
-func (c *queryClient) MonthlyBurnData(ctx context.Context, in *QueryMonthlyBurnDataRequest, opts ...grpc.CallOption) (*QueryMonthlyBurnDataResponse, error) {
-	out := new(QueryMonthlyBurnDataResponse)
-	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.aexburn.Query/MonthlyBurnData", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCurrentEpochGasUsageRequest) Reset()         { *m = QueryCurrentEpochGasUsageRequest{} }
+func (m *QueryCurrentEpochGasUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCurrentEpochGasUsageRequest) ProtoMessage()    {}
+func (*QueryCurrentEpochGasUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c657c48b078c0ed, []int{12}
+}
+func (m *QueryCurrentEpochGasUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCurrentEpochGasUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCurrentEpochGasUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryCurrentEpochGasUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCurrentEpochGasUsageRequest.Merge(m, src)
+}
+func (m *QueryCurrentEpochGasUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCurrentEpochGasUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCurrentEpochGasUsageRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) NetSupply(ctx context.Context, in *QueryNetSupplyRequest, opts ...grpc.CallOption) (*QueryNetSupplyResponse, error) {
-	out := new(QueryNetSupplyResponse)
-	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.aexburn.Query/NetSupply", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCurrentEpochGasUsageRequest proto.InternalMessageInfo
+
+// QueryCurrentEpochGasUsageResponse is the response type for the Query/CurrentEpochGasUsage RPC method.
+type QueryCurrentEpochGasUsageResponse struct {
+	EpochGasUsage EpochGasUsage `protobuf:"bytes,1,opt,name=epoch_gas_usage,json=epochGasUsage,proto3" json:"epoch_gas_usage"`
+	// average_usage_rate is the average gas usage rate accumulated so far
+	AverageUsageRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=average_usage_rate,json=averageUsageRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"average_usage_rate"`
+}
+
+func (m *QueryCurrentEpochGasUsageResponse) Reset()         { *m = QueryCurrentEpochGasUsageResponse{} }
+func (m *QueryCurrentEpochGasUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCurrentEpochGasUsageResponse) ProtoMessage()    {}
+func (*QueryCurrentEpochGasUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c657c48b078c0ed, []int{13}
+}
+func (m *QueryCurrentEpochGasUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCurrentEpochGasUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCurrentEpochGasUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryCurrentEpochGasUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCurrentEpochGasUsageResponse.Merge(m, src)
+}
+func (m *QueryCurrentEpochGasUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCurrentEpochGasUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCurrentEpochGasUsageResponse.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params returns the module parameters
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// BurnStats returns the cumulative burn statistics
-	BurnStats(context.Context, *QueryBurnStatsRequest) (*QueryBurnStatsResponse, error)
-	// InflationStats returns the cumulative inflation statistics
-	InflationStats(context.Context, *QueryInflationStatsRequest) (*QueryInflationStatsResponse, error)
-	// MonthlyBurnData returns the 12-month rolling window supply data
-	MonthlyBurnData(context.Context, *QueryMonthlyBurnDataRequest) (*QueryMonthlyBurnDataResponse, error)
-	// NetSupply returns the current net supply change in the 12-month window
-	NetSupply(context.Context, *QueryNetSupplyRequest) (*QueryNetSupplyResponse, error)
+var xxx_messageInfo_QueryCurrentEpochGasUsageResponse proto.InternalMessageInfo
+
+func (m *QueryCurrentEpochGasUsageResponse) GetEpochGasUsage() EpochGasUsage {
+	if m != nil {
+		return m.EpochGasUsage
+	}
+	return EpochGasUsage{}
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryReverseBrakeStateRequest is the request type for the Query/ReverseBrakeState RPC method.
+type QueryReverseBrakeStateRequest struct {
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryReverseBrakeStateRequest) Reset()         { *m = QueryReverseBrakeStateRequest{} }
+func (m *QueryReverseBrakeStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReverseBrakeStateRequest) ProtoMessage()    {}
+func (*QueryReverseBrakeStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c657c48b078c0ed, []int{14}
 }
-func (*UnimplementedQueryServer) BurnStats(ctx context.Context, req *QueryBurnStatsRequest) (*QueryBurnStatsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BurnStats not implemented")
+func (m *QueryReverseBrakeStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) InflationStats(ctx context.Context, req *QueryInflationStatsRequest) (*QueryInflationStatsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InflationStats not implemented")
+func (m *QueryReverseBrakeStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReverseBrakeStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) MonthlyBurnData(ctx context.Context, req *QueryMonthlyBurnDataRequest) (*QueryMonthlyBurnDataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MonthlyBurnData not implemented")
+func (m *QueryReverseBrakeStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReverseBrakeStateRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) NetSupply(ctx context.Context, req *QueryNetSupplyRequest) (*QueryNetSupplyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NetSupply not implemented")
+func (m *QueryReverseBrakeStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReverseBrakeStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReverseBrakeStateRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryReverseBrakeStateRequest proto.InternalMessageInfo
+
+// QueryReverseBrakeStateResponse is the response type for the Query/ReverseBrakeState RPC method.
+type QueryReverseBrakeStateResponse struct {
+	ReverseBrakeState ReverseBrakeState `protobuf:"bytes,1,opt,name=reverse_brake_state,json=reverseBrakeState,proto3" json:"reverse_brake_state"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/seiprotocol.seichain.aexburn.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryReverseBrakeStateResponse) Reset()         { *m = QueryReverseBrakeStateResponse{} }
+func (m *QueryReverseBrakeStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReverseBrakeStateResponse) ProtoMessage()    {}
+func (*QueryReverseBrakeStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c657c48b078c0ed, []int{15}
+}
+func (m *QueryReverseBrakeStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReverseBrakeStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReverseBrakeStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryReverseBrakeStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReverseBrakeStateResponse.Merge(m, src)
+}
+func (m *QueryReverseBrakeStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReverseBrakeStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReverseBrakeStateResponse.DiscardUnknown(m)
 }
 
-func _Query_BurnStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBurnStatsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BurnStats(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/seiprotocol.seichain.aexburn.Query/BurnStats",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BurnStats(ctx, req.(*QueryBurnStatsRequest))
+var xxx_messageInfo_QueryReverseBrakeStateResponse proto.InternalMessageInfo
+
+func (m *QueryReverseBrakeStateResponse) GetReverseBrakeState() ReverseBrakeState {
+	if m != nil {
+		return m.ReverseBrakeState
 	}
-	return interceptor(ctx, in, info, handler)
+	return ReverseBrakeState{}
 }
 
-func _Query_InflationStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryInflationStatsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).InflationStats(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/seiprotocol.seichain.aexburn.Query/InflationStats",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).InflationStats(ctx, req.(*QueryInflationStatsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryIncomeBufferRequest is the request type for the Query/IncomeBuffer RPC method.
+type QueryIncomeBufferRequest struct {
 }
 
-func _Query_MonthlyBurnData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMonthlyBurnDataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MonthlyBurnData(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/seiprotocol.seichain.aexburn.Query/MonthlyBurnData",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MonthlyBurnData(ctx, req.(*QueryMonthlyBurnDataRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryIncomeBufferRequest) Reset()         { *m = QueryIncomeBufferRequest{} }
+func (m *QueryIncomeBufferRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIncomeBufferRequest) ProtoMessage()    {}
+func (*QueryIncomeBufferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c657c48b078c0ed, []int{16}
 }
-
-func _Query_NetSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNetSupplyRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).NetSupply(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/seiprotocol.seichain.aexburn.Query/NetSupply",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).NetSupply(ctx, req.(*QueryNetSupplyRequest))
+func (m *QueryIncomeBufferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIncomeBufferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIncomeBufferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryIncomeBufferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIncomeBufferRequest.Merge(m, src)
+}
+func (m *QueryIncomeBufferRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIncomeBufferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIncomeBufferRequest.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "seiprotocol.seichain.aexburn.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "BurnStats",
-			Handler:    _Query_BurnStats_Handler,
-		},
-		{
-			MethodName: "InflationStats",
-			Handler:    _Query_InflationStats_Handler,
-		},
-		{
-			MethodName: "MonthlyBurnData",
-			Handler:    _Query_MonthlyBurnData_Handler,
-		},
-		{
-			MethodName: "NetSupply",
-			Handler:    _Query_NetSupply_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "aexburn/query.proto",
+var xxx_messageInfo_QueryIncomeBufferRequest proto.InternalMessageInfo
+
+// QueryIncomeBufferResponse is the response type for the Query/IncomeBuffer RPC method.
+type QueryIncomeBufferResponse struct {
+	IncomeBuffer IncomeBuffer `protobuf:"bytes,1,opt,name=income_buffer,json=incomeBuffer,proto3" json:"income_buffer"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryIncomeBufferResponse) Reset()         { *m = QueryIncomeBufferResponse{} }
+func (m *QueryIncomeBufferResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIncomeBufferResponse) ProtoMessage()    {}
+func (*QueryIncomeBufferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c657c48b078c0ed, []int{17}
+}
+func (m *QueryIncomeBufferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIncomeBufferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIncomeBufferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryIncomeBufferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIncomeBufferResponse.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryIncomeBufferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIncomeBufferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIncomeBufferResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryIncomeBufferResponse proto.InternalMessageInfo
+
+func (m *QueryIncomeBufferResponse) GetIncomeBuffer() IncomeBuffer {
+	if m != nil {
+		return m.IncomeBuffer
 	}
-	return dAtA[:n], nil
+	return IncomeBuffer{}
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryMintRecordsRequest is the request type for the Query/MintRecords RPC method.
+type QueryMintRecordsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryMintRecordsRequest) Reset()         { *m = QueryMintRecordsRequest{} }
+func (m *QueryMintRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintRecordsRequest) ProtoMessage()    {}
+func (*QueryMintRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c657c48b078c0ed, []int{18}
+}
+func (m *QueryMintRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryBurnStatsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryMintRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintRecordsRequest.Merge(m, src)
 }
-
-func (m *QueryBurnStatsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMintRecordsRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryBurnStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryMintRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintRecordsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryBurnStatsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMintRecordsRequest proto.InternalMessageInfo
+
+func (m *QueryMintRecordsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryBurnStatsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryMintRecordsResponse is the response type for the Query/MintRecords RPC method.
+type QueryMintRecordsResponse struct {
+	MintRecords []MintRecord        `protobuf:"bytes,1,rep,name=mint_records,json=mintRecords,proto3" json:"mint_records"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryBurnStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.BurnStats.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryMintRecordsResponse) Reset()         { *m = QueryMintRecordsResponse{} }
+func (m *QueryMintRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintRecordsResponse) ProtoMessage()    {}
+func (*QueryMintRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c657c48b078c0ed, []int{19}
+}
+func (m *QueryMintRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryInflationStatsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryMintRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintRecordsResponse.Merge(m, src)
 }
-
-func (m *QueryInflationStatsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMintRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintRecordsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryInflationStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryMintRecordsResponse proto.InternalMessageInfo
+
+func (m *QueryMintRecordsResponse) GetMintRecords() []MintRecord {
+	if m != nil {
+		return m.MintRecords
+	}
+	return nil
 }
 
-func (m *QueryInflationStatsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMintRecordsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryInflationStatsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBurnRecordsRequest is the request type for the Query/BurnRecords RPC method.
+type QueryBurnRecordsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// start_height is the lowest block height to return (inclusive, 0 = unbounded)
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the highest block height to return (inclusive, 0 = unbounded)
+	EndHeight int64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
 }
 
-func (m *QueryInflationStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.InflationStats.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryBurnRecordsRequest) Reset()         { *m = QueryBurnRecordsRequest{} }
+func (m *QueryBurnRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnRecordsRequest) ProtoMessage()    {}
+func (*QueryBurnRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c657c48b078c0ed, []int{20}
+}
+func (m *QueryBurnRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryBurnRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnRecordsRequest.Merge(m, src)
+}
+func (m *QueryBurnRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnRecordsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryMonthlyBurnDataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBurnRecordsRequest proto.InternalMessageInfo
+
+func (m *QueryBurnRecordsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryMonthlyBurnDataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryMonthlyBurnDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryBurnRecordsRequest) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
 }
 
-func (m *QueryMonthlyBurnDataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryBurnRecordsRequest) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryMonthlyBurnDataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBurnRecordsResponse is the response type for the Query/BurnRecords RPC method.
+type QueryBurnRecordsResponse struct {
+	BurnRecords []BurnRecord        `protobuf:"bytes,1,rep,name=burn_records,json=burnRecords,proto3" json:"burn_records"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryMonthlyBurnDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MonthlyBurnData) > 0 {
-		for iNdEx := len(m.MonthlyBurnData) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MonthlyBurnData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryBurnRecordsResponse) Reset()         { *m = QueryBurnRecordsResponse{} }
+func (m *QueryBurnRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnRecordsResponse) ProtoMessage()    {}
+func (*QueryBurnRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c657c48b078c0ed, []int{21}
+}
+func (m *QueryBurnRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryNetSupplyRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryBurnRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnRecordsResponse.Merge(m, src)
 }
-
-func (m *QueryNetSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBurnRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnRecordsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryNetSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryBurnRecordsResponse proto.InternalMessageInfo
+
+func (m *QueryBurnRecordsResponse) GetBurnRecords() []BurnRecord {
+	if m != nil {
+		return m.BurnRecords
+	}
+	return nil
 }
 
-func (m *QueryNetSupplyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryBurnRecordsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryNetSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryEmergencyStateRequest is the request type for the Query/EmergencyState RPC method.
+type QueryEmergencyStateRequest struct {
 }
 
-func (m *QueryNetSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.RemainingMintCapacity.Size()
-		i -= size
-		if _, err := m.RemainingMintCapacity.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.MaxAllowedNetSupply.Size()
-		i -= size
-		if _, err := m.MaxAllowedNetSupply.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.NetSupplyRate.Size()
-		i -= size
-		if _, err := m.NetSupplyRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.NetSupplyChange.Size()
-		i -= size
-		if _, err := m.NetSupplyChange.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.TotalBurned_12M.Size()
-		i -= size
-		if _, err := m.TotalBurned_12M.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.TotalMinted_12M.Size()
-		i -= size
-		if _, err := m.TotalMinted_12M.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryEmergencyStateRequest) Reset()         { *m = QueryEmergencyStateRequest{} }
+func (m *QueryEmergencyStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEmergencyStateRequest) ProtoMessage()    {}
+func (*QueryEmergencyStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c657c48b078c0ed, []int{22}
+}
+func (m *QueryEmergencyStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEmergencyStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmergencyStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryEmergencyStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmergencyStateRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryEmergencyStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEmergencyStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmergencyStateRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+var xxx_messageInfo_QueryEmergencyStateRequest proto.InternalMessageInfo
+
+// QueryEmergencyStateResponse is the response type for the Query/EmergencyState RPC method.
+type QueryEmergencyStateResponse struct {
+	EmergencyState EmergencyState `protobuf:"bytes,1,opt,name=emergency_state,json=emergencyState,proto3" json:"emergency_state"`
 }
 
-func (m *QueryBurnStatsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEmergencyStateResponse) Reset()         { *m = QueryEmergencyStateResponse{} }
+func (m *QueryEmergencyStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEmergencyStateResponse) ProtoMessage()    {}
+func (*QueryEmergencyStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c657c48b078c0ed, []int{23}
+}
+func (m *QueryEmergencyStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEmergencyStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmergencyStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryEmergencyStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmergencyStateResponse.Merge(m, src)
+}
+func (m *QueryEmergencyStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEmergencyStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmergencyStateResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBurnStatsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryEmergencyStateResponse proto.InternalMessageInfo
+
+func (m *QueryEmergencyStateResponse) GetEmergencyState() EmergencyState {
+	if m != nil {
+		return m.EmergencyState
 	}
-	var l int
-	_ = l
-	l = m.BurnStats.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return EmergencyState{}
 }
 
-func (m *QueryInflationStatsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "seiprotocol.seichain.aexburn.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "seiprotocol.seichain.aexburn.QueryParamsResponse")
+	proto.RegisterType((*QueryBurnStatsRequest)(nil), "seiprotocol.seichain.aexburn.QueryBurnStatsRequest")
+	proto.RegisterType((*QueryBurnStatsResponse)(nil), "seiprotocol.seichain.aexburn.QueryBurnStatsResponse")
+	proto.RegisterType((*QueryInflationStatsRequest)(nil), "seiprotocol.seichain.aexburn.QueryInflationStatsRequest")
+	proto.RegisterType((*QueryInflationStatsResponse)(nil), "seiprotocol.seichain.aexburn.QueryInflationStatsResponse")
+	proto.RegisterType((*QueryMonthlyBurnDataRequest)(nil), "seiprotocol.seichain.aexburn.QueryMonthlyBurnDataRequest")
+	proto.RegisterType((*QueryMonthlyBurnDataResponse)(nil), "seiprotocol.seichain.aexburn.QueryMonthlyBurnDataResponse")
+	proto.RegisterType((*QueryNetSupplyRequest)(nil), "seiprotocol.seichain.aexburn.QueryNetSupplyRequest")
+	proto.RegisterType((*QueryNetSupplyResponse)(nil), "seiprotocol.seichain.aexburn.QueryNetSupplyResponse")
+	proto.RegisterType((*QueryEpochGasUsageRequest)(nil), "seiprotocol.seichain.aexburn.QueryEpochGasUsageRequest")
+	proto.RegisterType((*QueryEpochGasUsageResponse)(nil), "seiprotocol.seichain.aexburn.QueryEpochGasUsageResponse")
+	proto.RegisterType((*QueryCurrentEpochGasUsageRequest)(nil), "seiprotocol.seichain.aexburn.QueryCurrentEpochGasUsageRequest")
+	proto.RegisterType((*QueryCurrentEpochGasUsageResponse)(nil), "seiprotocol.seichain.aexburn.QueryCurrentEpochGasUsageResponse")
+	proto.RegisterType((*QueryReverseBrakeStateRequest)(nil), "seiprotocol.seichain.aexburn.QueryReverseBrakeStateRequest")
+	proto.RegisterType((*QueryReverseBrakeStateResponse)(nil), "seiprotocol.seichain.aexburn.QueryReverseBrakeStateResponse")
+	proto.RegisterType((*QueryIncomeBufferRequest)(nil), "seiprotocol.seichain.aexburn.QueryIncomeBufferRequest")
+	proto.RegisterType((*QueryIncomeBufferResponse)(nil), "seiprotocol.seichain.aexburn.QueryIncomeBufferResponse")
+	proto.RegisterType((*QueryMintRecordsRequest)(nil), "seiprotocol.seichain.aexburn.QueryMintRecordsRequest")
+	proto.RegisterType((*QueryMintRecordsResponse)(nil), "seiprotocol.seichain.aexburn.QueryMintRecordsResponse")
+	proto.RegisterType((*QueryBurnRecordsRequest)(nil), "seiprotocol.seichain.aexburn.QueryBurnRecordsRequest")
+	proto.RegisterType((*QueryBurnRecordsResponse)(nil), "seiprotocol.seichain.aexburn.QueryBurnRecordsResponse")
+	proto.RegisterType((*QueryEmergencyStateRequest)(nil), "seiprotocol.seichain.aexburn.QueryEmergencyStateRequest")
+	proto.RegisterType((*QueryEmergencyStateResponse)(nil), "seiprotocol.seichain.aexburn.QueryEmergencyStateResponse")
 }
 
-func (m *QueryInflationStatsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.InflationStats.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func init() { proto.RegisterFile("aexburn/query.proto", fileDescriptor_8c657c48b078c0ed) }
+
+var fileDescriptor_8c657c48b078c0ed = []byte{
+	// 1376 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x26, 0x69, 0xa4, 0x3c, 0x27, 0x75, 0x32, 0x49, 0x13, 0x67, 0x9b, 0x38, 0xe9, 0x12,
+	0x42, 0x14, 0x9a, 0xdd, 0x3a, 0x2d, 0x49, 0x0b, 0xa8, 0x08, 0xa7, 0xa5, 0x54, 0xd0, 0xaa, 0x75,
+	0x55, 0x44, 0x0b, 0xd2, 0x6a, 0xbc, 0x9e, 0xac, 0x57, 0xf5, 0xee, 0xba, 0xbb, 0xe3, 0x90, 0x80,
+	0x10, 0x12, 0x17, 0x6e, 0x08, 0x89, 0x23, 0xc7, 0x1e, 0xb8, 0xc1, 0x05, 0x71, 0x81, 0x3f, 0xa0,
+	0x1c, 0x90, 0x2a, 0x21, 0x24, 0x84, 0x50, 0x85, 0x12, 0xfe, 0x10, 0xb4, 0xb3, 0xb3, 0xde, 0xcf,
+	0xfa, 0x0b, 0x38, 0x70, 0x49, 0xec, 0xf7, 0xe6, 0xfd, 0xde, 0xef, 0xbd, 0xf9, 0x78, 0x3f, 0xc3,
+	0x0c, 0x26, 0x07, 0xd5, 0x96, 0x63, 0x29, 0x0f, 0x5b, 0xc4, 0x39, 0x94, 0x9b, 0x8e, 0x4d, 0x6d,
+	0xb4, 0xe8, 0x12, 0x83, 0x7d, 0xd2, 0xec, 0x86, 0xec, 0x12, 0x43, 0xab, 0x63, 0xc3, 0x92, 0xf9,
+	0x4a, 0x71, 0x56, 0xb7, 0x75, 0x9b, 0xb9, 0x15, 0xef, 0x93, 0x1f, 0x23, 0x2e, 0xea, 0xb6, 0xad,
+	0x37, 0x88, 0x82, 0x9b, 0x86, 0x82, 0x2d, 0xcb, 0xa6, 0x98, 0x1a, 0xb6, 0xe5, 0x72, 0xef, 0x86,
+	0x66, 0xbb, 0xa6, 0xed, 0x2a, 0x55, 0xec, 0x12, 0x3f, 0x95, 0xb2, 0x5f, 0xaa, 0x12, 0x8a, 0x4b,
+	0x4a, 0x13, 0xeb, 0x86, 0xc5, 0x16, 0xf3, 0xb5, 0xb3, 0x01, 0xa5, 0x26, 0x76, 0xb0, 0x19, 0x20,
+	0xa0, 0xc0, 0xea, 0xfd, 0xf1, 0x6d, 0xd2, 0x2c, 0xa0, 0xdb, 0x1e, 0xd6, 0x2d, 0xb6, 0xb0, 0x42,
+	0x1e, 0xb6, 0x88, 0x4b, 0xa5, 0x7b, 0x30, 0x13, 0xb3, 0xba, 0x4d, 0xdb, 0x72, 0x09, 0x2a, 0xc3,
+	0x98, 0x0f, 0x58, 0x10, 0x56, 0x84, 0xf5, 0xdc, 0xd6, 0xaa, 0xdc, 0xa9, 0x4a, 0xd9, 0x8f, 0x2e,
+	0x8f, 0x3e, 0x7e, 0xba, 0x3c, 0x54, 0xe1, 0x91, 0xd2, 0x3c, 0x9c, 0x62, 0xd0, 0xe5, 0x96, 0x63,
+	0xdd, 0xa1, 0x98, 0xb6, 0x73, 0xee, 0xc1, 0x5c, 0xd2, 0xc1, 0xd3, 0xbe, 0x0d, 0xe0, 0xe1, 0xa9,
+	0xae, 0x67, 0xe5, 0xa9, 0x5f, 0xe8, 0x9c, 0xba, 0x0d, 0xc2, 0xb3, 0x8f, 0x57, 0x03, 0x83, 0xb4,
+	0x08, 0x22, 0xcb, 0x73, 0xdd, 0xda, 0x6b, 0xb0, 0x9e, 0xc5, 0x58, 0x7c, 0x08, 0xa7, 0x33, 0xbd,
+	0x9c, 0xca, 0x7b, 0x90, 0x37, 0x02, 0x4f, 0x8c, 0xcf, 0xd9, 0xce, 0x7c, 0xe2, 0x70, 0x9c, 0xd4,
+	0x49, 0x23, 0x66, 0x95, 0x96, 0x78, 0xee, 0x1b, 0xb6, 0x45, 0xeb, 0x0d, 0xd6, 0x88, 0x2b, 0x98,
+	0xe2, 0x80, 0xda, 0x27, 0xb0, 0x98, 0xed, 0xe6, 0xdc, 0x54, 0x98, 0x36, 0x7d, 0x97, 0xca, 0xda,
+	0x55, 0xc3, 0x14, 0x17, 0x84, 0x95, 0x91, 0xf5, 0xdc, 0xd6, 0x66, 0x67, 0x76, 0x09, 0x44, 0x4e,
+	0x2f, 0x6f, 0xc6, 0xcd, 0xed, 0xad, 0xbb, 0x49, 0xe8, 0x9d, 0x56, 0xb3, 0xd9, 0x38, 0x0c, 0x98,
+	0xfd, 0x3c, 0x0a, 0x73, 0x49, 0x0f, 0x27, 0xf5, 0x2e, 0x4c, 0x51, 0x9b, 0xe2, 0x86, 0x6a, 0x1a,
+	0x16, 0x25, 0x35, 0xb5, 0xb4, 0x65, 0xb2, 0x8e, 0x8d, 0x97, 0x65, 0x2f, 0xc9, 0xef, 0x4f, 0x97,
+	0xd7, 0x74, 0x83, 0xd6, 0x5b, 0x55, 0x59, 0xb3, 0x4d, 0x85, 0x1f, 0x71, 0xff, 0xdf, 0xa6, 0x5b,
+	0x7b, 0xa0, 0xd0, 0xc3, 0x26, 0x71, 0xe5, 0xeb, 0x16, 0xad, 0x9c, 0x64, 0x38, 0x37, 0x18, 0x4c,
+	0x69, 0xcb, 0x0c, 0x91, 0xbd, 0x12, 0x38, 0xf2, 0xf0, 0x3f, 0x40, 0x2e, 0x33, 0x18, 0x0f, 0xf9,
+	0x3e, 0x4c, 0x5b, 0x84, 0xaa, 0x2e, 0xab, 0x44, 0xd5, 0xea, 0xd8, 0xd2, 0x49, 0x61, 0x64, 0x20,
+	0xe8, 0xbc, 0x15, 0x74, 0x64, 0x97, 0xc1, 0xa0, 0x77, 0x20, 0x1f, 0xc1, 0x76, 0x30, 0x25, 0x85,
+	0xd1, 0xbe, 0x91, 0xaf, 0x10, 0xad, 0x32, 0xd9, 0x46, 0xae, 0x60, 0x4a, 0x90, 0x06, 0x73, 0x26,
+	0x3e, 0x50, 0x71, 0xa3, 0x61, 0x7f, 0x40, 0x6a, 0x6a, 0x98, 0xa3, 0x70, 0x62, 0x20, 0xe2, 0x33,
+	0x26, 0x3e, 0x78, 0xdd, 0x07, 0x6b, 0x6f, 0x2a, 0xda, 0x83, 0x79, 0x87, 0x98, 0xd8, 0xb0, 0x0c,
+	0x4b, 0x67, 0x1b, 0xaa, 0x6a, 0xb8, 0x89, 0x35, 0x83, 0x1e, 0x16, 0xc6, 0x06, 0xca, 0x72, 0xaa,
+	0x0d, 0xe7, 0xed, 0xeb, 0x2e, 0x07, 0x93, 0x2e, 0xc3, 0x02, 0x3b, 0x4e, 0x57, 0x9b, 0xb6, 0x56,
+	0xbf, 0x86, 0xdd, 0xbb, 0x2e, 0xd6, 0x09, 0x3f, 0x6c, 0xe8, 0x0c, 0x4c, 0x10, 0xcf, 0xae, 0x5a,
+	0x2d, 0xb3, 0x4a, 0x1c, 0x76, 0x9a, 0x46, 0x2b, 0x39, 0x66, 0xbb, 0xc9, 0x4c, 0xd2, 0xaf, 0x02,
+	0x88, 0x59, 0x00, 0xfc, 0x4c, 0xde, 0x83, 0xbc, 0x8f, 0xa0, 0x63, 0x57, 0x6d, 0x79, 0x2e, 0x7e,
+	0x89, 0x5f, 0xec, 0x7c, 0x4d, 0x62, 0x68, 0xfc, 0x92, 0x4c, 0x92, 0xa8, 0x11, 0xbd, 0x0f, 0x08,
+	0xef, 0x13, 0x07, 0xeb, 0xc4, 0x07, 0xf6, 0x77, 0x78, 0x78, 0xa0, 0x1d, 0x9e, 0xe2, 0x48, 0x3e,
+	0x79, 0x4c, 0x89, 0x24, 0xc1, 0x0a, 0x2b, 0x6b, 0xb7, 0xe5, 0x38, 0xc4, 0xa2, 0x59, 0xed, 0x91,
+	0xfe, 0x10, 0xe0, 0x4c, 0x87, 0x45, 0xff, 0xf7, 0x16, 0x2c, 0xc3, 0x12, 0xab, 0xae, 0x42, 0xf6,
+	0x89, 0xe3, 0x92, 0xb2, 0x83, 0x1f, 0x10, 0xef, 0xf5, 0x6c, 0xd7, 0xff, 0x99, 0x00, 0xc5, 0x67,
+	0xad, 0xe0, 0xc5, 0x13, 0x98, 0x71, 0x7c, 0xa7, 0x5a, 0xf5, 0xbc, 0xec, 0x21, 0x0f, 0x1a, 0xa0,
+	0x74, 0x6e, 0x40, 0x0a, 0x95, 0x37, 0x61, 0xda, 0x49, 0x3a, 0x24, 0x11, 0x0a, 0x7c, 0x94, 0x68,
+	0xb6, 0x49, 0xca, 0xad, 0xbd, 0x3d, 0xe2, 0x04, 0x2c, 0x1d, 0x58, 0xc8, 0xf0, 0x71, 0x7e, 0x77,
+	0x61, 0xd2, 0x60, 0x76, 0xb5, 0xca, 0x1c, 0x9c, 0xd9, 0x46, 0xb7, 0x11, 0x13, 0x42, 0x71, 0x52,
+	0x13, 0x46, 0xc4, 0x26, 0x61, 0x98, 0xf7, 0xe7, 0x87, 0x61, 0xd1, 0x0a, 0xd1, 0x6c, 0xa7, 0x16,
+	0x4c, 0x3d, 0xf4, 0x06, 0x40, 0xa8, 0x21, 0x78, 0xba, 0x35, 0xd9, 0xdf, 0x12, 0xb9, 0x8a, 0x5d,
+	0x22, 0xfb, 0xda, 0x86, 0x0b, 0x0e, 0xf9, 0x56, 0x78, 0xe0, 0x2a, 0x91, 0x48, 0xe9, 0x7b, 0x01,
+	0x0a, 0xe9, 0x1c, 0xbc, 0xac, 0xdb, 0x30, 0xc1, 0xde, 0x0c, 0xc7, 0xb7, 0xf3, 0xd1, 0xb4, 0xde,
+	0x65, 0x34, 0xb5, 0x81, 0x78, 0x4d, 0x39, 0x33, 0x84, 0x46, 0xd7, 0x62, 0xbc, 0x87, 0xb9, 0x32,
+	0xe8, 0xc6, 0xdb, 0xe7, 0x13, 0x23, 0xfe, 0x48, 0xe0, 0xcd, 0xf1, 0xa6, 0xc0, 0x7f, 0xd3, 0x1c,
+	0xef, 0xe1, 0x72, 0x29, 0x76, 0xa8, 0x5a, 0x27, 0x86, 0x5e, 0xa7, 0x8c, 0xee, 0x48, 0x25, 0xc7,
+	0x6c, 0x6f, 0x32, 0x13, 0x5a, 0x02, 0x20, 0x56, 0x2d, 0x58, 0x30, 0xc2, 0x16, 0x8c, 0x13, 0xab,
+	0xe6, 0xbb, 0xc3, 0xf6, 0xc6, 0x58, 0x86, 0xed, 0x65, 0x63, 0xbf, 0xaf, 0xf6, 0x86, 0x40, 0x41,
+	0x7b, 0xab, 0x21, 0xf4, 0xbf, 0xd7, 0xde, 0x40, 0x73, 0x5d, 0x35, 0x89, 0xa3, 0x13, 0x4b, 0x3b,
+	0x8c, 0x5d, 0xd9, 0x40, 0x73, 0x25, 0xbd, 0xa1, 0xe6, 0x22, 0x81, 0x27, 0x76, 0x55, 0xbb, 0x68,
+	0xae, 0x38, 0x5c, 0xa0, 0xb9, 0x48, 0xcc, 0xba, 0xf5, 0xcd, 0x14, 0x9c, 0x60, 0xc9, 0xd1, 0xe7,
+	0x02, 0x8c, 0xf9, 0x8a, 0x15, 0x9d, 0xeb, 0x0c, 0x9c, 0x16, 0xcc, 0x62, 0xa9, 0x8f, 0x08, 0xbf,
+	0x2c, 0x69, 0xf9, 0xd3, 0x5f, 0xfe, 0xfa, 0x72, 0x78, 0x01, 0xcd, 0x2b, 0x98, 0xb8, 0x9a, 0xc2,
+	0x97, 0x2a, 0xfb, 0x25, 0x2e, 0xda, 0xd1, 0x57, 0x02, 0x8c, 0xb7, 0x75, 0x2c, 0x3a, 0xdf, 0x43,
+	0x86, 0xa4, 0xa6, 0x16, 0x2f, 0xf4, 0x17, 0xc4, 0x99, 0x3d, 0xc7, 0x98, 0x2d, 0xa1, 0xd3, 0x29,
+	0x66, 0xa1, 0x0c, 0x47, 0xdf, 0x0a, 0x70, 0x32, 0xae, 0x6a, 0xd1, 0xc5, 0x1e, 0xb2, 0x65, 0xaa,
+	0x6e, 0xf1, 0xd2, 0x00, 0x91, 0x9c, 0xec, 0x3a, 0x23, 0x2b, 0xa1, 0x95, 0x14, 0xd9, 0x84, 0x50,
+	0x47, 0xdf, 0x09, 0x90, 0x4f, 0x28, 0x5d, 0xd4, 0x4b, 0xe2, 0x6c, 0x39, 0x2e, 0xbe, 0x3c, 0x48,
+	0x28, 0x27, 0xbd, 0xc1, 0x48, 0xaf, 0x22, 0x29, 0x45, 0x3a, 0xa5, 0xe0, 0xd9, 0x31, 0x08, 0x25,
+	0x58, 0x2f, 0xc7, 0x20, 0xa9, 0xcf, 0xc5, 0x0b, 0xfd, 0x05, 0x75, 0x3d, 0x06, 0xa1, 0xba, 0x44,
+	0x3f, 0x08, 0x30, 0x19, 0x13, 0x05, 0x68, 0xa7, 0x87, 0x64, 0x59, 0xca, 0x45, 0xbc, 0xd8, 0x7f,
+	0x20, 0x67, 0xba, 0xc3, 0x98, 0x96, 0x90, 0x92, 0x62, 0x9a, 0x10, 0x39, 0xca, 0x47, 0x51, 0xe9,
+	0xf8, 0x31, 0xfa, 0x49, 0x80, 0xd9, 0x2c, 0x9d, 0x84, 0x2e, 0xf7, 0xc0, 0xa5, 0x83, 0x0a, 0x13,
+	0x5f, 0x1b, 0x38, 0x9e, 0x97, 0x74, 0x8e, 0x95, 0xb4, 0x81, 0xd6, 0x53, 0x25, 0x69, 0x7e, 0x98,
+	0x9a, 0x28, 0x0d, 0xfd, 0x28, 0xc0, 0x74, 0x4a, 0x9d, 0xa0, 0x57, 0x7a, 0x20, 0xf2, 0x2c, 0x2d,
+	0x25, 0xbe, 0x3a, 0x58, 0x30, 0x2f, 0xe1, 0x2c, 0x2b, 0x61, 0x0d, 0xad, 0xa6, 0x4a, 0xc8, 0x50,
+	0x5f, 0xe8, 0x6b, 0x01, 0x26, 0xa2, 0x12, 0x06, 0x6d, 0xf7, 0xf4, 0x26, 0xa4, 0xa4, 0x95, 0xb8,
+	0xd3, 0x77, 0x1c, 0xe7, 0xbb, 0xc6, 0xf8, 0xae, 0xa0, 0x62, 0xc6, 0x4b, 0x12, 0x51, 0x63, 0xe8,
+	0x91, 0x00, 0xb9, 0x88, 0xbe, 0x41, 0x2f, 0xf5, 0xf2, 0x10, 0xa4, 0x34, 0x97, 0xb8, 0xdd, 0x6f,
+	0x18, 0xa7, 0xf9, 0x3c, 0xa3, 0xb9, 0x8c, 0x96, 0xd2, 0x6f, 0x47, 0x44, 0x5d, 0x31, 0x96, 0x11,
+	0x99, 0xd0, 0x13, 0xcb, 0xb4, 0xf8, 0x11, 0xb7, 0xfb, 0x0d, 0xeb, 0xca, 0x32, 0x2a, 0x52, 0xd8,
+	0x14, 0x89, 0xcf, 0xe9, 0x9e, 0xa6, 0x48, 0xa6, 0x8e, 0x10, 0x2f, 0x0d, 0x10, 0xd9, 0x75, 0x8a,
+	0x24, 0xa4, 0x47, 0xf9, 0xad, 0xc7, 0x47, 0x45, 0xe1, 0xc9, 0x51, 0x51, 0xf8, 0xf3, 0xa8, 0x28,
+	0x7c, 0x71, 0x5c, 0x1c, 0x7a, 0x72, 0x5c, 0x1c, 0xfa, 0xed, 0xb8, 0x38, 0x74, 0xbf, 0x14, 0xf9,
+	0x51, 0xe3, 0x12, 0x63, 0x33, 0x60, 0xc2, 0xbe, 0x30, 0x2a, 0xca, 0x41, 0x1b, 0x99, 0xfd, 0xc6,
+	0xa9, 0x8e, 0xb1, 0x35, 0xe7, 0xff, 0x1e, 0x00, 0x59, 0x37, 0xcb, 0x1a, 0x43, 0x14, 0x00, 0x00,
 }
 
-func (m *QueryMonthlyBurnDataRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns the module parameters
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// BurnStats returns the cumulative burn statistics
+	BurnStats(ctx context.Context, in *QueryBurnStatsRequest, opts ...grpc.CallOption) (*QueryBurnStatsResponse, error)
+	// InflationStats returns the cumulative inflation statistics
+	InflationStats(ctx context.Context, in *QueryInflationStatsRequest, opts ...grpc.CallOption) (*QueryInflationStatsResponse, error)
+	// MonthlyBurnData returns the 12-month rolling window supply data
+	MonthlyBurnData(ctx context.Context, in *QueryMonthlyBurnDataRequest, opts ...grpc.CallOption) (*QueryMonthlyBurnDataResponse, error)
+	// NetSupply returns the current net supply change in the 12-month window
+	NetSupply(ctx context.Context, in *QueryNetSupplyRequest, opts ...grpc.CallOption) (*QueryNetSupplyResponse, error)
+	// EpochGasUsage returns the gas usage accumulated for a completed epoch
+	EpochGasUsage(ctx context.Context, in *QueryEpochGasUsageRequest, opts ...grpc.CallOption) (*QueryEpochGasUsageResponse, error)
+	// CurrentEpochGasUsage returns the gas usage accumulated so far in the current epoch
+	CurrentEpochGasUsage(ctx context.Context, in *QueryCurrentEpochGasUsageRequest, opts ...grpc.CallOption) (*QueryCurrentEpochGasUsageResponse, error)
+	// ReverseBrakeState returns the reverse brake mechanism state
+	ReverseBrakeState(ctx context.Context, in *QueryReverseBrakeStateRequest, opts ...grpc.CallOption) (*QueryReverseBrakeStateResponse, error)
+	// IncomeBuffer returns the validator income smoothing buffer state
+	IncomeBuffer(ctx context.Context, in *QueryIncomeBufferRequest, opts ...grpc.CallOption) (*QueryIncomeBufferResponse, error)
+	// MintRecords returns the history of inflation mint records
+	MintRecords(ctx context.Context, in *QueryMintRecordsRequest, opts ...grpc.CallOption) (*QueryMintRecordsResponse, error)
+	// BurnRecords returns the history of fee burn records
+	BurnRecords(ctx context.Context, in *QueryBurnRecordsRequest, opts ...grpc.CallOption) (*QueryBurnRecordsResponse, error)
+	// EmergencyState returns the governance emergency controls
+	EmergencyState(ctx context.Context, in *QueryEmergencyStateRequest, opts ...grpc.CallOption) (*QueryEmergencyStateResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.aexburn.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BurnStats(ctx context.Context, in *QueryBurnStatsRequest, opts ...grpc.CallOption) (*QueryBurnStatsResponse, error) {
+	out := new(QueryBurnStatsResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.aexburn.Query/BurnStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InflationStats(ctx context.Context, in *QueryInflationStatsRequest, opts ...grpc.CallOption) (*QueryInflationStatsResponse, error) {
+	out := new(QueryInflationStatsResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.aexburn.Query/InflationStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MonthlyBurnData(ctx context.Context, in *QueryMonthlyBurnDataRequest, opts ...grpc.CallOption) (*QueryMonthlyBurnDataResponse, error) {
+	out := new(QueryMonthlyBurnDataResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.aexburn.Query/MonthlyBurnData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NetSupply(ctx context.Context, in *QueryNetSupplyRequest, opts ...grpc.CallOption) (*QueryNetSupplyResponse, error) {
+	out := new(QueryNetSupplyResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.aexburn.Query/NetSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochGasUsage(ctx context.Context, in *QueryEpochGasUsageRequest, opts ...grpc.CallOption) (*QueryEpochGasUsageResponse, error) {
+	out := new(QueryEpochGasUsageResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.aexburn.Query/EpochGasUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CurrentEpochGasUsage(ctx context.Context, in *QueryCurrentEpochGasUsageRequest, opts ...grpc.CallOption) (*QueryCurrentEpochGasUsageResponse, error) {
+	out := new(QueryCurrentEpochGasUsageResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.aexburn.Query/CurrentEpochGasUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReverseBrakeState(ctx context.Context, in *QueryReverseBrakeStateRequest, opts ...grpc.CallOption) (*QueryReverseBrakeStateResponse, error) {
+	out := new(QueryReverseBrakeStateResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.aexburn.Query/ReverseBrakeState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IncomeBuffer(ctx context.Context, in *QueryIncomeBufferRequest, opts ...grpc.CallOption) (*QueryIncomeBufferResponse, error) {
+	out := new(QueryIncomeBufferResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.aexburn.Query/IncomeBuffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintRecords(ctx context.Context, in *QueryMintRecordsRequest, opts ...grpc.CallOption) (*QueryMintRecordsResponse, error) {
+	out := new(QueryMintRecordsResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.aexburn.Query/MintRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BurnRecords(ctx context.Context, in *QueryBurnRecordsRequest, opts ...grpc.CallOption) (*QueryBurnRecordsResponse, error) {
+	out := new(QueryBurnRecordsResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.aexburn.Query/BurnRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EmergencyState(ctx context.Context, in *QueryEmergencyStateRequest, opts ...grpc.CallOption) (*QueryEmergencyStateResponse, error) {
+	out := new(QueryEmergencyStateResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.aexburn.Query/EmergencyState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns the module parameters
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// BurnStats returns the cumulative burn statistics
+	BurnStats(context.Context, *QueryBurnStatsRequest) (*QueryBurnStatsResponse, error)
+	// InflationStats returns the cumulative inflation statistics
+	InflationStats(context.Context, *QueryInflationStatsRequest) (*QueryInflationStatsResponse, error)
+	// MonthlyBurnData returns the 12-month rolling window supply data
+	MonthlyBurnData(context.Context, *QueryMonthlyBurnDataRequest) (*QueryMonthlyBurnDataResponse, error)
+	// NetSupply returns the current net supply change in the 12-month window
+	NetSupply(context.Context, *QueryNetSupplyRequest) (*QueryNetSupplyResponse, error)
+	// EpochGasUsage returns the gas usage accumulated for a completed epoch
+	EpochGasUsage(context.Context, *QueryEpochGasUsageRequest) (*QueryEpochGasUsageResponse, error)
+	// CurrentEpochGasUsage returns the gas usage accumulated so far in the current epoch
+	CurrentEpochGasUsage(context.Context, *QueryCurrentEpochGasUsageRequest) (*QueryCurrentEpochGasUsageResponse, error)
+	// ReverseBrakeState returns the reverse brake mechanism state
+	ReverseBrakeState(context.Context, *QueryReverseBrakeStateRequest) (*QueryReverseBrakeStateResponse, error)
+	// IncomeBuffer returns the validator income smoothing buffer state
+	IncomeBuffer(context.Context, *QueryIncomeBufferRequest) (*QueryIncomeBufferResponse, error)
+	// MintRecords returns the history of inflation mint records
+	MintRecords(context.Context, *QueryMintRecordsRequest) (*QueryMintRecordsResponse, error)
+	// BurnRecords returns the history of fee burn records
+	BurnRecords(context.Context, *QueryBurnRecordsRequest) (*QueryBurnRecordsResponse, error)
+	// EmergencyState returns the governance emergency controls
+	EmergencyState(context.Context, *QueryEmergencyStateRequest) (*QueryEmergencyStateResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) BurnStats(ctx context.Context, req *QueryBurnStatsRequest) (*QueryBurnStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnStats not implemented")
+}
+func (*UnimplementedQueryServer) InflationStats(ctx context.Context, req *QueryInflationStatsRequest) (*QueryInflationStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InflationStats not implemented")
+}
+func (*UnimplementedQueryServer) MonthlyBurnData(ctx context.Context, req *QueryMonthlyBurnDataRequest) (*QueryMonthlyBurnDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MonthlyBurnData not implemented")
+}
+func (*UnimplementedQueryServer) NetSupply(ctx context.Context, req *QueryNetSupplyRequest) (*QueryNetSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NetSupply not implemented")
+}
+func (*UnimplementedQueryServer) EpochGasUsage(ctx context.Context, req *QueryEpochGasUsageRequest) (*QueryEpochGasUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochGasUsage not implemented")
+}
+func (*UnimplementedQueryServer) CurrentEpochGasUsage(ctx context.Context, req *QueryCurrentEpochGasUsageRequest) (*QueryCurrentEpochGasUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrentEpochGasUsage not implemented")
+}
+func (*UnimplementedQueryServer) ReverseBrakeState(ctx context.Context, req *QueryReverseBrakeStateRequest) (*QueryReverseBrakeStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverseBrakeState not implemented")
+}
+func (*UnimplementedQueryServer) IncomeBuffer(ctx context.Context, req *QueryIncomeBufferRequest) (*QueryIncomeBufferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncomeBuffer not implemented")
+}
+func (*UnimplementedQueryServer) MintRecords(ctx context.Context, req *QueryMintRecordsRequest) (*QueryMintRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintRecords not implemented")
+}
+func (*UnimplementedQueryServer) BurnRecords(ctx context.Context, req *QueryBurnRecordsRequest) (*QueryBurnRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnRecords not implemented")
+}
+func (*UnimplementedQueryServer) EmergencyState(ctx context.Context, req *QueryEmergencyStateRequest) (*QueryEmergencyStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmergencyState not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.aexburn.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BurnStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.aexburn.Query/BurnStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnStats(ctx, req.(*QueryBurnStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InflationStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInflationStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InflationStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.aexburn.Query/InflationStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InflationStats(ctx, req.(*QueryInflationStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MonthlyBurnData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMonthlyBurnDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MonthlyBurnData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.aexburn.Query/MonthlyBurnData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MonthlyBurnData(ctx, req.(*QueryMonthlyBurnDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NetSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNetSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NetSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.aexburn.Query/NetSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NetSupply(ctx, req.(*QueryNetSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochGasUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochGasUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochGasUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.aexburn.Query/EpochGasUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochGasUsage(ctx, req.(*QueryEpochGasUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CurrentEpochGasUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCurrentEpochGasUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CurrentEpochGasUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.aexburn.Query/CurrentEpochGasUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CurrentEpochGasUsage(ctx, req.(*QueryCurrentEpochGasUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReverseBrakeState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReverseBrakeStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReverseBrakeState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.aexburn.Query/ReverseBrakeState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReverseBrakeState(ctx, req.(*QueryReverseBrakeStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IncomeBuffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIncomeBufferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IncomeBuffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.aexburn.Query/IncomeBuffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IncomeBuffer(ctx, req.(*QueryIncomeBufferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.aexburn.Query/MintRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintRecords(ctx, req.(*QueryMintRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BurnRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.aexburn.Query/BurnRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnRecords(ctx, req.(*QueryBurnRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EmergencyState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEmergencyStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EmergencyState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.aexburn.Query/EmergencyState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EmergencyState(ctx, req.(*QueryEmergencyStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "seiprotocol.seichain.aexburn.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "BurnStats",
+			Handler:    _Query_BurnStats_Handler,
+		},
+		{
+			MethodName: "InflationStats",
+			Handler:    _Query_InflationStats_Handler,
+		},
+		{
+			MethodName: "MonthlyBurnData",
+			Handler:    _Query_MonthlyBurnData_Handler,
+		},
+		{
+			MethodName: "NetSupply",
+			Handler:    _Query_NetSupply_Handler,
+		},
+		{
+			MethodName: "EpochGasUsage",
+			Handler:    _Query_EpochGasUsage_Handler,
+		},
+		{
+			MethodName: "CurrentEpochGasUsage",
+			Handler:    _Query_CurrentEpochGasUsage_Handler,
+		},
+		{
+			MethodName: "ReverseBrakeState",
+			Handler:    _Query_ReverseBrakeState_Handler,
+		},
+		{
+			MethodName: "IncomeBuffer",
+			Handler:    _Query_IncomeBuffer_Handler,
+		},
+		{
+			MethodName: "MintRecords",
+			Handler:    _Query_MintRecords_Handler,
+		},
+		{
+			MethodName: "BurnRecords",
+			Handler:    _Query_BurnRecords_Handler,
+		},
+		{
+			MethodName: "EmergencyState",
+			Handler:    _Query_EmergencyState_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "aexburn/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BurnStats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.InflationStats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMonthlyBurnDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMonthlyBurnDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMonthlyBurnDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMonthlyBurnDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMonthlyBurnDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMonthlyBurnDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MonthlyBurnData) > 0 {
+		for iNdEx := len(m.MonthlyBurnData) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MonthlyBurnData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNetSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNetSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNetSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNetSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNetSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNetSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RemainingMintCapacity.Size()
+		i -= size
+		if _, err := m.RemainingMintCapacity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MaxAllowedNetSupply.Size()
+		i -= size
+		if _, err := m.MaxAllowedNetSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.NetSupplyRate.Size()
+		i -= size
+		if _, err := m.NetSupplyRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.NetSupplyChange.Size()
+		i -= size
+		if _, err := m.NetSupplyChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TotalBurned_12M.Size()
+		i -= size
+		if _, err := m.TotalBurned_12M.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TotalMinted_12M.Size()
+		i -= size
+		if _, err := m.TotalMinted_12M.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochGasUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochGasUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochGasUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochGasUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochGasUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochGasUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AverageUsageRate.Size()
+		i -= size
+		if _, err := m.AverageUsageRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.EpochGasUsage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCurrentEpochGasUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentEpochGasUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentEpochGasUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCurrentEpochGasUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentEpochGasUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentEpochGasUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AverageUsageRate.Size()
+		i -= size
+		if _, err := m.AverageUsageRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.EpochGasUsage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReverseBrakeStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReverseBrakeStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReverseBrakeStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReverseBrakeStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReverseBrakeStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReverseBrakeStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ReverseBrakeState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIncomeBufferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIncomeBufferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIncomeBufferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIncomeBufferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIncomeBufferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIncomeBufferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.IncomeBuffer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MintRecords) > 0 {
+		for iNdEx := len(m.MintRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BurnRecords) > 0 {
+		for iNdEx := len(m.BurnRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmergencyStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmergencyStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmergencyStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmergencyStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmergencyStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmergencyStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EmergencyState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBurnStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBurnStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BurnStats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInflationStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInflationStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InflationStats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMonthlyBurnDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMonthlyBurnDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MonthlyBurnData) > 0 {
+		for _, e := range m.MonthlyBurnData {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryNetSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryNetSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalMinted_12M.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalBurned_12M.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NetSupplyChange.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NetSupplyRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaxAllowedNetSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RemainingMintCapacity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEpochGasUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	return n
+}
+
+func (m *QueryEpochGasUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EpochGasUsage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AverageUsageRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCurrentEpochGasUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCurrentEpochGasUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EpochGasUsage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AverageUsageRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryReverseBrakeStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryReverseBrakeStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ReverseBrakeState.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryIncomeBufferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryIncomeBufferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.IncomeBuffer.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMintRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MintRecords) > 0 {
+		for _, e := range m.MintRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBurnRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *QueryBurnRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BurnRecords) > 0 {
+		for _, e := range m.BurnRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEmergencyStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEmergencyStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EmergencyState.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMonthlyBurnDataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMonthlyBurnDataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMonthlyBurnDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMonthlyBurnDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMonthlyBurnDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMonthlyBurnDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthlyBurnData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MonthlyBurnData = append(m.MonthlyBurnData, MonthlyBurnData{})
+			if err := m.MonthlyBurnData[len(m.MonthlyBurnData)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNetSupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNetSupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNetSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNetSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNetSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNetSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinted_12M", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalMinted_12M.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurned_12M", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalBurned_12M.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetSupplyChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetSupplyChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetSupplyRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetSupplyRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAllowedNetSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAllowedNetSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingMintCapacity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingMintCapacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochGasUsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochGasUsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochGasUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochGasUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochGasUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochGasUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochGasUsage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochGasUsage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageUsageRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AverageUsageRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
+func (m *QueryCurrentEpochGasUsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentEpochGasUsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentEpochGasUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryMonthlyBurnDataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.MonthlyBurnData) > 0 {
-		for _, e := range m.MonthlyBurnData {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryCurrentEpochGasUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentEpochGasUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentEpochGasUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochGasUsage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochGasUsage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageUsageRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AverageUsageRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *QueryNetSupplyRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryNetSupplyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.TotalMinted_12M.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.TotalBurned_12M.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.NetSupplyChange.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.NetSupplyRate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.MaxAllowedNetSupply.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.RemainingMintCapacity.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryReverseBrakeStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1232,10 +4038,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReverseBrakeStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReverseBrakeStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1259,7 +4065,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryReverseBrakeStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1282,15 +4088,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReverseBrakeStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReverseBrakeStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReverseBrakeState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1317,7 +4123,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ReverseBrakeState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1342,7 +4148,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBurnStatsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIncomeBufferRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1365,10 +4171,10 @@ func (m *QueryBurnStatsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBurnStatsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIncomeBufferRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBurnStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIncomeBufferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1392,7 +4198,7 @@ func (m *QueryBurnStatsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBurnStatsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIncomeBufferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1415,15 +4221,15 @@ func (m *QueryBurnStatsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBurnStatsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIncomeBufferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBurnStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIncomeBufferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnStats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncomeBuffer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1450,7 +4256,7 @@ func (m *QueryBurnStatsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BurnStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.IncomeBuffer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1475,7 +4281,7 @@ func (m *QueryBurnStatsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInflationStatsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMintRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1498,12 +4304,48 @@ func (m *QueryInflationStatsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInflationStatsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMintRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInflationStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMintRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1525,7 +4367,7 @@ func (m *QueryInflationStatsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInflationStatsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMintRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1548,15 +4390,15 @@ func (m *QueryInflationStatsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInflationStatsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMintRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInflationStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMintRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationStats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRecords", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1583,7 +4425,44 @@ func (m *QueryInflationStatsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InflationStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MintRecords = append(m.MintRecords, MintRecord{})
+			if err := m.MintRecords[len(m.MintRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1608,7 +4487,7 @@ func (m *QueryInflationStatsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMonthlyBurnDataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBurnRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1618,25 +4497,99 @@ func (m *QueryMonthlyBurnDataRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMonthlyBurnDataRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMonthlyBurnDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1658,7 +4611,7 @@ func (m *QueryMonthlyBurnDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMonthlyBurnDataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBurnRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1681,15 +4634,15 @@ func (m *QueryMonthlyBurnDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMonthlyBurnDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBurnRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMonthlyBurnDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBurnRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MonthlyBurnData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRecords", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1716,8 +4669,44 @@ func (m *QueryMonthlyBurnDataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MonthlyBurnData = append(m.MonthlyBurnData, MonthlyBurnData{})
-			if err := m.MonthlyBurnData[len(m.MonthlyBurnData)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BurnRecords = append(m.BurnRecords, BurnRecord{})
+			if err := m.BurnRecords[len(m.BurnRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1742,7 +4731,7 @@ func (m *QueryMonthlyBurnDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNetSupplyRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEmergencyStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1765,10 +4754,10 @@ func (m *QueryNetSupplyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNetSupplyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEmergencyStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNetSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEmergencyStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1792,7 +4781,7 @@ func (m *QueryNetSupplyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNetSupplyResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEmergencyStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1815,187 +4804,17 @@ func (m *QueryNetSupplyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNetSupplyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEmergencyStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNetSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEmergencyStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinted_12M", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TotalMinted_12M.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurned_12M", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TotalBurned_12M.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetSupplyChange", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.NetSupplyChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetSupplyRate", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.NetSupplyRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxAllowedNetSupply", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MaxAllowedNetSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemainingMintCapacity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyState", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2005,23 +4824,22 @@ func (m *QueryNetSupplyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RemainingMintCapacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EmergencyState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
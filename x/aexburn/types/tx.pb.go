@@ -0,0 +1,1776 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: aexburn/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	// authority is the address allowed to control the module
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	// params defines the new module parameters, all fields must be set
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params" yaml:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_040106a8c90919d4, []int{0}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse is the Msg/UpdateParams response type.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_040106a8c90919d4, []int{1}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgSetEmergencyPause is the Msg/SetEmergencyPause request type.
+type MsgSetEmergencyPause struct {
+	// authority is the address allowed to control the module
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	// burn_paused halts fee burning and income smoothing when true
+	BurnPaused bool `protobuf:"varint,2,opt,name=burn_paused,json=burnPaused,proto3" json:"burn_paused,omitempty" yaml:"burn_paused"`
+	// mint_paused halts inflation minting when true
+	MintPaused bool `protobuf:"varint,3,opt,name=mint_paused,json=mintPaused,proto3" json:"mint_paused,omitempty" yaml:"mint_paused"`
+}
+
+func (m *MsgSetEmergencyPause) Reset()         { *m = MsgSetEmergencyPause{} }
+func (m *MsgSetEmergencyPause) String() string { return proto.CompactTextString(m) }
+func (*MsgSetEmergencyPause) ProtoMessage()    {}
+func (*MsgSetEmergencyPause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_040106a8c90919d4, []int{2}
+}
+func (m *MsgSetEmergencyPause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetEmergencyPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetEmergencyPause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetEmergencyPause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetEmergencyPause.Merge(m, src)
+}
+func (m *MsgSetEmergencyPause) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetEmergencyPause) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetEmergencyPause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetEmergencyPause proto.InternalMessageInfo
+
+func (m *MsgSetEmergencyPause) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetEmergencyPause) GetBurnPaused() bool {
+	if m != nil {
+		return m.BurnPaused
+	}
+	return false
+}
+
+func (m *MsgSetEmergencyPause) GetMintPaused() bool {
+	if m != nil {
+		return m.MintPaused
+	}
+	return false
+}
+
+// MsgSetEmergencyPauseResponse is the Msg/SetEmergencyPause response type.
+type MsgSetEmergencyPauseResponse struct {
+}
+
+func (m *MsgSetEmergencyPauseResponse) Reset()         { *m = MsgSetEmergencyPauseResponse{} }
+func (m *MsgSetEmergencyPauseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetEmergencyPauseResponse) ProtoMessage()    {}
+func (*MsgSetEmergencyPauseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_040106a8c90919d4, []int{3}
+}
+func (m *MsgSetEmergencyPauseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetEmergencyPauseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetEmergencyPauseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetEmergencyPauseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetEmergencyPauseResponse.Merge(m, src)
+}
+func (m *MsgSetEmergencyPauseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetEmergencyPauseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetEmergencyPauseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetEmergencyPauseResponse proto.InternalMessageInfo
+
+// MsgDrainIncomeBuffer is the Msg/DrainIncomeBuffer request type.
+type MsgDrainIncomeBuffer struct {
+	// authority is the address allowed to control the module
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	// recipient receives the drained funds, the fee collector is used when empty
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty" yaml:"recipient"`
+}
+
+func (m *MsgDrainIncomeBuffer) Reset()         { *m = MsgDrainIncomeBuffer{} }
+func (m *MsgDrainIncomeBuffer) String() string { return proto.CompactTextString(m) }
+func (*MsgDrainIncomeBuffer) ProtoMessage()    {}
+func (*MsgDrainIncomeBuffer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_040106a8c90919d4, []int{4}
+}
+func (m *MsgDrainIncomeBuffer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDrainIncomeBuffer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDrainIncomeBuffer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDrainIncomeBuffer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDrainIncomeBuffer.Merge(m, src)
+}
+func (m *MsgDrainIncomeBuffer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDrainIncomeBuffer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDrainIncomeBuffer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDrainIncomeBuffer proto.InternalMessageInfo
+
+func (m *MsgDrainIncomeBuffer) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgDrainIncomeBuffer) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+// MsgDrainIncomeBufferResponse is the Msg/DrainIncomeBuffer response type.
+type MsgDrainIncomeBufferResponse struct {
+	// amount is the amount drained from the buffer (in base units)
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount" yaml:"amount"`
+}
+
+func (m *MsgDrainIncomeBufferResponse) Reset()         { *m = MsgDrainIncomeBufferResponse{} }
+func (m *MsgDrainIncomeBufferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDrainIncomeBufferResponse) ProtoMessage()    {}
+func (*MsgDrainIncomeBufferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_040106a8c90919d4, []int{5}
+}
+func (m *MsgDrainIncomeBufferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDrainIncomeBufferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDrainIncomeBufferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDrainIncomeBufferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDrainIncomeBufferResponse.Merge(m, src)
+}
+func (m *MsgDrainIncomeBufferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDrainIncomeBufferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDrainIncomeBufferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDrainIncomeBufferResponse proto.InternalMessageInfo
+
+// MsgResetIncomeBuffer is the Msg/ResetIncomeBuffer request type.
+type MsgResetIncomeBuffer struct {
+	// authority is the address allowed to control the module
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+}
+
+func (m *MsgResetIncomeBuffer) Reset()         { *m = MsgResetIncomeBuffer{} }
+func (m *MsgResetIncomeBuffer) String() string { return proto.CompactTextString(m) }
+func (*MsgResetIncomeBuffer) ProtoMessage()    {}
+func (*MsgResetIncomeBuffer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_040106a8c90919d4, []int{6}
+}
+func (m *MsgResetIncomeBuffer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResetIncomeBuffer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResetIncomeBuffer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResetIncomeBuffer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetIncomeBuffer.Merge(m, src)
+}
+func (m *MsgResetIncomeBuffer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResetIncomeBuffer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetIncomeBuffer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResetIncomeBuffer proto.InternalMessageInfo
+
+func (m *MsgResetIncomeBuffer) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgResetIncomeBufferResponse is the Msg/ResetIncomeBuffer response type.
+type MsgResetIncomeBufferResponse struct {
+}
+
+func (m *MsgResetIncomeBufferResponse) Reset()         { *m = MsgResetIncomeBufferResponse{} }
+func (m *MsgResetIncomeBufferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResetIncomeBufferResponse) ProtoMessage()    {}
+func (*MsgResetIncomeBufferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_040106a8c90919d4, []int{7}
+}
+func (m *MsgResetIncomeBufferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResetIncomeBufferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResetIncomeBufferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResetIncomeBufferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetIncomeBufferResponse.Merge(m, src)
+}
+func (m *MsgResetIncomeBufferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResetIncomeBufferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetIncomeBufferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResetIncomeBufferResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "seiprotocol.seichain.aexburn.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "seiprotocol.seichain.aexburn.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetEmergencyPause)(nil), "seiprotocol.seichain.aexburn.MsgSetEmergencyPause")
+	proto.RegisterType((*MsgSetEmergencyPauseResponse)(nil), "seiprotocol.seichain.aexburn.MsgSetEmergencyPauseResponse")
+	proto.RegisterType((*MsgDrainIncomeBuffer)(nil), "seiprotocol.seichain.aexburn.MsgDrainIncomeBuffer")
+	proto.RegisterType((*MsgDrainIncomeBufferResponse)(nil), "seiprotocol.seichain.aexburn.MsgDrainIncomeBufferResponse")
+	proto.RegisterType((*MsgResetIncomeBuffer)(nil), "seiprotocol.seichain.aexburn.MsgResetIncomeBuffer")
+	proto.RegisterType((*MsgResetIncomeBufferResponse)(nil), "seiprotocol.seichain.aexburn.MsgResetIncomeBufferResponse")
+}
+
+func init() { proto.RegisterFile("aexburn/tx.proto", fileDescriptor_040106a8c90919d4) }
+
+var fileDescriptor_040106a8c90919d4 = []byte{
+	// 528 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4f, 0x8b, 0xd3, 0x40,
+	0x1c, 0x6d, 0x76, 0xa5, 0xd8, 0x59, 0xc5, 0x35, 0x54, 0xad, 0xa1, 0x24, 0xcb, 0x20, 0xb2, 0x97,
+	0x26, 0x58, 0x11, 0xa1, 0x17, 0x21, 0xe8, 0x61, 0x95, 0xc2, 0x92, 0x45, 0x04, 0x2f, 0x32, 0x4d,
+	0x67, 0xd3, 0xc1, 0xcd, 0x4c, 0xc8, 0x4c, 0xb0, 0xbd, 0x78, 0xf1, 0x2c, 0x78, 0xf6, 0xb3, 0xf8,
+	0x01, 0xf6, 0xb8, 0x47, 0xf1, 0x10, 0xa4, 0xfd, 0x06, 0xfd, 0x04, 0x92, 0x99, 0x24, 0xfd, 0x17,
+	0xb6, 0x74, 0x3d, 0x75, 0xa6, 0xbf, 0xdf, 0x7b, 0xbf, 0xf7, 0xe6, 0xf7, 0x08, 0x38, 0x44, 0x78,
+	0x3c, 0x48, 0x62, 0xea, 0x88, 0xb1, 0x1d, 0xc5, 0x4c, 0x30, 0xbd, 0xcd, 0x31, 0x91, 0x27, 0x9f,
+	0x5d, 0xd8, 0x1c, 0x13, 0x7f, 0x84, 0x08, 0xb5, 0xf3, 0x36, 0xa3, 0x19, 0xb0, 0x80, 0xc9, 0xb2,
+	0x93, 0x9d, 0x14, 0xc6, 0x68, 0x16, 0x2c, 0x11, 0x8a, 0x51, 0xc8, 0xd5, 0xbf, 0xf0, 0xa7, 0x06,
+	0xee, 0xf5, 0x79, 0xf0, 0x3e, 0x1a, 0x22, 0x81, 0x4f, 0x65, 0x45, 0xef, 0x82, 0x06, 0x4a, 0xc4,
+	0x88, 0xc5, 0x44, 0x4c, 0x5a, 0xda, 0x91, 0x76, 0xdc, 0x70, 0x9b, 0xf3, 0xd4, 0x3a, 0x9c, 0xa0,
+	0xf0, 0xa2, 0x07, 0xcb, 0x12, 0xf4, 0x16, 0x6d, 0xfa, 0x19, 0xa8, 0x2b, 0xde, 0xd6, 0xde, 0x91,
+	0x76, 0x7c, 0xd0, 0x7d, 0x62, 0x5f, 0x27, 0xd1, 0x56, 0x93, 0xdc, 0x07, 0x97, 0xa9, 0x55, 0x9b,
+	0xa7, 0xd6, 0x5d, 0x45, 0xad, 0x18, 0xa0, 0x97, 0x53, 0xc1, 0xc7, 0xe0, 0xd1, 0x9a, 0x36, 0x0f,
+	0xf3, 0x88, 0x51, 0x8e, 0xe1, 0x2f, 0x0d, 0x34, 0xfb, 0x3c, 0x38, 0xc3, 0xe2, 0x4d, 0x88, 0xe3,
+	0x00, 0x53, 0x7f, 0x72, 0x8a, 0x12, 0x8e, 0x6f, 0x24, 0xfe, 0x25, 0x38, 0xc8, 0x54, 0x7d, 0x8a,
+	0x32, 0x86, 0xa1, 0x74, 0x70, 0xdb, 0x7d, 0x38, 0x4f, 0x2d, 0x5d, 0xa1, 0x96, 0x8a, 0xd0, 0x03,
+	0xd9, 0x4d, 0xce, 0x1a, 0x66, 0xc0, 0x90, 0x50, 0x51, 0x00, 0xf7, 0xd7, 0x81, 0x4b, 0x45, 0xe8,
+	0x81, 0xec, 0xa6, 0x80, 0xd0, 0x04, 0xed, 0x2a, 0xf5, 0xa5, 0xbd, 0xaf, 0xd2, 0xdd, 0xeb, 0x18,
+	0x11, 0x7a, 0x42, 0x7d, 0x16, 0x62, 0x37, 0x39, 0x3f, 0xc7, 0xf1, 0x8d, 0xdc, 0x75, 0x41, 0x23,
+	0xc6, 0x3e, 0x89, 0x08, 0xa6, 0xa2, 0xb5, 0xb7, 0x8e, 0x29, 0x4b, 0xd0, 0x5b, 0xb4, 0xc1, 0x2f,
+	0xa0, 0x5d, 0x35, 0xbf, 0xd0, 0xa7, 0x7f, 0x00, 0x75, 0x14, 0xb2, 0x84, 0x8a, 0x5c, 0xc4, 0xab,
+	0x6c, 0x91, 0x7f, 0x52, 0xeb, 0x69, 0x40, 0xc4, 0x28, 0x19, 0xd8, 0x3e, 0x0b, 0x1d, 0x9f, 0xf1,
+	0x90, 0xf1, 0xfc, 0xa7, 0xc3, 0x87, 0x9f, 0x1d, 0x31, 0x89, 0x30, 0xb7, 0x4f, 0xa8, 0x58, 0xac,
+	0x5c, 0xb1, 0x40, 0x2f, 0xa7, 0x83, 0x6f, 0xa5, 0x71, 0x0f, 0x73, 0x2c, 0xfe, 0xd7, 0x78, 0xfe,
+	0xc8, 0x1b, 0x5c, 0x85, 0x89, 0xee, 0xf7, 0x5b, 0x60, 0xbf, 0xcf, 0x03, 0x5d, 0x80, 0x3b, 0x2b,
+	0xf9, 0xef, 0x5c, 0x9f, 0xdd, 0xb5, 0x48, 0x1a, 0x2f, 0x76, 0x6a, 0x2f, 0x9f, 0xf0, 0x9b, 0x06,
+	0xee, 0x57, 0xc4, 0x77, 0x2b, 0xd9, 0x06, 0xc6, 0xe8, 0xed, 0x8e, 0x59, 0x51, 0x51, 0x11, 0xb3,
+	0xad, 0x8c, 0x1b, 0x18, 0xa3, 0xb7, 0x3b, 0x66, 0x45, 0x45, 0xc5, 0xce, 0xb7, 0x32, 0x6e, 0x60,
+	0x8c, 0xde, 0xee, 0x98, 0x42, 0x85, 0xfb, 0xee, 0x72, 0x6a, 0x6a, 0x57, 0x53, 0x53, 0xfb, 0x3b,
+	0x35, 0xb5, 0x1f, 0x33, 0xb3, 0x76, 0x35, 0x33, 0x6b, 0xbf, 0x67, 0x66, 0xed, 0xe3, 0xb3, 0xa5,
+	0x58, 0x73, 0x4c, 0x3a, 0xc5, 0x00, 0x79, 0x91, 0x13, 0x9c, 0xb1, 0x53, 0x7e, 0xa5, 0xb3, 0x94,
+	0x0f, 0xea, 0xb2, 0xe7, 0xf9, 0xbf, 0x01, 0x00, 0xec, 0xe4, 0x9c, 0x47, 0xbd, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams replaces the module parameters
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetEmergencyPause pauses or resumes fee burning and inflation minting
+	SetEmergencyPause(ctx context.Context, in *MsgSetEmergencyPause, opts ...grpc.CallOption) (*MsgSetEmergencyPauseResponse, error)
+	// DrainIncomeBuffer moves the whole income buffer balance out of the module account
+	DrainIncomeBuffer(ctx context.Context, in *MsgDrainIncomeBuffer, opts ...grpc.CallOption) (*MsgDrainIncomeBufferResponse, error)
+	// ResetIncomeBuffer resets the income buffer accounting to the module account balance
+	ResetIncomeBuffer(ctx context.Context, in *MsgResetIncomeBuffer, opts ...grpc.CallOption) (*MsgResetIncomeBufferResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.aexburn.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetEmergencyPause(ctx context.Context, in *MsgSetEmergencyPause, opts ...grpc.CallOption) (*MsgSetEmergencyPauseResponse, error) {
+	out := new(MsgSetEmergencyPauseResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.aexburn.Msg/SetEmergencyPause", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DrainIncomeBuffer(ctx context.Context, in *MsgDrainIncomeBuffer, opts ...grpc.CallOption) (*MsgDrainIncomeBufferResponse, error) {
+	out := new(MsgDrainIncomeBufferResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.aexburn.Msg/DrainIncomeBuffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResetIncomeBuffer(ctx context.Context, in *MsgResetIncomeBuffer, opts ...grpc.CallOption) (*MsgResetIncomeBufferResponse, error) {
+	out := new(MsgResetIncomeBufferResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.aexburn.Msg/ResetIncomeBuffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams replaces the module parameters
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetEmergencyPause pauses or resumes fee burning and inflation minting
+	SetEmergencyPause(context.Context, *MsgSetEmergencyPause) (*MsgSetEmergencyPauseResponse, error)
+	// DrainIncomeBuffer moves the whole income buffer balance out of the module account
+	DrainIncomeBuffer(context.Context, *MsgDrainIncomeBuffer) (*MsgDrainIncomeBufferResponse, error)
+	// ResetIncomeBuffer resets the income buffer accounting to the module account balance
+	ResetIncomeBuffer(context.Context, *MsgResetIncomeBuffer) (*MsgResetIncomeBufferResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) SetEmergencyPause(ctx context.Context, req *MsgSetEmergencyPause) (*MsgSetEmergencyPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEmergencyPause not implemented")
+}
+func (*UnimplementedMsgServer) DrainIncomeBuffer(ctx context.Context, req *MsgDrainIncomeBuffer) (*MsgDrainIncomeBufferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrainIncomeBuffer not implemented")
+}
+func (*UnimplementedMsgServer) ResetIncomeBuffer(ctx context.Context, req *MsgResetIncomeBuffer) (*MsgResetIncomeBufferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetIncomeBuffer not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.aexburn.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetEmergencyPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetEmergencyPause)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetEmergencyPause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.aexburn.Msg/SetEmergencyPause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetEmergencyPause(ctx, req.(*MsgSetEmergencyPause))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DrainIncomeBuffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDrainIncomeBuffer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DrainIncomeBuffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.aexburn.Msg/DrainIncomeBuffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DrainIncomeBuffer(ctx, req.(*MsgDrainIncomeBuffer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResetIncomeBuffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResetIncomeBuffer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResetIncomeBuffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.aexburn.Msg/ResetIncomeBuffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResetIncomeBuffer(ctx, req.(*MsgResetIncomeBuffer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "seiprotocol.seichain.aexburn.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "SetEmergencyPause",
+			Handler:    _Msg_SetEmergencyPause_Handler,
+		},
+		{
+			MethodName: "DrainIncomeBuffer",
+			Handler:    _Msg_DrainIncomeBuffer_Handler,
+		},
+		{
+			MethodName: "ResetIncomeBuffer",
+			Handler:    _Msg_ResetIncomeBuffer_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "aexburn/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetEmergencyPause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetEmergencyPause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetEmergencyPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MintPaused {
+		i--
+		if m.MintPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BurnPaused {
+		i--
+		if m.BurnPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetEmergencyPauseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetEmergencyPauseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetEmergencyPauseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDrainIncomeBuffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDrainIncomeBuffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDrainIncomeBuffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDrainIncomeBufferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDrainIncomeBufferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDrainIncomeBufferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResetIncomeBuffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetIncomeBuffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetIncomeBuffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResetIncomeBufferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetIncomeBufferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetIncomeBufferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetEmergencyPause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BurnPaused {
+		n += 2
+	}
+	if m.MintPaused {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetEmergencyPauseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDrainIncomeBuffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDrainIncomeBufferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgResetIncomeBuffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResetIncomeBufferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetEmergencyPause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetEmergencyPause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetEmergencyPause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnPaused = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MintPaused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetEmergencyPauseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetEmergencyPauseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetEmergencyPauseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDrainIncomeBuffer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDrainIncomeBuffer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDrainIncomeBuffer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDrainIncomeBufferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDrainIncomeBufferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDrainIncomeBufferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResetIncomeBuffer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResetIncomeBuffer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResetIncomeBuffer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResetIncomeBufferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResetIncomeBufferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResetIncomeBufferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -10,6 +10,7 @@ import (
 	"slices"
 	"sort"
 	"sync"
+	"sync/atomic"
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -73,6 +74,11 @@ type Keeper struct {
 
 	QueryConfig *querier.Config
 
+	// maintain the eth_getLogs address and topic index in receipt.db. Not used in chain critical path.
+	LogIndexEnabled    bool
+	logIndexReset      *atomic.Bool
+	logIndexBackfilled *atomic.Bool
+
 	// only used during ETH replay. Not used in chain critical path.
 	EthClient       *ethclient.Client
 	EthReplayConfig replay.Config
@@ -152,6 +158,8 @@ func NewKeeper(
 		cachedFeeCollectorAddressMtx: &sync.RWMutex{},
 		keyToNonce:                   make(map[tmtypes.TxKey]*AddressNoncePair),
 		receiptStore:                 receiptStateStore,
+		logIndexReset:                &atomic.Bool{},
+		logIndexBackfilled:           &atomic.Bool{},
 	}
 	return k
 }
@@ -271,8 +271,8 @@ func TestGetCustomPrecompiles(t *testing.T) {
 
 	// Define the precompiles directories to scan
 	precompileDirs := []string{
-		"addr", "bank", "distribution", "gov", "ibc", "json",
-		"oracle", "p256", "pointer", "pointerview", "solo", "staking", "wasmd",
+		"addr", "aexburn", "authz", "bank", "distribution", "feegrant", "gov", "ibc", "json",
+		"oracle", "p256", "pointer", "pointerview", "solo", "staking", "tokenfactory", "wasmd",
 	}
 	precompileTags := map[string][]string{}
 
@@ -0,0 +1,280 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/iavl"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+// Number of receipt.db receipts indexed per flush while backfilling the log index
+const LogIndexBackfillBatchSize int = 1000
+
+// The log index lives in receipt.db next to the receipts and maps the address and topics of
+// every log to the block it was emitted in, so that eth_getLogs can skip the blocks without
+// a matching log instead of scanning the whole range. It is maintained when transient
+// receipts are flushed and only exists on nodes that enable it.
+
+// LogIndexCovers returns whether the log index has the logs of every block from fromHeight
+// on, which is the case once the receipts that were stored before the index was enabled
+// have been backfilled
+func (k *Keeper) LogIndexCovers(ctx sdk.Context, fromHeight int64) bool {
+	if !k.LogIndexEnabled {
+		return false
+	}
+	// Once backfilled the index keeps covering every block until it is disabled, so the
+	// stores only have to be checked until then
+	if k.logIndexBackfilled != nil && k.logIndexBackfilled.Load() {
+		return true
+	}
+
+	// Receipts still in the legacy store are only indexed once they are migrated
+	legacyIter := prefix.NewStore(ctx.KVStore(k.storeKey), types.ReceiptKeyPrefix).Iterator(nil, nil)
+	hasLegacyReceipts := legacyIter.Valid()
+	legacyIter.Close()
+	if hasLegacyReceipts {
+		return false
+	}
+
+	lv, err := k.receiptStore.GetLatestVersion()
+	if err != nil {
+		return false
+	}
+	if backfilled, err := k.receiptStore.Has(types.ReceiptStoreKey, lv, types.LogIndexBackfillCompletionKey); err == nil && backfilled {
+		if k.logIndexBackfilled != nil {
+			k.logIndexBackfilled.Store(true)
+		}
+		return true
+	}
+	startHeight, found, err := k.getLogIndexStartHeight(lv)
+	return err == nil && found && fromHeight >= startHeight
+}
+
+// GetLogIndexHeights returns the heights within [fromHeight, toHeight], ascending, of the
+// blocks with a log emitted by one of the addresses. Without addresses the last topic
+// position with values is used instead, as later topics are usually indexed event arguments
+// and more selective than the event signature. The logs of the returned blocks still have
+// to be matched against the full filter. With a positive limit the lookup stops early and
+// only the latest limit+1 heights are returned, which is enough for callers to tell that
+// more than limit blocks matched.
+func (k *Keeper) GetLogIndexHeights(addresses []common.Address, topics [][]common.Hash, fromHeight, toHeight int64, limit int) ([]int64, error) {
+	var kind byte
+	var values [][]byte
+	if len(addresses) > 0 {
+		kind = types.LogIndexAddress
+		for _, address := range addresses {
+			values = append(values, address.Bytes())
+		}
+	} else {
+		for i := len(topics) - 1; i >= 0 && i <= int(types.LogIndexTopic3-types.LogIndexTopic0); i-- {
+			if len(topics[i]) == 0 {
+				continue
+			}
+			kind = types.LogIndexTopic0 + byte(i)
+			for _, topic := range topics[i] {
+				values = append(values, topic.Bytes())
+			}
+			break
+		}
+	}
+	if len(values) == 0 {
+		return nil, errors.New("log index lookups need an address or topic")
+	}
+	if fromHeight < 0 || toHeight < fromHeight {
+		return []int64{}, nil
+	}
+
+	lv, err := k.receiptStore.GetLatestVersion()
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[int64]struct{})
+	for _, value := range values {
+		heightOffset := len(types.LogIndexValuePrefix(kind, value))
+		// Entries are walked from the latest height down, so with a limit each value only
+		// needs its latest limit+1 distinct heights for the overall latest ones to be found
+		iter, err := k.receiptStore.ReverseIterator(
+			types.ReceiptStoreKey,
+			lv,
+			types.LogIndexHeightKey(kind, value, uint64(fromHeight)), //nolint:gosec
+			types.LogIndexHeightKey(kind, value, uint64(toHeight)+1), //nolint:gosec
+		)
+		if err != nil {
+			return nil, err
+		}
+		distinct, last := 0, int64(-1)
+		for ; iter.Valid(); iter.Next() {
+			height := int64(binary.BigEndian.Uint64(iter.Key()[heightOffset:])) //nolint:gosec
+			if height == last {
+				continue
+			}
+			if limit > 0 && distinct > limit {
+				break
+			}
+			distinct, last = distinct+1, height
+			seen[height] = struct{}{}
+		}
+		err = iter.Error()
+		_ = iter.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	heights := make([]int64, 0, len(seen))
+	for height := range seen {
+		heights = append(heights, height)
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+	if limit > 0 && len(heights) > limit+1 {
+		heights = heights[len(heights)-limit-1:]
+	}
+	return heights, nil
+}
+
+// logIndexMaintenancePairs returns the log index metadata to write with a flush and the
+// next batch of backfilled entries. The metadata is rewritten with every flush that stores
+// receipts, so pruning old receipt.db versions never drops it. Errors only pause the
+// maintenance, as the index is not needed to flush the receipts of the block.
+func (k *Keeper) logIndexMaintenancePairs(ctx sdk.Context, hasReceipts bool) ([]*iavl.KVPair, error) {
+	lv, err := k.receiptStore.GetLatestVersion()
+	if err != nil {
+		return nil, err
+	}
+
+	var pairs []*iavl.KVPair
+	if hasReceipts {
+		startHeight, found, err := k.getLogIndexStartHeight(lv)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			startHeight = ctx.BlockHeight()
+		}
+		pairs = append(pairs, &iavl.KVPair{Key: types.LogIndexStartHeightKey, Value: heightToBytes(startHeight)})
+	}
+
+	backfilled, err := k.receiptStore.Has(types.ReceiptStoreKey, lv, types.LogIndexBackfillCompletionKey)
+	if err != nil {
+		return nil, err
+	}
+	if backfilled {
+		if hasReceipts {
+			pairs = append(pairs, &iavl.KVPair{Key: types.LogIndexBackfillCompletionKey, Value: []byte{1}})
+		}
+		return pairs, nil
+	}
+
+	backfillPairs, err := k.backfillLogIndexBatch(ctx, lv, LogIndexBackfillBatchSize)
+	if err != nil {
+		return nil, err
+	}
+	return append(pairs, backfillPairs...), nil
+}
+
+// backfillLogIndexBatch indexes up to batchSize receipts after the backfill cursor and
+// returns the entries together with the moved cursor, or the completion marker once every
+// stored receipt has been indexed. Receipts flushed since the index was enabled are indexed
+// again, which rewrites the same entries. Receipts that cannot be decoded are skipped.
+func (k *Keeper) backfillLogIndexBatch(ctx sdk.Context, version int64, batchSize int) ([]*iavl.KVPair, error) {
+	start := types.ReceiptKeyPrefix
+	cursor, err := k.receiptStore.Get(types.ReceiptStoreKey, version, types.LogIndexBackfillCursorKey)
+	if err != nil {
+		return nil, err
+	}
+	if cursor != nil {
+		// Start right after the last indexed receipt
+		start = append(append([]byte{}, cursor...), 0)
+	}
+
+	iter, err := k.receiptStore.Iterator(types.ReceiptStoreKey, version, start, sdk.PrefixEndBytes(types.ReceiptKeyPrefix))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = iter.Close() }()
+
+	var pairs []*iavl.KVPair
+	var lastKey []byte
+	for indexed := 0; indexed < batchSize && iter.Valid(); indexed++ {
+		txHash := common.BytesToHash(iter.Key()[len(types.ReceiptKeyPrefix):])
+		receipt := &types.Receipt{}
+		if err := receipt.Unmarshal(iter.Value()); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("skipping unreadable receipt %s in log index backfill: %s", txHash.Hex(), err))
+		} else {
+			pairs = append(pairs, logIndexPairs(txHash, receipt)...)
+		}
+		lastKey = append([]byte{}, iter.Key()...)
+		iter.Next()
+	}
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+
+	if !iter.Valid() {
+		return append(pairs, &iavl.KVPair{Key: types.LogIndexBackfillCompletionKey, Value: []byte{1}}), nil
+	}
+	return append(pairs, &iavl.KVPair{Key: types.LogIndexBackfillCursorKey, Value: lastKey}), nil
+}
+
+// logIndexResetPairs deletes the log index metadata. Blocks flushed while the index is
+// disabled are not indexed, so enabling it again has to start over with a full backfill.
+func (k *Keeper) logIndexResetPairs() ([]*iavl.KVPair, error) {
+	lv, err := k.receiptStore.GetLatestVersion()
+	if err != nil {
+		return nil, err
+	}
+	var pairs []*iavl.KVPair
+	for _, key := range [][]byte{types.LogIndexStartHeightKey, types.LogIndexBackfillCursorKey, types.LogIndexBackfillCompletionKey} {
+		has, err := k.receiptStore.Has(types.ReceiptStoreKey, lv, key)
+		if err != nil {
+			return nil, err
+		}
+		if has {
+			pairs = append(pairs, &iavl.KVPair{Key: key, Delete: true})
+		}
+	}
+	return pairs, nil
+}
+
+func (k *Keeper) getLogIndexStartHeight(version int64) (int64, bool, error) {
+	bz, err := k.receiptStore.Get(types.ReceiptStoreKey, version, types.LogIndexStartHeightKey)
+	if err != nil || bz == nil {
+		return 0, false, err
+	}
+	return int64(binary.BigEndian.Uint64(bz)), true, nil //nolint:gosec
+}
+
+// logIndexPairs returns the log index entries of the logs of a receipt
+func logIndexPairs(txHash common.Hash, receipt *types.Receipt) []*iavl.KVPair {
+	var pairs []*iavl.KVPair
+	for _, log := range receipt.Logs {
+		address := common.HexToAddress(log.Address)
+		pairs = append(pairs, &iavl.KVPair{
+			Key:   types.LogIndexKey(types.LogIndexAddress, address.Bytes(), receipt.BlockNumber, receipt.TransactionIndex, log.Index),
+			Value: txHash.Bytes(),
+		})
+		for i, topic := range log.Topics {
+			if i > int(types.LogIndexTopic3-types.LogIndexTopic0) {
+				break
+			}
+			pairs = append(pairs, &iavl.KVPair{
+				Key:   types.LogIndexKey(types.LogIndexTopic0+byte(i), common.HexToHash(topic).Bytes(), receipt.BlockNumber, receipt.TransactionIndex, log.Index),
+				Value: txHash.Bytes(),
+			})
+		}
+	}
+	return pairs
+}
+
+func heightToBytes(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height)) //nolint:gosec
+	return bz
+}
@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func mockLogReceipt(t *testing.T, k *keeper.Keeper, ctx sdk.Context, height int64, txHash common.Hash, address common.Address, topics ...common.Hash) {
+	ctx = ctx.WithBlockHeight(height)
+	receipt := &types.Receipt{
+		TxHashHex:   txHash.Hex(),
+		BlockNumber: uint64(height),
+		Logs:        []*types.Log{{Address: address.Hex()}},
+	}
+	for _, topic := range topics {
+		receipt.Logs[0].Topics = append(receipt.Logs[0].Topics, topic.Hex())
+	}
+	require.NoError(t, k.MockReceipt(ctx, txHash, receipt))
+}
+
+func TestLogIndexLookups(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	k.LogIndexEnabled = true
+
+	token := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	other := common.HexToAddress("0x1000000000000000000000000000000000000002")
+	transfer := common.HexToHash("0xaa")
+	approval := common.HexToHash("0xbb")
+	holder := common.HexToHash("0x01")
+
+	mockLogReceipt(t, k, ctx, 10, common.HexToHash("0x10"), token, transfer, holder)
+	mockLogReceipt(t, k, ctx, 20, common.HexToHash("0x20"), other, transfer)
+	mockLogReceipt(t, k, ctx, 30, common.HexToHash("0x30"), token, approval, holder)
+	mockLogReceipt(t, k, ctx, 40, common.HexToHash("0x40"), other, approval)
+
+	heights, err := k.GetLogIndexHeights([]common.Address{token}, nil, 0, 100, 0)
+	require.NoError(t, err)
+	require.Equal(t, []int64{10, 30}, heights)
+
+	heights, err = k.GetLogIndexHeights([]common.Address{token, other}, nil, 15, 35, 0)
+	require.NoError(t, err)
+	require.Equal(t, []int64{20, 30}, heights)
+
+	heights, err = k.GetLogIndexHeights(nil, [][]common.Hash{{transfer}}, 0, 100, 0)
+	require.NoError(t, err)
+	require.Equal(t, []int64{10, 20}, heights)
+
+	// The last topic position with values is looked up
+	heights, err = k.GetLogIndexHeights(nil, [][]common.Hash{{approval}, {holder}}, 0, 100, 0)
+	require.NoError(t, err)
+	require.Equal(t, []int64{10, 30}, heights)
+
+	heights, err = k.GetLogIndexHeights(nil, [][]common.Hash{{}, {holder}}, 11, 100, 0)
+	require.NoError(t, err)
+	require.Equal(t, []int64{30}, heights)
+
+	_, err = k.GetLogIndexHeights(nil, [][]common.Hash{{}}, 0, 100, 0)
+	require.Error(t, err)
+
+	// A limit keeps only the latest limit+1 heights
+	heights, err = k.GetLogIndexHeights([]common.Address{token, other}, nil, 0, 100, 2)
+	require.NoError(t, err)
+	require.Equal(t, []int64{20, 30, 40}, heights)
+
+	heights, err = k.GetLogIndexHeights([]common.Address{token, other}, nil, 0, 100, 4)
+	require.NoError(t, err)
+	require.Equal(t, []int64{10, 20, 30, 40}, heights)
+
+	// Every receipt was flushed with the index enabled
+	require.True(t, k.LogIndexCovers(ctx, 0))
+}
+
+func TestLogIndexBackfill(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	token := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	// Receipts stored before the index is enabled, flushed from their own transient store
+	seedCtx, _ := ctx.WithBlockHeight(1).CacheContext()
+	for i := 1; i <= keeper.LogIndexBackfillBatchSize+1; i++ {
+		txHash := common.BigToHash(big.NewInt(int64(i)))
+		require.NoError(t, k.SetTransientReceipt(seedCtx, txHash, &types.Receipt{
+			TxHashHex:        txHash.Hex(),
+			BlockNumber:      uint64(i),
+			TransactionIndex: uint32(i),
+			Logs:             []*types.Log{{Address: token.Hex()}},
+		}))
+	}
+	require.NoError(t, k.FlushTransientReceiptsSync(seedCtx))
+
+	k.LogIndexEnabled = true
+	require.False(t, k.LogIndexCovers(ctx, 0))
+
+	// The first flush indexes its own receipts and the first backfill batch
+	start := int64(5000)
+	mockLogReceipt(t, k, ctx, start, common.HexToHash("0x5000"), token)
+	require.True(t, k.LogIndexCovers(ctx, start))
+	require.False(t, k.LogIndexCovers(ctx, 1))
+	heights, err := k.GetLogIndexHeights([]common.Address{token}, nil, 0, start, 0)
+	require.NoError(t, err)
+	require.Len(t, heights, keeper.LogIndexBackfillBatchSize+1)
+
+	// The next flush indexes the rest
+	mockLogReceipt(t, k, ctx, start+1, common.HexToHash("0x5001"), token)
+	require.True(t, k.LogIndexCovers(ctx, 1))
+	heights, err = k.GetLogIndexHeights([]common.Address{token}, nil, 0, start+1, 0)
+	require.NoError(t, err)
+	require.Len(t, heights, keeper.LogIndexBackfillBatchSize+3)
+	require.Equal(t, int64(1), heights[0])
+	require.Equal(t, start+1, heights[len(heights)-1])
+}
+
+func TestLogIndexResetWhenDisabled(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	token := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	k.LogIndexEnabled = true
+	mockLogReceipt(t, k, ctx, 10, common.HexToHash("0x10"), token)
+	require.True(t, k.LogIndexCovers(ctx, 0))
+
+	// Blocks flushed without the index leave a gap, so the index starts over
+	k.LogIndexEnabled = false
+	require.False(t, k.LogIndexCovers(ctx, 0))
+	mockLogReceipt(t, k, ctx, 11, common.HexToHash("0x11"), token)
+	k.LogIndexEnabled = true
+	require.False(t, k.LogIndexCovers(ctx, 0))
+
+	mockLogReceipt(t, k, ctx, 12, common.HexToHash("0x12"), token)
+	require.True(t, k.LogIndexCovers(ctx, 12))
+	require.True(t, k.LogIndexCovers(ctx, 0))
+	heights, err := k.GetLogIndexHeights([]common.Address{token}, nil, 0, 100, 0)
+	require.NoError(t, err)
+	require.Equal(t, []int64{10, 11, 12}, heights)
+}
+
+func TestLogIndexBackfillSkipsUnreadableReceipts(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	token := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	mockLogReceipt(t, k, ctx, 1, common.HexToHash("0x1"), token)
+	require.NoError(t, k.MockRawReceipt(ctx.WithBlockHeight(2), common.HexToHash("0x2"), []byte{0xff, 0xff, 0xff}))
+
+	// The unreadable receipt is left out of the index without failing the block's flush
+	k.LogIndexEnabled = true
+	mockLogReceipt(t, k, ctx, 3, common.HexToHash("0x3"), token)
+	receipt, err := k.GetReceiptFromReceiptStore(ctx, common.HexToHash("0x3"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), receipt.BlockNumber)
+	require.True(t, k.LogIndexCovers(ctx, 0))
+	heights, err := k.GetLogIndexHeights([]common.Address{token}, nil, 0, 100, 0)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 3}, heights)
+}
@@ -143,6 +143,15 @@ func (k *Keeper) MockReceipt(ctx sdk.Context, txHash common.Hash, receipt *types
 	return k.FlushTransientReceiptsSync(ctx)
 }
 
+// MockRawReceipt stores raw bytes as the receipt of a transaction in receipt.db without
+// decoding them. It is used by tests to simulate receipts that cannot be read back.
+func (k *Keeper) MockRawReceipt(ctx sdk.Context, txHash common.Hash, bz []byte) error {
+	return k.receiptStore.ApplyChangeset(ctx.BlockHeight(), &proto.NamedChangeSet{
+		Name:      types.ReceiptStoreKey,
+		Changeset: iavl.ChangeSet{Pairs: []*iavl.KVPair{{Key: types.ReceiptKey(txHash), Value: bz}}},
+	})
+}
+
 func (k *Keeper) FlushTransientReceiptsSync(ctx sdk.Context) error {
 	return k.flushTransientReceipts(ctx, true)
 }
@@ -191,8 +200,31 @@ func (k *Keeper) flushTransientReceipts(ctx sdk.Context, sync bool) error {
 			return err
 		}
 
-		kvPair := &iavl.KVPair{Key: types.ReceiptKey(types.TransientReceiptKey(iter.Key()).TransactionHash()), Value: marshalledReceipt}
+		txHash := types.TransientReceiptKey(iter.Key()).TransactionHash()
+		kvPair := &iavl.KVPair{Key: types.ReceiptKey(txHash), Value: marshalledReceipt}
 		pairs = append(pairs, kvPair)
+		if k.LogIndexEnabled {
+			pairs = append(pairs, logIndexPairs(txHash, receipt)...)
+		}
+	}
+
+	// The log index only serves eth_getLogs, so failing to maintain it must not fail the flush
+	if k.LogIndexEnabled {
+		indexPairs, err := k.logIndexMaintenancePairs(ctx, len(pairs) > 0)
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to maintain the log index, retrying with the next block: %s", err))
+		} else {
+			pairs = append(pairs, indexPairs...)
+		}
+	} else if k.logIndexReset != nil && k.logIndexReset.CompareAndSwap(false, true) {
+		resetPairs, err := k.logIndexResetPairs()
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to reset the log index, retrying with the next block: %s", err))
+			k.logIndexReset.Store(false)
+		} else {
+			pairs = append(pairs, resetPairs...)
+			k.logIndexBackfilled.Store(false)
+		}
 	}
 	if len(pairs) == 0 {
 		return nil
@@ -61,6 +61,22 @@ var (
 	BaseFeePerGasPrefix             = []byte{0x1b}
 	NextBaseFeePerGasPrefix         = []byte{0x1c}
 	EvmOnlyBlockBloomPrefix         = []byte{0x1d}
+
+	// receipt store only
+	LogIndexPrefix                = []byte{0x1e}
+	LogIndexStartHeightKey        = []byte{0x1f, 0x01}
+	LogIndexBackfillCursorKey     = []byte{0x1f, 0x02}
+	LogIndexBackfillCompletionKey = []byte{0x1f, 0x03}
+)
+
+// Log index entries are keyed by what a log filter can match on: the emitting address or
+// a topic at a given position
+const (
+	LogIndexAddress byte = iota
+	LogIndexTopic0
+	LogIndexTopic1
+	LogIndexTopic2
+	LogIndexTopic3
 )
 
 var (
@@ -102,6 +118,33 @@ func (trk TransientReceiptKey) TransactionHash() common.Hash {
 	return common.Hash{}
 }
 
+// LogIndexValuePrefix returns the prefix of all log index entries for an address
+// (LogIndexAddress) or a topic value at a position (LogIndexTopic0 + position)
+func LogIndexValuePrefix(kind byte, value []byte) []byte {
+	key := make([]byte, 0, len(LogIndexPrefix)+1+len(value))
+	key = append(key, LogIndexPrefix...)
+	key = append(key, kind)
+	return append(key, value...)
+}
+
+// LogIndexKey returns the log index key of a single log. Entries of the same address or
+// topic are ordered by block height, then by position in the block
+func LogIndexKey(kind byte, value []byte, height uint64, txIndex uint32, logIndex uint32) []byte {
+	key := LogIndexValuePrefix(kind, value)
+	bz := make([]byte, 16)
+	binary.BigEndian.PutUint64(bz, height)
+	binary.BigEndian.PutUint32(bz[8:], txIndex)
+	binary.BigEndian.PutUint32(bz[12:], logIndex)
+	return append(key, bz...)
+}
+
+// LogIndexHeightKey returns the first log index key of an address or topic at a height
+func LogIndexHeightKey(kind byte, value []byte, height uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, height)
+	return append(LogIndexValuePrefix(kind, value), bz...)
+}
+
 func BlockBloomKey(height int64) []byte {
 	bz := make([]byte, 8)
 	binary.BigEndian.PutUint64(bz, uint64(height))
@@ -28,6 +28,9 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epoch epochTypes.Epoch) {
 	// Released Succssfully, decrement the remaining amount by the daily release amount and update minter
 	amountMinted := coinsToMint.AmountOf(latestMinter.GetDenom())
 	latestMinter.RecordSuccessfulMint(ctx, epoch, amountMinted.Uint64())
+	if k.hooks != nil {
+		k.hooks.AfterDistributeMintedCoin(ctx, sdk.NewCoin(latestMinter.GetDenom(), amountMinted))
+	}
 	k.Logger(ctx).Info("Minted coins", "minter", latestMinter, "amount", coinsToMint.String())
 	k.SetMinter(ctx, latestMinter)
 }